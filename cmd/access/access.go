@@ -0,0 +1,135 @@
+// Package access implements user, group, role, and ACL administration.
+package access
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access",
+		Short: "Manage users, groups, roles, and permissions",
+		Long: `Administer the Proxmox access control system, the same settings found under
+Datacenter > Permissions in the web UI. A typical onboarding:
+
+  proxmox-cli access user create alice@pve --email alice@example.com --groups ops --password
+  proxmox-cli access acl set --path /pool/web-team --role PVEVMAdmin --group ops
+  proxmox-cli access permissions --user alice@pve --path /vms/100`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newUserCmd())
+	cmd.AddCommand(newGroupCmd())
+	cmd.AddCommand(newRoleCmd())
+	cmd.AddCommand(newACLCmd())
+	cmd.AddCommand(newPermissionsCmd())
+	return cmd
+}
+
+type pathPermissions struct {
+	Path       string          `json:"path"`
+	Privileges map[string]bool `json:"privileges"`
+}
+
+func newPermissionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permissions",
+		Short: "Show the effective privileges of a user or token",
+		Long: `Show the privileges a user or API token effectively holds, after group
+membership, role expansion, and propagation are applied. Without --user the
+privileges of the authenticated user are shown; without --path every path
+with privileges is listed. Privileges marked with * propagate to child paths.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			user, err := cmd.Flags().GetString("user")
+			if err != nil {
+				return fmt.Errorf("get user flag: %w", err)
+			}
+			path, err := cmd.Flags().GetString("path")
+			if err != nil {
+				return fmt.Errorf("get path flag: %w", err)
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			permissions, err := client.Permissions(cmd.Context(), &proxmox.PermissionsOptions{UserID: user, Path: path})
+			if err != nil {
+				return fmt.Errorf("get permissions: %w", err)
+			}
+
+			paths := make([]pathPermissions, 0, len(permissions))
+			for permissionPath, privileges := range permissions {
+				entry := pathPermissions{Path: permissionPath, Privileges: map[string]bool{}}
+				for privilege, propagate := range privileges {
+					entry.Privileges[privilege] = bool(propagate)
+				}
+				paths = append(paths, entry)
+			}
+			sort.Slice(paths, func(i, j int) bool { return paths[i].Path < paths[j].Path })
+
+			if format == "json" {
+				return utility.PrintJSON(out, paths)
+			}
+			if len(paths) == 0 {
+				fmt.Fprintln(out, "No privileges")
+				return nil
+			}
+			for i, entry := range paths {
+				if i > 0 {
+					fmt.Fprintln(out)
+				}
+				fmt.Fprintf(out, "%s\n", entry.Path)
+				privileges := make([]string, 0, len(entry.Privileges))
+				for privilege := range entry.Privileges {
+					privileges = append(privileges, privilege)
+				}
+				sort.Strings(privileges)
+				if len(privileges) == 0 {
+					fmt.Fprintln(out, "  (none)")
+				}
+				for _, privilege := range privileges {
+					marker := ""
+					if entry.Privileges[privilege] {
+						marker = " *"
+					}
+					fmt.Fprintf(out, "  %s%s\n", privilege, marker)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String("user", "", "User or API token ID (default: the authenticated user)")
+	cmd.Flags().String("path", "", "ACL path to check, e.g. /vms/100 or /storage/local")
+	_ = cmd.RegisterFlagCompletionFunc("user", completeUsers)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+// completeUsers completes user IDs for flags and arguments.
+func completeUsers(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	client, err := utility.AuthenticatedClient()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	users, err := client.Users(cmd.Context())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	names := make([]string, 0, len(users))
+	for _, user := range users {
+		names = append(names, user.UserID)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,190 @@
+package access
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/viper"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/Adz-ai/proxmox-cli/test/mocks"
+)
+
+func setupAccessMocks(t *testing.T, ctrl *gomock.Controller) *mocks.MockProxmoxClientInterface {
+	t.Helper()
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set("server_url", "https://pve.example.com:8006")
+	viper.Set("auth_ticket.ticket", "ticket")
+	viper.Set("auth_ticket.CSRFPreventionToken", "token")
+
+	client := mocks.NewMockProxmoxClientInterface(ctrl)
+	utility.SetClientFactory(func() interfaces.ProxmoxClientInterface { return client })
+	t.Cleanup(utility.ResetClientFactory)
+	return client
+}
+
+func runAccess(t *testing.T, input string, args ...string) (string, error) {
+	t.Helper()
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetIn(strings.NewReader(input))
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestUserCreateSendsDetailsAndPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAccessMocks(t, ctrl)
+
+	client.EXPECT().NewUser(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, user *proxmox.NewUser) error {
+			if user.UserID != "alice@pve" || user.Email != "alice@example.com" || user.Password != "s3cret" {
+				t.Fatalf("unexpected user: %+v", user)
+			}
+			if !user.Enable || strings.Join(user.Groups, ",") != "ops,dev" {
+				t.Fatalf("expected enabled user in ops,dev: %+v", user)
+			}
+			return nil
+		})
+
+	out, err := runAccess(t, "s3cret\n", "user", "create", "alice@pve",
+		"--email", "alice@example.com", "--groups", "ops,dev", "--password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "User alice@pve created") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestUserCreateRequiresRealm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	setupAccessMocks(t, ctrl)
+
+	_, err := runAccess(t, "", "user", "create", "alice")
+	if err == nil || !strings.Contains(err.Error(), "name@realm") {
+		t.Fatalf("expected realm error, got %v", err)
+	}
+}
+
+func TestUserUpdateSendsOnlyChangedFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAccessMocks(t, ctrl)
+
+	client.EXPECT().UpdateUser(gomock.Any(), "alice@pve", gomock.Any()).DoAndReturn(
+		func(_ any, _ string, options *proxmox.UserOptions) error {
+			if options.Enable == nil || bool(*options.Enable) {
+				t.Fatalf("expected enable=false, got %+v", options.Enable)
+			}
+			if options.Email != "" || options.Groups != nil || bool(options.Append) {
+				t.Fatalf("unexpected fields set: %+v", options)
+			}
+			return nil
+		})
+
+	if _, err := runAccess(t, "", "user", "update", "alice@pve", "--enable=false"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUserPasswdSendsConfirmation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAccessMocks(t, ctrl)
+
+	client.EXPECT().ChangePassword(gomock.Any(), "alice@pve", "n3w", "mine").Return(nil)
+
+	if _, err := runAccess(t, "mine\nn3w\n", "user", "passwd", "alice@pve"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRoleCreateSuggestsPrivilege(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAccessMocks(t, ctrl)
+
+	client.EXPECT().Roles(gomock.Any()).Return(proxmox.Roles{
+		&proxmox.Role{RoleID: "Administrator", Privs: "VM.Audit,VM.Console,VM.PowerMgmt", Special: true},
+	}, nil)
+
+	_, err := runAccess(t, "", "role", "create", "VMOperator", "--privs", "VM.Audit,VM.Powermgmt")
+	if err == nil || !strings.Contains(err.Error(), `"VM.Powermgmt" (did you mean "VM.PowerMgmt"?)`) {
+		t.Fatalf("expected privilege suggestion, got %v", err)
+	}
+}
+
+func TestRoleCreateSendsPrivileges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAccessMocks(t, ctrl)
+
+	client.EXPECT().Roles(gomock.Any()).Return(proxmox.Roles{
+		&proxmox.Role{RoleID: "Administrator", Privs: "VM.Audit,VM.Console,VM.PowerMgmt", Special: true},
+	}, nil)
+	client.EXPECT().NewRole(gomock.Any(), "VMOperator", "VM.Audit,VM.PowerMgmt").Return(nil)
+
+	if _, err := runAccess(t, "", "role", "create", "VMOperator", "--privs", "VM.Audit,VM.PowerMgmt"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestACLSetGrantsToGroupWithoutPropagation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAccessMocks(t, ctrl)
+
+	client.EXPECT().UpdateACL(gomock.Any(), proxmox.ACLOptions{
+		Path:      "/vms/100",
+		Roles:     "PVEVMUser",
+		Groups:    "ops",
+		Propagate: false,
+	}).Return(nil)
+
+	out, err := runAccess(t, "", "acl", "set", "--path", "/vms/100", "--role", "PVEVMUser", "--group", "ops", "--propagate=false")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "Granted PVEVMUser on /vms/100 to ops") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestACLDeleteSetsDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAccessMocks(t, ctrl)
+
+	client.EXPECT().UpdateACL(gomock.Any(), proxmox.ACLOptions{
+		Path:      "/",
+		Roles:     "Administrator",
+		Tokens:    "alice@pve!ci",
+		Propagate: true,
+		Delete:    true,
+	}).Return(nil)
+
+	if _, err := runAccess(t, "", "acl", "delete", "--path", "/", "--role", "Administrator", "--token", "alice@pve!ci", "--yes"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPermissionsShowsPrivilegesByPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAccessMocks(t, ctrl)
+
+	client.EXPECT().Permissions(gomock.Any(), &proxmox.PermissionsOptions{UserID: "alice@pve", Path: "/vms/100"}).
+		Return(proxmox.Permissions{
+			"/vms/100": proxmox.Permission{"VM.PowerMgmt": true, "VM.Audit": false},
+		}, nil)
+
+	out, err := runAccess(t, "", "permissions", "--user", "alice@pve", "--path", "/vms/100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "/vms/100\n  VM.Audit\n  VM.PowerMgmt *\n") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
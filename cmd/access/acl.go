@@ -0,0 +1,197 @@
+package access
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+type aclEntry struct {
+	Path      string `json:"path"`
+	Type      string `json:"type"`
+	ID        string `json:"id"`
+	Role      string `json:"role"`
+	Propagate bool   `json:"propagate"`
+}
+
+func newACLCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acl",
+		Short: "Manage access control lists",
+		Long: `Grant and revoke roles on ACL paths such as /, /vms/100, /storage/local,
+/nodes/pve1, or /pool/web-team. Each entry applies to users, groups, or API
+tokens; with propagation (the default) it also covers every path below.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newACLListCmd(), newACLModifyCmd("set"), newACLModifyCmd("delete"))
+	return cmd
+}
+
+func newACLListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List ACL entries",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			pathFilter, err := cmd.Flags().GetString("path")
+			if err != nil {
+				return fmt.Errorf("get path flag: %w", err)
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			acls, err := client.ACL(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list ACL entries: %w", err)
+			}
+
+			entries := make([]aclEntry, 0, len(acls))
+			for _, acl := range acls {
+				if acl == nil || (pathFilter != "" && acl.Path != pathFilter) {
+					continue
+				}
+				entries = append(entries, aclEntry{
+					Path:      acl.Path,
+					Type:      acl.Type,
+					ID:        acl.UGID,
+					Role:      acl.RoleID,
+					Propagate: bool(acl.Propagate),
+				})
+			}
+			sort.Slice(entries, func(i, j int) bool {
+				if entries[i].Path != entries[j].Path {
+					return entries[i].Path < entries[j].Path
+				}
+				if entries[i].ID != entries[j].ID {
+					return entries[i].ID < entries[j].ID
+				}
+				return entries[i].Role < entries[j].Role
+			})
+
+			if format == "json" {
+				return utility.PrintJSON(out, entries)
+			}
+			if len(entries) == 0 {
+				fmt.Fprintln(out, "No ACL entries found")
+				return nil
+			}
+			fmt.Fprintf(out, "%-24s %-6s %-28s %-20s %s\n", "Path", "Type", "User/Group/Token", "Role", "Propagate")
+			fmt.Fprintf(out, "%-24s %-6s %-28s %-20s %s\n", "----", "----", "----------------", "----", "---------")
+			for _, entry := range entries {
+				fmt.Fprintf(out, "%-24s %-6s %-28s %-20s %s\n", entry.Path, entry.Type, entry.ID, entry.Role, utility.YesNo(entry.Propagate))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String("path", "", "Only list entries on this path")
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newACLModifyCmd(action string) *cobra.Command {
+	short := "Grant roles on a path"
+	if action == "delete" {
+		short = "Revoke roles on a path"
+	}
+	cmd := &cobra.Command{
+		Use:   action,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			options := proxmox.ACLOptions{Delete: proxmox.IntOrBool(action == "delete")}
+			var err error
+			if options.Path, err = flags.GetString("path"); err != nil {
+				return fmt.Errorf("get path flag: %w", err)
+			}
+			if !strings.HasPrefix(options.Path, "/") {
+				return fmt.Errorf("--path must be an absolute ACL path such as / or /vms/100")
+			}
+			lists := map[string]*string{"role": &options.Roles, "user": &options.Users, "group": &options.Groups, "token": &options.Tokens}
+			for _, name := range []string{"role", "user", "group", "token"} {
+				values, err := flags.GetStringSlice(name)
+				if err != nil {
+					return fmt.Errorf("get %s flag: %w", name, err)
+				}
+				*lists[name] = strings.Join(values, ",")
+			}
+			if options.Roles == "" {
+				return fmt.Errorf("--role is required")
+			}
+			if options.Users == "" && options.Groups == "" && options.Tokens == "" {
+				return fmt.Errorf("pass at least one of --user, --group, or --token")
+			}
+			propagate, err := flags.GetBool("propagate")
+			if err != nil {
+				return fmt.Errorf("get propagate flag: %w", err)
+			}
+			options.Propagate = proxmox.IntOrBool(propagate)
+
+			var parts []string
+			for _, list := range []string{options.Users, options.Groups, options.Tokens} {
+				if list != "" {
+					parts = append(parts, list)
+				}
+			}
+			subjects := strings.Join(parts, ",")
+			if action == "delete" {
+				prompt := fmt.Sprintf("Revoke %s on %s from %s?", options.Roles, options.Path, subjects)
+				if err := utility.ConfirmAction(cmd, prompt); err != nil {
+					return err
+				}
+			}
+
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			if err := client.UpdateACL(cmd.Context(), options); err != nil {
+				return fmt.Errorf("update ACL on %q: %w", options.Path, err)
+			}
+			if action == "delete" {
+				fmt.Fprintf(cmd.OutOrStdout(), "Revoked %s on %s from %s\n", options.Roles, options.Path, subjects)
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "Granted %s on %s to %s\n", options.Roles, options.Path, subjects)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String("path", "", "ACL path, e.g. /, /vms/100, /storage/local, /pool/web-team")
+	cmd.Flags().StringSlice("role", nil, "Roles (comma-separated)")
+	cmd.Flags().StringSlice("user", nil, "Users (comma-separated)")
+	cmd.Flags().StringSlice("group", nil, "Groups (comma-separated)")
+	cmd.Flags().StringSlice("token", nil, "API tokens (comma-separated), e.g. alice@pve!ci")
+	cmd.Flags().Bool("propagate", true, "Apply to all paths below --path")
+	if action == "delete" {
+		utility.AddYesFlag(cmd)
+	}
+	_ = cmd.RegisterFlagCompletionFunc("user", completeUsers)
+	_ = cmd.RegisterFlagCompletionFunc("group", completeGroups)
+	_ = cmd.RegisterFlagCompletionFunc("role", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		client, err := utility.AuthenticatedClient()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		roles, err := client.Roles(cmd.Context())
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		names := make([]string, 0, len(roles))
+		for _, role := range roles {
+			names = append(names, role.RoleID)
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	})
+	return cmd
+}
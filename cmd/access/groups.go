@@ -0,0 +1,230 @@
+package access
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/spf13/cobra"
+)
+
+type groupSummary struct {
+	GroupID string   `json:"groupid"`
+	Comment string   `json:"comment,omitempty"`
+	Members []string `json:"members"`
+}
+
+func newGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "group",
+		Aliases: []string{"groups"},
+		Short:   "Manage groups",
+		Long: `Manage user groups. Membership is set on the user, e.g.
+'proxmox-cli access user update alice@pve --groups ops --append'.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newGroupListCmd(), newGroupShowCmd(), newGroupCreateCmd(), newGroupUpdateCmd(), newGroupDeleteCmd())
+	return cmd
+}
+
+func newGroupListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List groups and their members",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			groups, err := client.Groups(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list groups: %w", err)
+			}
+
+			summaries := make([]groupSummary, 0, len(groups))
+			for _, group := range groups {
+				if group == nil {
+					continue
+				}
+				members := []string{}
+				if group.Users != "" {
+					members = strings.Split(group.Users, ",")
+				}
+				summaries = append(summaries, groupSummary{GroupID: group.GroupID, Comment: group.Comment, Members: members})
+			}
+			sort.Slice(summaries, func(i, j int) bool { return summaries[i].GroupID < summaries[j].GroupID })
+
+			if format == "json" {
+				return utility.PrintJSON(out, summaries)
+			}
+			if len(summaries) == 0 {
+				fmt.Fprintln(out, "No groups found")
+				return nil
+			}
+			fmt.Fprintf(out, "%-20s %-30s %s\n", "Group", "Comment", "Members")
+			fmt.Fprintf(out, "%-20s %-30s %s\n", "-----", "-------", "-------")
+			for _, summary := range summaries {
+				fmt.Fprintf(out, "%-20s %-30s %s\n", summary.GroupID, utility.DashIfEmpty(summary.Comment), utility.DashIfEmpty(strings.Join(summary.Members, ",")))
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newGroupShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "show <group>",
+		Short:             "Show a group's members",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeGroupArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			group, err := client.Group(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("get group %q: %w", args[0], err)
+			}
+			summary := groupSummary{GroupID: group.GroupID, Comment: group.Comment, Members: append([]string{}, group.Members...)}
+			sort.Strings(summary.Members)
+
+			if format == "json" {
+				return utility.PrintJSON(out, summary)
+			}
+			fmt.Fprintf(out, "Group: %s\n", summary.GroupID)
+			if summary.Comment != "" {
+				fmt.Fprintf(out, "Comment: %s\n", summary.Comment)
+			}
+			if len(summary.Members) == 0 {
+				fmt.Fprintln(out, "No members")
+				return nil
+			}
+			fmt.Fprintln(out, "Members:")
+			for _, member := range summary.Members {
+				fmt.Fprintf(out, "  %s\n", member)
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newGroupCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <group>",
+		Short: "Create a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			comment, err := cmd.Flags().GetString("comment")
+			if err != nil {
+				return fmt.Errorf("get comment flag: %w", err)
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			if err := client.NewGroup(cmd.Context(), args[0], comment); err != nil {
+				return fmt.Errorf("create group %q: %w", args[0], err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Group %s created\n", args[0])
+			return nil
+		},
+	}
+	cmd.Flags().String("comment", "", "Comment")
+	return cmd
+}
+
+func newGroupUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "update <group>",
+		Short:             "Change a group's comment",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeGroupArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("comment") {
+				return fmt.Errorf("nothing to update: pass --comment")
+			}
+			comment, err := cmd.Flags().GetString("comment")
+			if err != nil {
+				return fmt.Errorf("get comment flag: %w", err)
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			if err := client.UpdateGroup(cmd.Context(), args[0], comment); err != nil {
+				return fmt.Errorf("update group %q: %w", args[0], err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Group %s updated\n", args[0])
+			return nil
+		},
+	}
+	cmd.Flags().String("comment", "", "Comment")
+	return cmd
+}
+
+func newGroupDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "delete <group>",
+		Short:             "Delete a group",
+		Long:              `Delete a group. Its members lose the permissions granted through it.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeGroupArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Delete group %s?", args[0])); err != nil {
+				return err
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			if err := client.DeleteGroup(cmd.Context(), args[0]); err != nil {
+				return fmt.Errorf("delete group %q: %w", args[0], err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Group %s deleted\n", args[0])
+			return nil
+		},
+	}
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+// completeGroups completes group IDs for flags and arguments.
+func completeGroups(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	client, err := utility.AuthenticatedClient()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	groups, err := client.Groups(cmd.Context())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	names := make([]string, 0, len(groups))
+	for _, group := range groups {
+		names = append(names, group.GroupID)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+func completeGroupArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeGroups(cmd, args, toComplete)
+}
@@ -0,0 +1,189 @@
+package access
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// allPrivilegesRole is the built-in role holding every privilege the server
+// knows, which makes it the reference list for validation.
+const allPrivilegesRole = "Administrator"
+
+type roleSummary struct {
+	RoleID     string   `json:"roleid"`
+	BuiltIn    bool     `json:"builtin"`
+	Privileges []string `json:"privileges"`
+}
+
+func newRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "role",
+		Aliases: []string{"roles"},
+		Short:   "List and create roles",
+		Args:    cobra.NoArgs,
+	}
+	cmd.AddCommand(newRoleListCmd(), newRoleCreateCmd())
+	return cmd
+}
+
+func newRoleListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List roles and their privileges",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			customOnly, err := cmd.Flags().GetBool("custom")
+			if err != nil {
+				return fmt.Errorf("get custom flag: %w", err)
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			roles, err := client.Roles(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list roles: %w", err)
+			}
+
+			summaries := make([]roleSummary, 0, len(roles))
+			for _, role := range roles {
+				if role == nil || (customOnly && bool(role.Special)) {
+					continue
+				}
+				summaries = append(summaries, roleSummary{
+					RoleID:     role.RoleID,
+					BuiltIn:    bool(role.Special),
+					Privileges: splitPrivileges(role.Privs),
+				})
+			}
+			sort.Slice(summaries, func(i, j int) bool { return summaries[i].RoleID < summaries[j].RoleID })
+
+			if format == "json" {
+				return utility.PrintJSON(out, summaries)
+			}
+			if len(summaries) == 0 {
+				fmt.Fprintln(out, "No roles found")
+				return nil
+			}
+			fmt.Fprintf(out, "%-22s %-8s %s\n", "Role", "BuiltIn", "Privileges")
+			fmt.Fprintf(out, "%-22s %-8s %s\n", "----", "-------", "----------")
+			for _, summary := range summaries {
+				fmt.Fprintf(out, "%-22s %-8s %s\n", summary.RoleID, utility.YesNo(summary.BuiltIn), utility.DashIfEmpty(strings.Join(summary.Privileges, ",")))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Bool("custom", false, "Only list roles that are not built in")
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newRoleCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <role>",
+		Short: "Create a role from a list of privileges",
+		Long: `Create a custom role. Privileges are checked against the ones the server
+knows before the role is created, so typos are reported with a suggestion
+instead of being rejected later:
+
+  proxmox-cli access role create VMOperator --privs VM.Audit,VM.PowerMgmt,VM.Console`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			privileges, err := cmd.Flags().GetStringSlice("privs")
+			if err != nil {
+				return fmt.Errorf("get privs flag: %w", err)
+			}
+			if len(privileges) == 0 {
+				return fmt.Errorf("--privs is required")
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			roles, err := client.Roles(ctx)
+			if err != nil {
+				return fmt.Errorf("list roles: %w", err)
+			}
+			if err := validatePrivileges(privileges, roles); err != nil {
+				return err
+			}
+			if err := client.NewRole(ctx, args[0], strings.Join(privileges, ",")); err != nil {
+				return fmt.Errorf("create role %q: %w", args[0], err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Role %s created with %d privileges\n", args[0], len(privileges))
+			return nil
+		},
+	}
+	cmd.Flags().StringSlice("privs", nil, "Privileges (comma-separated), e.g. VM.Audit,VM.PowerMgmt")
+	_ = cmd.RegisterFlagCompletionFunc("privs", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		client, err := utility.AuthenticatedClient()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		roles, err := client.Roles(cmd.Context())
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return knownPrivileges(roles), cobra.ShellCompDirectiveNoFileComp
+	})
+	return cmd
+}
+
+// validatePrivileges rejects privileges the server does not know, suggesting
+// the closest known one. Validation is skipped when the reference role is
+// not visible to the caller.
+func validatePrivileges(privileges []string, roles proxmox.Roles) error {
+	known := knownPrivileges(roles)
+	if len(known) == 0 {
+		return nil
+	}
+	var unknown []string
+	for _, privilege := range privileges {
+		if slices.Contains(known, privilege) {
+			continue
+		}
+		message := fmt.Sprintf("%q", privilege)
+		if suggestion := utility.ClosestMatch(privilege, known); suggestion != "" {
+			message += fmt.Sprintf(" (did you mean %q?)", suggestion)
+		}
+		unknown = append(unknown, message)
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown privileges: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// knownPrivileges returns the sorted privileges of the reference role.
+func knownPrivileges(roles proxmox.Roles) []string {
+	for _, role := range roles {
+		if role != nil && role.RoleID == allPrivilegesRole {
+			privileges := splitPrivileges(role.Privs)
+			sort.Strings(privileges)
+			return privileges
+		}
+	}
+	return nil
+}
+
+func splitPrivileges(privs string) []string {
+	privileges := []string{}
+	for _, privilege := range strings.Split(privs, ",") {
+		if privilege = strings.TrimSpace(privilege); privilege != "" {
+			privileges = append(privileges, privilege)
+		}
+	}
+	return privileges
+}
@@ -0,0 +1,363 @@
+package access
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// expireLayout is the date format accepted and shown for account expiry.
+const expireLayout = "2006-01-02"
+
+type userSummary struct {
+	UserID  string   `json:"userid"`
+	Name    string   `json:"name,omitempty"`
+	Email   string   `json:"email,omitempty"`
+	Enabled bool     `json:"enabled"`
+	Expire  string   `json:"expire,omitempty"`
+	Groups  []string `json:"groups"`
+	Comment string   `json:"comment,omitempty"`
+}
+
+func newUserCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "user",
+		Aliases: []string{"users"},
+		Short:   "Manage users",
+		Long: `Manage Proxmox users. User IDs include the realm, e.g. alice@pve or
+bob@pam. Passwords can only be set for users of the pve realm; pam users
+authenticate against the node's system accounts.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newUserListCmd(), newUserCreateCmd(), newUserUpdateCmd(), newUserDeleteCmd(), newUserPasswdCmd())
+	return cmd
+}
+
+func newUserListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List users",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			users, err := client.Users(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list users: %w", err)
+			}
+
+			summaries := make([]userSummary, 0, len(users))
+			for _, user := range users {
+				if user == nil {
+					continue
+				}
+				groups := []string(user.Groups)
+				if groups == nil {
+					groups = []string{}
+				}
+				summaries = append(summaries, userSummary{
+					UserID:  user.UserID,
+					Name:    strings.TrimSpace(user.Firstname + " " + user.Lastname),
+					Email:   user.Email,
+					Enabled: bool(user.Enable),
+					Expire:  formatExpire(user.Expire),
+					Groups:  groups,
+					Comment: user.Comment,
+				})
+			}
+			sort.Slice(summaries, func(i, j int) bool { return summaries[i].UserID < summaries[j].UserID })
+
+			if format == "json" {
+				return utility.PrintJSON(out, summaries)
+			}
+			fmt.Fprintf(out, "%-20s %-20s %-26s %-8s %-11s %s\n", "User", "Name", "Email", "Enabled", "Expires", "Groups")
+			fmt.Fprintf(out, "%-20s %-20s %-26s %-8s %-11s %s\n", "----", "----", "-----", "-------", "-------", "------")
+			for _, summary := range summaries {
+				fmt.Fprintf(out, "%-20s %-20s %-26s %-8s %-11s %s\n", summary.UserID, utility.DashIfEmpty(summary.Name),
+					utility.DashIfEmpty(summary.Email), utility.YesNo(summary.Enabled), summary.Expire, utility.DashIfEmpty(strings.Join(summary.Groups, ",")))
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newUserCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <userid>",
+		Short: "Create a user",
+		Long: `Create a user. --password prompts for an initial password (or reads it
+from stdin when piped); it only applies to the pve realm.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			userID, err := validateUserID(args[0])
+			if err != nil {
+				return err
+			}
+			user := &proxmox.NewUser{UserID: userID, Enable: true}
+			if user.Email, err = flags.GetString("email"); err != nil {
+				return fmt.Errorf("get email flag: %w", err)
+			}
+			if user.Firstname, err = flags.GetString("firstname"); err != nil {
+				return fmt.Errorf("get firstname flag: %w", err)
+			}
+			if user.Lastname, err = flags.GetString("lastname"); err != nil {
+				return fmt.Errorf("get lastname flag: %w", err)
+			}
+			if user.Comment, err = flags.GetString("comment"); err != nil {
+				return fmt.Errorf("get comment flag: %w", err)
+			}
+			groups, err := flags.GetStringSlice("groups")
+			if err != nil {
+				return fmt.Errorf("get groups flag: %w", err)
+			}
+			user.Groups = proxmox.CSV(groups)
+			if user.Expire, err = expireFromFlags(cmd); err != nil {
+				return err
+			}
+			disabled, err := flags.GetBool("disable")
+			if err != nil {
+				return fmt.Errorf("get disable flag: %w", err)
+			}
+			user.Enable = !disabled
+			withPassword, err := flags.GetBool("password")
+			if err != nil {
+				return fmt.Errorf("get password flag: %w", err)
+			}
+			if withPassword {
+				if user.Password, err = utility.PromptSecret(cmd, fmt.Sprintf("Password for %s: ", userID)); err != nil {
+					return err
+				}
+				if user.Password == "" {
+					return fmt.Errorf("password cannot be empty")
+				}
+			}
+
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			if err := client.NewUser(cmd.Context(), user); err != nil {
+				return fmt.Errorf("create user %q: %w", userID, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "User %s created\n", userID)
+			return nil
+		},
+	}
+	addUserFlags(cmd)
+	cmd.Flags().Bool("disable", false, "Create the account disabled")
+	cmd.Flags().Bool("password", false, "Prompt for an initial password (pve realm)")
+	return cmd
+}
+
+func newUserUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <userid>",
+		Short: "Change a user's details, groups, or status",
+		Long: `Change a user. Only the flags given are sent. --groups replaces the user's
+groups unless --append is also given.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeUserArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			userID := args[0]
+			options := &proxmox.UserOptions{}
+			changed := false
+			for _, field := range []struct {
+				flag  string
+				value *string
+			}{
+				{"email", &options.Email},
+				{"firstname", &options.Firstname},
+				{"lastname", &options.Lastname},
+				{"comment", &options.Comment},
+			} {
+				if !flags.Changed(field.flag) {
+					continue
+				}
+				value, err := flags.GetString(field.flag)
+				if err != nil {
+					return fmt.Errorf("get %s flag: %w", field.flag, err)
+				}
+				*field.value = value
+				changed = true
+			}
+			if flags.Changed("groups") {
+				groups, err := flags.GetStringSlice("groups")
+				if err != nil {
+					return fmt.Errorf("get groups flag: %w", err)
+				}
+				appendGroups, err := flags.GetBool("append")
+				if err != nil {
+					return fmt.Errorf("get append flag: %w", err)
+				}
+				options.Groups = proxmox.CSV(groups)
+				options.Append = proxmox.IntOrBool(appendGroups)
+				changed = true
+			}
+			if flags.Changed("expire") {
+				expire, err := expireFromFlags(cmd)
+				if err != nil {
+					return err
+				}
+				if expire == 0 {
+					return fmt.Errorf("--expire needs a date (YYYY-MM-DD)")
+				}
+				options.Expire = expire
+				changed = true
+			}
+			if flags.Changed("enable") {
+				enable, err := flags.GetBool("enable")
+				if err != nil {
+					return fmt.Errorf("get enable flag: %w", err)
+				}
+				value := proxmox.IntOrBool(enable)
+				options.Enable = &value
+				changed = true
+			}
+			if !changed {
+				return fmt.Errorf("nothing to update: pass at least one of --email, --firstname, --lastname, --comment, --groups, --expire, --enable")
+			}
+
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			if err := client.UpdateUser(cmd.Context(), userID, options); err != nil {
+				return fmt.Errorf("update user %q: %w", userID, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "User %s updated\n", userID)
+			return nil
+		},
+	}
+	addUserFlags(cmd)
+	cmd.Flags().Bool("append", false, "Add --groups to the user's groups instead of replacing them")
+	cmd.Flags().Bool("enable", true, "Enable (--enable) or disable (--enable=false) the account")
+	return cmd
+}
+
+func newUserDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "delete <userid>",
+		Short:             "Delete a user",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeUserArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID := args[0]
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Delete user %s and its API tokens?", userID)); err != nil {
+				return err
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			if err := client.DeleteUser(cmd.Context(), userID); err != nil {
+				return fmt.Errorf("delete user %q: %w", userID, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "User %s deleted\n", userID)
+			return nil
+		},
+	}
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func newUserPasswdCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "passwd <userid>",
+		Short: "Set a user's password",
+		Long: `Set the password of a pve or pam realm user. Proxmox 8.1 and later ask for
+your own current password as confirmation first; leave it empty on older
+versions. Both are prompted for, or read line by line from stdin when piped.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeUserArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID := args[0]
+			confirmation, err := utility.PromptSecret(cmd, "Your current password: ")
+			if err != nil {
+				return err
+			}
+			password, err := utility.PromptSecret(cmd, fmt.Sprintf("New password for %s: ", userID))
+			if err != nil {
+				return err
+			}
+			if password == "" {
+				return fmt.Errorf("password cannot be empty")
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			if err := client.ChangePassword(cmd.Context(), userID, password, confirmation); err != nil {
+				return fmt.Errorf("set password of user %q: %w", userID, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Password of user %s changed\n", userID)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func addUserFlags(cmd *cobra.Command) {
+	cmd.Flags().String("email", "", "Email address")
+	cmd.Flags().String("firstname", "", "First name")
+	cmd.Flags().String("lastname", "", "Last name")
+	cmd.Flags().String("comment", "", "Comment")
+	cmd.Flags().StringSlice("groups", nil, "Groups (comma-separated)")
+	cmd.Flags().String("expire", "", "Account expiry date (YYYY-MM-DD)")
+	_ = cmd.RegisterFlagCompletionFunc("groups", completeGroups)
+}
+
+// validateUserID checks that a user ID carries a realm.
+func validateUserID(userID string) (string, error) {
+	userID = strings.TrimSpace(userID)
+	name, realm, ok := strings.Cut(userID, "@")
+	if !ok || name == "" || realm == "" {
+		return "", fmt.Errorf("user ID %q must have the form 'name@realm', e.g. alice@pve", userID)
+	}
+	return userID, nil
+}
+
+// expireFromFlags converts --expire to a Unix timestamp, 0 meaning never.
+func expireFromFlags(cmd *cobra.Command) (int, error) {
+	value, err := cmd.Flags().GetString("expire")
+	if err != nil {
+		return 0, fmt.Errorf("get expire flag: %w", err)
+	}
+	if value == "" {
+		return 0, nil
+	}
+	date, err := time.ParseInLocation(expireLayout, value, time.Local)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --expire %q: use YYYY-MM-DD", value)
+	}
+	return int(date.Unix()), nil
+}
+
+func formatExpire(expire int) string {
+	if expire == 0 {
+		return "never"
+	}
+	return time.Unix(int64(expire), 0).Format(expireLayout)
+}
+
+func completeUserArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeUsers(cmd, args, toComplete)
+}
@@ -2,27 +2,20 @@ package auth
 
 import (
 	"bytes"
+	"errors"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
-	"github.com/spf13/cobra"
+	"github.com/luthermonson/go-proxmox"
 	"github.com/spf13/viper"
-)
+	"go.uber.org/mock/gomock"
 
-func TestPromptSecretFromReader(t *testing.T) {
-	cmd := &cobra.Command{Use: "test"}
-	cmd.SetIn(strings.NewReader("  s3cret \n"))
-	var out bytes.Buffer
-	cmd.SetOut(&out)
-
-	secret, err := promptSecret(cmd, "Secret: ")
-	if err != nil {
-		t.Fatal(err)
-	}
-	if secret != "s3cret" {
-		t.Fatalf("promptSecret = %q, want %q", secret, "s3cret")
-	}
-}
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/Adz-ai/proxmox-cli/test/mocks"
+)
 
 func TestTokenRejectsMalformedTokenID(t *testing.T) {
 	viper.Reset()
@@ -72,3 +65,185 @@ func TestLogoutWhenNotLoggedIn(t *testing.T) {
 		t.Fatalf("unexpected output:\n%s", out.String())
 	}
 }
+
+func setupTokenMocks(t *testing.T, ctrl *gomock.Controller) *mocks.MockProxmoxClientInterface {
+	t.Helper()
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	t.Setenv("PROXMOX_CLI_CONFIG", filepath.Join(t.TempDir(), "config.json"))
+	viper.Set("server_url", "https://pve.example.com:8006")
+	viper.Set("api_token.token_id", "ci@pve!deploy")
+	viper.Set("api_token.secret", "old-secret")
+
+	client := mocks.NewMockProxmoxClientInterface(ctrl)
+	utility.SetClientFactory(func() interfaces.ProxmoxClientInterface { return client })
+	t.Cleanup(utility.ResetClientFactory)
+	return client
+}
+
+func runAuth(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestTokenCreateShowsSecretAndSaves(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupTokenMocks(t, ctrl)
+
+	client.EXPECT().NewAPIToken(gomock.Any(), "alice@pve", proxmox.Token{TokenID: "laptop", Comment: "dev", Privsep: false}).
+		Return(proxmox.NewAPIToken{FullTokenID: "alice@pve!laptop", Value: "new-secret"}, nil)
+	client.EXPECT().Version(gomock.Any()).Return(&proxmox.Version{Version: "8.2"}, nil)
+
+	out, err := runAuth(t, "token", "create", "--user", "alice@pve", "--name", "laptop", "--comment", "dev", "--privsep=false", "--save")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "Secret: new-secret") || !strings.Contains(out, "saved to context") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+	if utility.ContextString("api_token.token_id") != "alice@pve!laptop" || utility.ContextString("api_token.secret") != "new-secret" {
+		t.Fatal("expected the new token to be stored")
+	}
+}
+
+func TestTokenCreateShowsSecretWhenVerificationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupTokenMocks(t, ctrl)
+
+	client.EXPECT().NewAPIToken(gomock.Any(), "alice@pve", gomock.Any()).
+		Return(proxmox.NewAPIToken{FullTokenID: "alice@pve!laptop", Value: "new-secret"}, nil)
+	client.EXPECT().Version(gomock.Any()).Return(nil, errors.New("401 Unauthorized"))
+
+	out, err := runAuth(t, "token", "create", "--user", "alice@pve", "--name", "laptop", "--save")
+	if err == nil || !strings.Contains(err.Error(), "not saved") {
+		t.Fatalf("expected verification failure, got %v", err)
+	}
+	if !strings.Contains(out, "Secret: new-secret") {
+		t.Fatalf("expected the secret before the error:\n%s", out)
+	}
+	if utility.ContextString("api_token.token_id") != "ci@pve!deploy" {
+		t.Fatal("expected the stored token to stay unchanged")
+	}
+}
+
+func TestTokenListDefaultsToAuthenticatedUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupTokenMocks(t, ctrl)
+
+	client.EXPECT().APITokens(gomock.Any(), "ci@pve").Return(proxmox.Tokens{
+		{TokenID: "deploy", Privsep: true},
+		{TokenID: "backup", Comment: "nightly"},
+	}, nil)
+
+	out, err := runAuth(t, "token", "list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "ci@pve!deploy *") || !strings.Contains(out, "nightly") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestTokenRotateReplacesStoredToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupTokenMocks(t, ctrl)
+
+	client.EXPECT().APITokens(gomock.Any(), "ci@pve").Return(proxmox.Tokens{
+		{TokenID: "deploy", Comment: "CI", Expire: 1800000000, Privsep: true},
+	}, nil)
+	gomock.InOrder(
+		client.EXPECT().NewAPIToken(gomock.Any(), "ci@pve", proxmox.Token{TokenID: "deploy-2", Comment: "CI", Expire: 1800000000, Privsep: true}).
+			Return(proxmox.NewAPIToken{FullTokenID: "ci@pve!deploy-2", Value: "rotated"}, nil),
+		client.EXPECT().ACL(gomock.Any()).Return(proxmox.ACLs{
+			{Path: "/vms", Type: "token", UGID: "ci@pve!deploy", RoleID: "PVEVMAdmin", Propagate: true},
+			{Path: "/", Type: "token", UGID: "ci@pve!other", RoleID: "Administrator"},
+		}, nil),
+		client.EXPECT().UpdateACL(gomock.Any(), proxmox.ACLOptions{Path: "/vms", Roles: "PVEVMAdmin", Tokens: "ci@pve!deploy-2", Propagate: true}).Return(nil),
+		client.EXPECT().Version(gomock.Any()).Return(&proxmox.Version{Version: "8.2"}, nil),
+		client.EXPECT().DeleteAPIToken(gomock.Any(), "ci@pve", "deploy").Return(nil),
+	)
+
+	out, err := runAuth(t, "token", "rotate", "ci@pve!deploy", "--new-name", "deploy-2", "--yes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "Copied 1 ACL entries") || !strings.Contains(out, "New secret: rotated") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+	if utility.ContextString("api_token.token_id") != "ci@pve!deploy-2" || utility.ContextString("api_token.secret") != "rotated" {
+		t.Fatal("expected the rotated token to be stored")
+	}
+}
+
+func TestTokenRotateKeepsOldTokenWhenVerificationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupTokenMocks(t, ctrl)
+
+	client.EXPECT().APITokens(gomock.Any(), "ci@pve").Return(proxmox.Tokens{{TokenID: "deploy"}}, nil)
+	client.EXPECT().NewAPIToken(gomock.Any(), "ci@pve", gomock.Any()).
+		Return(proxmox.NewAPIToken{FullTokenID: "ci@pve!deploy-2", Value: "rotated"}, nil)
+	client.EXPECT().Version(gomock.Any()).Return(nil, errors.New("401 Unauthorized"))
+	client.EXPECT().DeleteAPIToken(gomock.Any(), "ci@pve", "deploy-2").Return(nil)
+
+	_, err := runAuth(t, "token", "rotate", "ci@pve!deploy", "--new-name", "deploy-2", "--yes")
+	if err == nil || !strings.Contains(err.Error(), "ci@pve!deploy was kept") {
+		t.Fatalf("expected verification failure, got %v", err)
+	}
+	if utility.ContextString("api_token.token_id") != "ci@pve!deploy" {
+		t.Fatal("expected the old token to stay stored")
+	}
+}
+
+func TestTokenRotateShowsSecretWhenRevokingOldTokenFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupTokenMocks(t, ctrl)
+
+	client.EXPECT().APITokens(gomock.Any(), "ci@pve").Return(proxmox.Tokens{{TokenID: "deploy"}}, nil)
+	client.EXPECT().NewAPIToken(gomock.Any(), "ci@pve", gomock.Any()).
+		Return(proxmox.NewAPIToken{FullTokenID: "ci@pve!deploy-2", Value: "rotated"}, nil)
+	client.EXPECT().Version(gomock.Any()).Return(&proxmox.Version{Version: "8.2"}, nil)
+	client.EXPECT().DeleteAPIToken(gomock.Any(), "ci@pve", "deploy").Return(errors.New("403 Forbidden"))
+
+	out, err := runAuth(t, "token", "rotate", "ci@pve!deploy", "--new-name", "deploy-2", "--yes")
+	if err == nil || !strings.Contains(err.Error(), "delete old API token ci@pve!deploy") {
+		t.Fatalf("expected revoke failure, got %v", err)
+	}
+	if !strings.Contains(out, "New secret: rotated") {
+		t.Fatalf("expected the secret before the error:\n%s", out)
+	}
+}
+
+func TestTokenRotateRemovesNewTokenWhenACLCopyFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupTokenMocks(t, ctrl)
+
+	client.EXPECT().APITokens(gomock.Any(), "ci@pve").Return(proxmox.Tokens{{TokenID: "deploy", Privsep: true}}, nil)
+	client.EXPECT().NewAPIToken(gomock.Any(), "ci@pve", gomock.Any()).
+		Return(proxmox.NewAPIToken{FullTokenID: "ci@pve!deploy-2", Value: "rotated"}, nil)
+	client.EXPECT().ACL(gomock.Any()).Return(nil, errors.New("403 Forbidden"))
+	client.EXPECT().DeleteAPIToken(gomock.Any(), "ci@pve", "deploy-2").Return(nil)
+
+	_, err := runAuth(t, "token", "rotate", "ci@pve!deploy", "--new-name", "deploy-2", "--yes")
+	if err == nil || !strings.Contains(err.Error(), "ci@pve!deploy-2 removed") {
+		t.Fatalf("expected ACL copy failure, got %v", err)
+	}
+	if utility.ContextString("api_token.token_id") != "ci@pve!deploy" {
+		t.Fatal("expected the old token to stay stored")
+	}
+}
+
+func TestRotatedNameReplacesPreviousSuffix(t *testing.T) {
+	now := time.Date(2026, 10, 19, 8, 30, 0, 0, time.UTC)
+	if got := rotatedName("ci", now); got != "ci-20261019083000" {
+		t.Fatalf("got %q", got)
+	}
+	if got := rotatedName("ci-20250101000000", now); got != "ci-20261019083000" {
+		t.Fatalf("got %q", got)
+	}
+}
@@ -5,13 +5,11 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/luthermonson/go-proxmox"
 	"github.com/spf13/cobra"
-	"golang.org/x/term"
 
 	"github.com/Adz-ai/proxmox-cli/cmd/utility"
 )
@@ -27,7 +25,7 @@ func newLoginCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("read username: %w", err)
 			}
-			password, err := promptSecret(cmd, "Enter Password: ")
+			password, err := utility.PromptSecret(cmd, "Enter Password: ")
 			if err != nil {
 				return err
 			}
@@ -46,30 +44,6 @@ func newLoginCmd() *cobra.Command {
 	return cmd
 }
 
-// promptSecret reads a secret without echoing when stdin is a terminal, and
-// falls back to a plain read for piped input.
-func promptSecret(cmd *cobra.Command, prompt string) (string, error) {
-	out := cmd.OutOrStdout()
-	in := cmd.InOrStdin()
-
-	if file, ok := in.(*os.File); ok && term.IsTerminal(int(file.Fd())) {
-		fmt.Fprint(out, prompt)
-		byteSecret, err := term.ReadPassword(int(file.Fd()))
-		if err != nil {
-			return "", fmt.Errorf("read secret: %w", err)
-		}
-		fmt.Fprintln(out)
-		return strings.TrimSpace(string(byteSecret)), nil
-	}
-
-	reader := bufio.NewReader(in)
-	secret, err := reader.ReadString('\n')
-	if err != nil && err != io.EOF {
-		return "", fmt.Errorf("read secret: %w", err)
-	}
-	return strings.TrimSpace(secret), nil
-}
-
 func authenticateWithProxmox(cmd *cobra.Command, username, password string) error {
 	out := cmd.OutOrStdout()
 	in := cmd.InOrStdin()
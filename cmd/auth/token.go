@@ -20,7 +20,8 @@ func newTokenCmd() *cobra.Command {
 
 Unlike session tickets, API tokens do not expire, which makes them the
 recommended way to use this CLI in scripts. Create one in the Proxmox web
-interface under Datacenter > Permissions > API Tokens, then run:
+interface under Datacenter > Permissions > API Tokens, or with
+'proxmox-cli auth token create', then run:
 
   proxmox-cli auth token -t 'user@realm!tokenname'
 
@@ -38,7 +39,7 @@ piped) so it does not end up in your shell history.`,
 				return fmt.Errorf("token ID must have the form 'user@realm!tokenname'")
 			}
 
-			secret, err := promptSecret(cmd, "Enter API token secret: ")
+			secret, err := utility.PromptSecret(cmd, "Enter API token secret: ")
 			if err != nil {
 				return err
 			}
@@ -54,33 +55,16 @@ piped) so it does not end up in your shell history.`,
 			if err != nil {
 				return fmt.Errorf("invalid server URL: %w", err)
 			}
-
 			fmt.Fprintf(out, "Verifying API token against %s...\n", serverURL)
 
-			ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
-			defer cancel()
-
-			httpClient, err := utility.NewHTTPClient(utility.ContextBool("insecure"), utility.ContextString("ca_cert"))
-			if err != nil {
-				return fmt.Errorf("configure HTTP client: %w", err)
-			}
-			client := proxmox.NewClient(fmt.Sprintf("%s/api2/json", serverURL),
-				proxmox.WithHTTPClient(httpClient),
-				proxmox.WithAPIToken(tokenID, secret),
-			)
-			version, err := client.Version(ctx)
+			version, err := verifyToken(cmd.Context(), tokenID, secret)
 			if err != nil {
-				return fmt.Errorf("verify API token: %w", err)
+				return err
 			}
 
-			utility.ClearAuthTicket()
-			utility.ClearAPIToken()
-			utility.SetContextValue("api_token.token_id", tokenID)
-			utility.SetContextValue("api_token.secret", secret)
-			if err := utility.WriteConfig(); err != nil {
-				return fmt.Errorf("save API token: %w", err)
+			if err := saveToken(tokenID, secret); err != nil {
+				return err
 			}
-
 			fmt.Fprintln(out, "API token saved")
 			fmt.Fprintf(out, "Connected to Proxmox VE %s\n", version.Version)
 			return nil
@@ -91,5 +75,35 @@ piped) so it does not end up in your shell history.`,
 	if err := cmd.MarkFlagRequired("token-id"); err != nil {
 		panic(err)
 	}
+	cmd.AddCommand(newTokenCreateCmd(), newTokenListCmd(), newTokenRevokeCmd(), newTokenRotateCmd())
 	return cmd
 }
+
+// verifyToken checks that a token authenticates against the configured server.
+func verifyToken(ctx context.Context, tokenID, secret string) (*proxmox.Version, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	client, err := utility.TokenClient(tokenID, secret)
+	if err != nil {
+		return nil, err
+	}
+	version, err := client.Version(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("verify API token: %w", err)
+	}
+	return version, nil
+}
+
+// saveToken stores a token in the active context, replacing any session
+// ticket or previously stored token.
+func saveToken(tokenID, secret string) error {
+	utility.ClearAuthTicket()
+	utility.ClearAPIToken()
+	utility.SetContextValue("api_token.token_id", tokenID)
+	utility.SetContextValue("api_token.secret", secret)
+	if err := utility.WriteConfig(); err != nil {
+		return fmt.Errorf("save API token: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,429 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+)
+
+const expireLayout = "2006-01-02"
+
+// rotatedSuffix matches the timestamp rotate appends to token names, so a
+// rotated token is rotated to a fresh suffix instead of accumulating them.
+var rotatedSuffix = regexp.MustCompile(`-\d{14}$`)
+
+type tokenSummary struct {
+	TokenID string `json:"tokenid"`
+	Privsep bool   `json:"privsep"`
+	Expire  string `json:"expire"`
+	Comment string `json:"comment,omitempty"`
+	Active  bool   `json:"active"`
+}
+
+type createdToken struct {
+	TokenID string `json:"tokenid"`
+	Secret  string `json:"secret"`
+}
+
+func newTokenCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an API token on the server",
+		Long: `Create an API token for a user. The secret is shown once; pass --save to
+also store the token in the active context.
+
+With privilege separation (the default) the token starts with no
+permissions; grant them with 'proxmox-cli access acl set --token ...'.
+
+  proxmox-cli auth token create --user ci@pve --name deploy --expire 2027-01-31 --save`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			flags := cmd.Flags()
+			userID, err := userFromFlag(cmd)
+			if err != nil {
+				return err
+			}
+			name, err := flags.GetString("name")
+			if err != nil {
+				return fmt.Errorf("get name flag: %w", err)
+			}
+			if name == "" || strings.ContainsAny(name, "!@") {
+				return fmt.Errorf("--name must be a plain token name such as 'ci'")
+			}
+			token := proxmox.Token{TokenID: name}
+			if token.Comment, err = flags.GetString("comment"); err != nil {
+				return fmt.Errorf("get comment flag: %w", err)
+			}
+			privsep, err := flags.GetBool("privsep")
+			if err != nil {
+				return fmt.Errorf("get privsep flag: %w", err)
+			}
+			token.Privsep = proxmox.IntOrBool(privsep)
+			expire, err := flags.GetString("expire")
+			if err != nil {
+				return fmt.Errorf("get expire flag: %w", err)
+			}
+			if expire != "" {
+				date, err := time.ParseInLocation(expireLayout, expire, time.Local)
+				if err != nil {
+					return fmt.Errorf("invalid --expire %q: use YYYY-MM-DD", expire)
+				}
+				token.Expire = int(date.Unix())
+			}
+			save, err := flags.GetBool("save")
+			if err != nil {
+				return fmt.Errorf("get save flag: %w", err)
+			}
+
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			created, err := client.NewAPIToken(cmd.Context(), userID, token)
+			if err != nil {
+				return fmt.Errorf("create API token %s!%s: %w", userID, name, err)
+			}
+			result := createdToken{TokenID: created.FullTokenID, Secret: created.Value}
+			if result.TokenID == "" {
+				result.TokenID = userID + "!" + name
+			}
+
+			// Show the secret before verifying or saving it, so it is not lost
+			// when either step fails.
+			if format == "json" {
+				if err := utility.PrintJSON(out, result); err != nil {
+					return err
+				}
+			} else {
+				fmt.Fprintf(out, "Created API token %s\n", result.TokenID)
+				fmt.Fprintf(out, "Secret: %s\n", result.Secret)
+				fmt.Fprintln(out, "The secret cannot be shown again; store it now.")
+			}
+
+			if save {
+				if _, err := verifyToken(cmd.Context(), result.TokenID, result.Secret); err != nil {
+					return fmt.Errorf("%w; the token was created but not saved", err)
+				}
+				if err := saveToken(result.TokenID, result.Secret); err != nil {
+					return err
+				}
+			}
+			if format == "json" {
+				return nil
+			}
+			if save {
+				fmt.Fprintf(out, "API token saved to context %q\n", utility.ActiveContext())
+			}
+			if privsep {
+				fmt.Fprintf(out, "The token has no permissions yet; grant them with 'proxmox-cli access acl set --path <path> --role <role> --token %s'\n", result.TokenID)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String("user", "", "User that owns the token (default: the authenticated user)")
+	cmd.Flags().String("name", "", "Token name (required)")
+	cmd.Flags().Bool("privsep", true, "Restrict the token to its own ACLs instead of the user's permissions")
+	cmd.Flags().String("expire", "", "Expiry date (YYYY-MM-DD); never expires by default")
+	cmd.Flags().String("comment", "", "Comment")
+	cmd.Flags().Bool("save", false, "Store the new token in the active context")
+	utility.AddOutputFlag(cmd)
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func newTokenListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List a user's API tokens",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			userID, err := userFromFlag(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			tokens, err := client.APITokens(cmd.Context(), userID)
+			if err != nil {
+				return fmt.Errorf("list API tokens of %s: %w", userID, err)
+			}
+
+			stored := utility.ContextString("api_token.token_id")
+			summaries := make([]tokenSummary, 0, len(tokens))
+			for _, token := range tokens {
+				if token == nil {
+					continue
+				}
+				tokenID := userID + "!" + token.TokenID
+				summaries = append(summaries, tokenSummary{
+					TokenID: tokenID,
+					Privsep: bool(token.Privsep),
+					Expire:  formatExpire(token.Expire),
+					Comment: token.Comment,
+					Active:  tokenID == stored,
+				})
+			}
+			sort.Slice(summaries, func(i, j int) bool { return summaries[i].TokenID < summaries[j].TokenID })
+
+			if format == "json" {
+				return utility.PrintJSON(out, summaries)
+			}
+			if len(summaries) == 0 {
+				fmt.Fprintf(out, "No API tokens for %s\n", userID)
+				return nil
+			}
+			fmt.Fprintf(out, "%-32s %-8s %-11s %s\n", "Token", "Privsep", "Expires", "Comment")
+			fmt.Fprintf(out, "%-32s %-8s %-11s %s\n", "-----", "-------", "-------", "-------")
+			active := false
+			for _, summary := range summaries {
+				name := summary.TokenID
+				if summary.Active {
+					name += " *"
+					active = true
+				}
+				comment := summary.Comment
+				if comment == "" {
+					comment = "-"
+				}
+				fmt.Fprintf(out, "%-32s %-8t %-11s %s\n", name, summary.Privsep, summary.Expire, comment)
+			}
+			if active {
+				fmt.Fprintln(out, "\n* token stored in the active context")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String("user", "", "User whose tokens to list (default: the authenticated user)")
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newTokenRevokeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke <user@realm!tokenname>",
+		Short: "Delete an API token on the server",
+		Long: `Delete an API token. Anything still using it stops working immediately.
+If the token is stored in the active context it is removed from there too.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			userID, name, err := splitTokenID(args[0])
+			if err != nil {
+				return err
+			}
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Revoke API token %s?", args[0])); err != nil {
+				return err
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			if err := client.DeleteAPIToken(cmd.Context(), userID, name); err != nil {
+				return fmt.Errorf("revoke API token %s: %w", args[0], err)
+			}
+			fmt.Fprintf(out, "API token %s revoked\n", args[0])
+
+			if utility.ContextString("api_token.token_id") == args[0] {
+				utility.ClearAPIToken()
+				if err := utility.WriteConfig(); err != nil {
+					return fmt.Errorf("remove revoked API token from configuration: %w", err)
+				}
+				fmt.Fprintf(out, "Removed it from context %q; authenticate again with 'proxmox-cli auth login' or 'proxmox-cli auth token -t'\n", utility.ActiveContext())
+			}
+			return nil
+		},
+	}
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func newTokenRotateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate <user@realm!tokenname>",
+		Short: "Replace an API token with a new one",
+		Long: `Create a replacement for an API token with the same comment, expiry, and
+privilege separation, copy the old token's ACL entries to it, and verify it
+can authenticate. If the old token is stored in the active context the new
+one replaces it there. Only then is the old token deleted.
+
+Token names cannot be reused while the old token exists, so the new token
+is named <name>-<timestamp> unless --new-name is given.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			out := cmd.OutOrStdout()
+			oldID := args[0]
+			userID, name, err := splitTokenID(oldID)
+			if err != nil {
+				return err
+			}
+			newName, err := cmd.Flags().GetString("new-name")
+			if err != nil {
+				return fmt.Errorf("get new-name flag: %w", err)
+			}
+			if newName == "" {
+				newName = rotatedName(name, time.Now())
+			}
+			if newName == name {
+				return fmt.Errorf("--new-name must differ from the current token name")
+			}
+			newID := userID + "!" + newName
+			prompt := fmt.Sprintf("Rotate API token %s to %s? The old token is deleted once the new one is verified.", oldID, newID)
+			if err := utility.ConfirmAction(cmd, prompt); err != nil {
+				return err
+			}
+
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			tokens, err := client.APITokens(ctx, userID)
+			if err != nil {
+				return fmt.Errorf("list API tokens of %s: %w", userID, err)
+			}
+			var old *proxmox.Token
+			for _, token := range tokens {
+				if token != nil && token.TokenID == name {
+					old = token
+					break
+				}
+			}
+			if old == nil {
+				return fmt.Errorf("API token %s not found", oldID)
+			}
+
+			created, err := client.NewAPIToken(ctx, userID, proxmox.Token{
+				TokenID: newName,
+				Comment: old.Comment,
+				Expire:  old.Expire,
+				Privsep: old.Privsep,
+			})
+			if err != nil {
+				return fmt.Errorf("create API token %s: %w", newID, err)
+			}
+			fmt.Fprintf(out, "Created API token %s\n", newID)
+
+			// discard removes the new token when it cannot replace the old one.
+			discard := func(err error) error {
+				if cleanupErr := client.DeleteAPIToken(ctx, userID, newName); cleanupErr != nil {
+					return fmt.Errorf("%w; %s was kept, and removing %s failed: %v", err, oldID, newID, cleanupErr)
+				}
+				return fmt.Errorf("%w; %s was kept and %s removed", err, oldID, newID)
+			}
+			if bool(old.Privsep) {
+				copied, err := copyTokenACLs(ctx, client, oldID, newID)
+				if err != nil {
+					return discard(err)
+				}
+				fmt.Fprintf(out, "Copied %d ACL entries\n", copied)
+			}
+			if _, err := verifyToken(ctx, newID, created.Value); err != nil {
+				return discard(err)
+			}
+			// Show the secret before anything else can fail, since the new
+			// token is kept from here on and the secret cannot be read again.
+			fmt.Fprintf(out, "New secret: %s\n", created.Value)
+
+			if utility.ContextString("api_token.token_id") == oldID {
+				if err := saveToken(newID, created.Value); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "API token saved to context %q\n", utility.ActiveContext())
+			}
+			if err := client.DeleteAPIToken(ctx, userID, name); err != nil {
+				return fmt.Errorf("delete old API token %s: %w", oldID, err)
+			}
+			fmt.Fprintf(out, "API token %s revoked\n", oldID)
+			fmt.Fprintln(out, "The secret cannot be shown again; update anything else that used the old token.")
+			return nil
+		},
+	}
+	cmd.Flags().String("new-name", "", "Name of the replacement token (default: <name>-<timestamp>)")
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+// copyTokenACLs grants the new token every role the old token holds, since a
+// privilege-separated token starts without permissions.
+func copyTokenACLs(ctx context.Context, client interfaces.ProxmoxClientInterface, oldID, newID string) (int, error) {
+	acls, err := client.ACL(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list ACL entries: %w", err)
+	}
+	copied := 0
+	for _, acl := range acls {
+		if acl == nil || acl.Type != "token" || acl.UGID != oldID {
+			continue
+		}
+		options := proxmox.ACLOptions{Path: acl.Path, Roles: acl.RoleID, Tokens: newID, Propagate: acl.Propagate}
+		if err := client.UpdateACL(ctx, options); err != nil {
+			return copied, fmt.Errorf("grant %s on %s to %s: %w", acl.RoleID, acl.Path, newID, err)
+		}
+		copied++
+	}
+	return copied, nil
+}
+
+func rotatedName(name string, now time.Time) string {
+	return rotatedSuffix.ReplaceAllString(name, "") + "-" + now.Format("20060102150405")
+}
+
+// splitTokenID splits 'user@realm!tokenname' into its user and token name.
+func splitTokenID(tokenID string) (string, string, error) {
+	userID, name, ok := strings.Cut(strings.TrimSpace(tokenID), "!")
+	if !ok || userID == "" || name == "" || !strings.Contains(userID, "@") {
+		return "", "", fmt.Errorf("token ID must have the form 'user@realm!tokenname'")
+	}
+	return userID, name, nil
+}
+
+// userFromFlag returns the --user flag, defaulting to the user the active
+// context is authenticated as.
+func userFromFlag(cmd *cobra.Command) (string, error) {
+	userID, err := cmd.Flags().GetString("user")
+	if err != nil {
+		return "", fmt.Errorf("get user flag: %w", err)
+	}
+	if userID != "" {
+		return userID, nil
+	}
+	if tokenID := utility.ContextString("api_token.token_id"); tokenID != "" {
+		userID, _, _ = strings.Cut(tokenID, "!")
+		return userID, nil
+	}
+	// Session tickets have the form PVE:user@realm:...
+	if fields := strings.Split(utility.ContextString("auth_ticket.ticket"), ":"); len(fields) > 2 && fields[1] != "" {
+		return fields[1], nil
+	}
+	return "", fmt.Errorf("--user is required when not authenticated")
+}
+
+func formatExpire(expire int) string {
+	if expire == 0 {
+		return "never"
+	}
+	return time.Unix(int64(expire), 0).Format(expireLayout)
+}
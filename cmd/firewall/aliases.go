@@ -0,0 +1,173 @@
+package firewall
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+func newAliasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "alias",
+		Aliases: []string{"aliases"},
+		Short:   "Manage firewall aliases",
+		Long: `Manage named addresses and networks at cluster or guest level. Rules refer
+to them by name, e.g. --dest dbserver.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newAliasListCmd(), newAliasCreateCmd(), newAliasUpdateCmd(), newAliasDeleteCmd())
+	return cmd
+}
+
+func addAliasScopeFlags(cmd *cobra.Command) {
+	addScopeFlags(cmd, scopeCluster, scopeGuest)
+}
+
+func newAliasListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List firewall aliases",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			aliases, err := firewall.Aliases(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list aliases of %s: %w", target.label, err)
+			}
+			sort.Slice(aliases, func(i, j int) bool { return aliases[i].Name < aliases[j].Name })
+
+			if format == "json" {
+				return utility.PrintJSON(out, aliases)
+			}
+			if len(aliases) == 0 {
+				fmt.Fprintf(out, "No aliases for %s\n", target.label)
+				return nil
+			}
+			fmt.Fprintf(out, "%-24s %-32s %s\n", "Name", "CIDR", "Comment")
+			fmt.Fprintf(out, "%-24s %-32s %s\n", "----", "----", "-------")
+			for _, alias := range aliases {
+				fmt.Fprintf(out, "%-24s %-32s %s\n", alias.Name, alias.Cidr, utility.DashIfEmpty(alias.Comment))
+			}
+			return nil
+		},
+	}
+	addAliasScopeFlags(cmd)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newAliasCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <name> <cidr>",
+		Short: "Create a firewall alias",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			comment, err := cmd.Flags().GetString("comment")
+			if err != nil {
+				return fmt.Errorf("get comment flag: %w", err)
+			}
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			alias := &proxmox.FirewallAliasCreateOption{Name: args[0], CIDR: args[1], Comment: comment}
+			if err := firewall.NewAlias(cmd.Context(), alias); err != nil {
+				return fmt.Errorf("create alias %q on %s: %w", args[0], target.label, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Alias %s (%s) created on %s\n", args[0], args[1], target.label)
+			return nil
+		},
+	}
+	addAliasScopeFlags(cmd)
+	cmd.Flags().String("comment", "", "Comment")
+	return cmd
+}
+
+func newAliasUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <name>",
+		Short: "Change the address or comment of a firewall alias",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if !flags.Changed("cidr") && !flags.Changed("comment") {
+				return fmt.Errorf("nothing to update: pass --cidr or --comment")
+			}
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			// The API replaces both fields, so unchanged ones are read back first.
+			aliases, err := firewall.Aliases(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list aliases of %s: %w", target.label, err)
+			}
+			var current *proxmox.FirewallAlias
+			for _, alias := range aliases {
+				if alias != nil && alias.Name == args[0] {
+					current = alias
+					break
+				}
+			}
+			if current == nil {
+				return fmt.Errorf("alias %q not found on %s", args[0], target.label)
+			}
+			cidr, comment := current.Cidr, current.Comment
+			if flags.Changed("cidr") {
+				if cidr, err = flags.GetString("cidr"); err != nil {
+					return fmt.Errorf("get cidr flag: %w", err)
+				}
+			}
+			if flags.Changed("comment") {
+				if comment, err = flags.GetString("comment"); err != nil {
+					return fmt.Errorf("get comment flag: %w", err)
+				}
+			}
+			if err := firewall.UpdateAlias(cmd.Context(), args[0], cidr, comment); err != nil {
+				return fmt.Errorf("update alias %q of %s: %w", args[0], target.label, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Alias %s updated\n", args[0])
+			return nil
+		},
+	}
+	addAliasScopeFlags(cmd)
+	cmd.Flags().String("cidr", "", "New address or network")
+	cmd.Flags().String("comment", "", "New comment")
+	return cmd
+}
+
+func newAliasDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a firewall alias",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Delete alias %s of %s?", args[0], target.label)); err != nil {
+				return err
+			}
+			if err := firewall.DeleteAlias(cmd.Context(), args[0]); err != nil {
+				return fmt.Errorf("delete alias %q of %s: %w", args[0], target.label, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Alias %s deleted from %s\n", args[0], target.label)
+			return nil
+		},
+	}
+	addAliasScopeFlags(cmd)
+	utility.AddYesFlag(cmd)
+	return cmd
+}
@@ -0,0 +1,216 @@
+package firewall
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// ruleFile is the document read by firewall apply.
+type ruleFile struct {
+	Rules []ruleSpec `yaml:"rules"`
+}
+
+// ruleChange is one line of an apply plan. Kept rules carry their live
+// position, deleted rules the position they are removed from, and added
+// rules the position they are inserted at.
+type ruleChange struct {
+	op   byte // ' ', '-', or '+'
+	pos  int
+	rule ruleSpec
+}
+
+func newApplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Make a firewall's rules match a YAML file",
+		Long: `Compare the rules in a YAML file with the live rule list, print the
+difference, and after confirmation delete and insert rules until the live
+list matches the file in content and order:
+
+  rules:
+    - type: in
+      action: ACCEPT
+      macro: SSH
+      source: +management
+    - type: in
+      action: ACCEPT
+      proto: tcp
+      dport: 80,443
+      comment: web
+    - type: group
+      action: webservers
+
+Rules are matched as a whole, so a changed or reordered rule shows up as a
+deletion plus an insertion. The file supports the same ${name} variables,
+--set, and --values as the create specs.
+
+  proxmox-cli firewall apply --vmid 100 -f rules.yaml --dry-run`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			out := cmd.OutOrStdout()
+			file, err := cmd.Flags().GetString("file")
+			if err != nil {
+				return fmt.Errorf("get file flag: %w", err)
+			}
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return fmt.Errorf("get dry-run flag: %w", err)
+			}
+			desired, err := loadRuleFile(cmd, file)
+			if err != nil {
+				return err
+			}
+
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			rules, err := firewall.Rules(ctx)
+			if err != nil {
+				return fmt.Errorf("list firewall rules of %s: %w", target.label, err)
+			}
+			plan := planRules(liveRules(rules), desired)
+			added, deleted := printPlan(out, plan)
+			if added == 0 && deleted == 0 {
+				fmt.Fprintf(out, "Firewall rules of %s are up to date\n", target.label)
+				return nil
+			}
+			fmt.Fprintf(out, "\n%d to add, %d to delete\n", added, deleted)
+			if dryRun {
+				return nil
+			}
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Apply these changes to the %s firewall?", target.label)); err != nil {
+				return err
+			}
+
+			// Delete from the bottom so the positions of the remaining
+			// deletions stay valid, then insert from the top so every
+			// insertion position is already final.
+			for i := len(plan) - 1; i >= 0; i-- {
+				if plan[i].op != '-' {
+					continue
+				}
+				if err := firewall.DeleteRule(ctx, plan[i].pos); err != nil {
+					return fmt.Errorf("delete firewall rule %d of %s: %w", plan[i].pos, target.label, err)
+				}
+			}
+			for _, change := range plan {
+				if change.op != '+' {
+					continue
+				}
+				params := change.rule.params()
+				params["pos"] = change.pos
+				if err := firewall.NewRule(ctx, params); err != nil {
+					return fmt.Errorf("add firewall rule at %d of %s: %w", change.pos, target.label, err)
+				}
+			}
+			fmt.Fprintf(out, "Applied %d changes to %s\n", added+deleted, target.label)
+			return nil
+		},
+	}
+	addRuleScopeFlags(cmd)
+	cmd.Flags().StringP("file", "f", "", "YAML file with the desired rules (required)")
+	cmd.Flags().Bool("dry-run", false, "Only print the difference")
+	utility.AddSpecValueFlags(cmd)
+	utility.AddYesFlag(cmd)
+	if err := cmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func loadRuleFile(cmd *cobra.Command, path string) ([]ruleSpec, error) {
+	values, err := utility.SpecValuesFromFlags(cmd)
+	if err != nil {
+		return nil, err
+	}
+	documents, err := utility.LoadSpecs(path, values)
+	if err != nil {
+		return nil, fmt.Errorf("read rule file %q: %w", path, err)
+	}
+	if len(documents) != 1 {
+		return nil, fmt.Errorf("rule file %q must contain exactly one document", path)
+	}
+	data, err := yaml.Marshal(documents[0])
+	if err != nil {
+		return nil, fmt.Errorf("read rule file %q: %w", path, err)
+	}
+	var file ruleFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse rule file %q: %w", path, err)
+	}
+	rules := make([]ruleSpec, 0, len(file.Rules))
+	for i, rule := range file.Rules {
+		normalized, err := rule.normalize()
+		if err != nil {
+			return nil, fmt.Errorf("rule %d in %q: %w", i+1, path, err)
+		}
+		rules = append(rules, normalized)
+	}
+	return rules, nil
+}
+
+// planRules aligns the live and desired rule lists on their longest common
+// subsequence: live rules outside it are deleted and desired rules outside
+// it are inserted, which keeps every other rule in place.
+func planRules(live, desired []ruleSpec) []ruleChange {
+	keys := func(rules []ruleSpec) []string {
+		described := make([]string, len(rules))
+		for i, rule := range rules {
+			described[i] = rule.describe()
+		}
+		return described
+	}
+	a, b := keys(live), keys(desired)
+
+	// common[i][j] is the LCS length of a[i:] and b[j:].
+	common := make([][]int, len(a)+1)
+	for i := range common {
+		common[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	var plan []ruleChange
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			plan = append(plan, ruleChange{op: ' ', pos: live[i].Pos, rule: live[i]})
+			i++
+			j++
+		case j < len(b) && (i == len(a) || common[i][j+1] >= common[i+1][j]):
+			plan = append(plan, ruleChange{op: '+', pos: j, rule: desired[j]})
+			j++
+		default:
+			plan = append(plan, ruleChange{op: '-', pos: live[i].Pos, rule: live[i]})
+			i++
+		}
+	}
+	return plan
+}
+
+func printPlan(out io.Writer, plan []ruleChange) (added, deleted int) {
+	for _, change := range plan {
+		switch change.op {
+		case '+':
+			added++
+		case '-':
+			deleted++
+		}
+		fmt.Fprintf(out, "%c %3d  %s\n", change.op, change.pos, change.rule.describe())
+	}
+	return added, deleted
+}
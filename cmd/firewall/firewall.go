@@ -0,0 +1,174 @@
+// Package firewall implements firewall management at cluster, node, and
+// guest level: rules, IP sets, aliases, security groups, options, and logs.
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	scopeCluster = "cluster"
+	scopeNode    = "node"
+	scopeGuest   = "guest"
+	scopeGroup   = "group"
+)
+
+var scopeFlags = map[string]string{
+	scopeCluster: "--cluster",
+	scopeNode:    "--node",
+	scopeGuest:   "--vmid",
+	scopeGroup:   "--group",
+}
+
+// scope identifies which firewall a command works on.
+type scope struct {
+	kind  string
+	path  string
+	label string
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "firewall",
+		Aliases: []string{"fw"},
+		Short:   "Manage firewall rules, IP sets, aliases, and options",
+		Long: `Manage the Proxmox firewall. Most commands work on one scope, chosen with
+--cluster, --node <node>, or --vmid <id>:
+
+  proxmox-cli firewall rules list --vmid 100
+  proxmox-cli firewall options set --cluster --enable --policy-in DROP
+  proxmox-cli firewall apply --vmid 100 -f rules.yaml
+  proxmox-cli firewall log --vmid 100 --follow`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newRulesCmd())
+	cmd.AddCommand(newApplyCmd())
+	cmd.AddCommand(newIPSetCmd())
+	cmd.AddCommand(newAliasCmd())
+	cmd.AddCommand(newGroupCmd())
+	cmd.AddCommand(newOptionsCmd())
+	cmd.AddCommand(newLogCmd())
+	return cmd
+}
+
+// addScopeFlags registers the flags selecting a firewall scope. Only the
+// scopes a command supports get a flag.
+func addScopeFlags(cmd *cobra.Command, kinds ...string) {
+	for _, kind := range kinds {
+		switch kind {
+		case scopeCluster:
+			cmd.Flags().Bool("cluster", false, "Use the cluster-wide firewall")
+		case scopeNode:
+			cmd.Flags().StringP("node", "n", "", "Use the firewall of this node")
+			utility.RegisterNodeFlagCompletion(cmd, "node")
+		case scopeGuest:
+			cmd.Flags().IntP("vmid", "i", 0, "Use the firewall of this VM or container")
+		case scopeGroup:
+			cmd.Flags().String("group", "", "Use the rules of this cluster security group")
+			_ = cmd.RegisterFlagCompletionFunc("group", completeGroups)
+		}
+	}
+}
+
+// firewallFromFlags resolves the scope flags to a firewall. Exactly one scope
+// must be given; guests are looked up to find their node and type.
+func firewallFromFlags(cmd *cobra.Command) (interfaces.FirewallInterface, *scope, error) {
+	flags := cmd.Flags()
+	var kinds []string
+	selected := ""
+	for _, kind := range []string{scopeCluster, scopeNode, scopeGuest, scopeGroup} {
+		flag := flags.Lookup(strings.TrimPrefix(scopeFlags[kind], "--"))
+		if flag == nil {
+			continue
+		}
+		kinds = append(kinds, kind)
+		if !flag.Changed {
+			continue
+		}
+		if selected != "" {
+			return nil, nil, fmt.Errorf("%s and %s cannot be combined", scopeFlags[selected], scopeFlags[kind])
+		}
+		selected = kind
+	}
+	if selected == "" {
+		names := make([]string, 0, len(kinds))
+		for _, kind := range kinds {
+			names = append(names, scopeFlags[kind])
+		}
+		return nil, nil, fmt.Errorf("choose a firewall with %s", strings.Join(names, ", "))
+	}
+
+	client, err := utility.AuthenticatedClient()
+	if err != nil {
+		return nil, nil, fmt.Errorf("authenticate Proxmox client: %w", err)
+	}
+	target := &scope{kind: selected}
+	switch selected {
+	case scopeCluster:
+		if enabled, err := flags.GetBool("cluster"); err != nil || !enabled {
+			return nil, nil, fmt.Errorf("choose a firewall with %s", scopeFlags[scopeCluster])
+		}
+		target.path, target.label = clusterFirewallPath, "cluster"
+	case scopeNode:
+		node, err := flags.GetString("node")
+		if err != nil {
+			return nil, nil, fmt.Errorf("get node flag: %w", err)
+		}
+		if node = strings.TrimSpace(node); node == "" {
+			return nil, nil, fmt.Errorf("--node cannot be empty")
+		}
+		target.path, target.label = "/nodes/"+node+"/firewall", "node "+node
+	case scopeGuest:
+		vmid, err := flags.GetInt("vmid")
+		if err != nil {
+			return nil, nil, fmt.Errorf("get vmid flag: %w", err)
+		}
+		if target.path, target.label, err = guestFirewallPath(cmd.Context(), client, vmid); err != nil {
+			return nil, nil, err
+		}
+	case scopeGroup:
+		group, err := flags.GetString("group")
+		if err != nil {
+			return nil, nil, fmt.Errorf("get group flag: %w", err)
+		}
+		if group = strings.TrimSpace(group); group == "" {
+			return nil, nil, fmt.Errorf("--group cannot be empty")
+		}
+		target.path, target.label = clusterFirewallPath+"/groups/"+group, "security group "+group
+	}
+	return client.Firewall(target.path), target, nil
+}
+
+// guestFirewallPath finds the node and type of a guest, which the guest
+// firewall API is nested under.
+func guestFirewallPath(ctx context.Context, client interfaces.ProxmoxClientInterface, vmid int) (string, string, error) {
+	if vmid <= 0 {
+		return "", "", fmt.Errorf("--vmid must be positive")
+	}
+	cluster, err := client.Cluster(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("get cluster: %w", err)
+	}
+	resources, err := cluster.Resources(ctx, "vm")
+	if err != nil {
+		return "", "", fmt.Errorf("list cluster resources: %w", err)
+	}
+	for _, resource := range resources {
+		if resource == nil || resource.VMID != uint64(vmid) {
+			continue
+		}
+		label := fmt.Sprintf("VM %d", vmid)
+		if resource.Type == "lxc" {
+			label = fmt.Sprintf("CT %d", vmid)
+		}
+		return fmt.Sprintf("/nodes/%s/%s/%d/firewall", resource.Node, resource.Type, vmid), label, nil
+	}
+	return "", "", fmt.Errorf("guest %d not found", vmid)
+}
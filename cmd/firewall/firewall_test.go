@@ -0,0 +1,317 @@
+package firewall
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/viper"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/Adz-ai/proxmox-cli/test/mocks"
+)
+
+func setupFirewallMocks(t *testing.T, ctrl *gomock.Controller) *mocks.MockProxmoxClientInterface {
+	t.Helper()
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set("server_url", "https://pve.example.com:8006")
+	viper.Set("auth_ticket.ticket", "ticket")
+	viper.Set("auth_ticket.CSRFPreventionToken", "token")
+
+	client := mocks.NewMockProxmoxClientInterface(ctrl)
+	utility.SetClientFactory(func() interfaces.ProxmoxClientInterface { return client })
+	t.Cleanup(utility.ResetClientFactory)
+	return client
+}
+
+func runFirewall(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func expectFirewall(ctrl *gomock.Controller, client *mocks.MockProxmoxClientInterface, path string) *mocks.MockFirewallInterface {
+	firewall := mocks.NewMockFirewallInterface(ctrl)
+	client.EXPECT().Firewall(path).Return(firewall)
+	return firewall
+}
+
+func TestRulesListResolvesGuestFirewall(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupFirewallMocks(t, ctrl)
+
+	cluster := mocks.NewMockClusterInterface(ctrl)
+	client.EXPECT().Cluster(gomock.Any()).Return(cluster, nil)
+	cluster.EXPECT().Resources(gomock.Any(), "vm").Return(proxmox.ClusterResources{
+		{VMID: 100, Node: "pve1", Type: "qemu"},
+		{VMID: 200, Node: "pve2", Type: "lxc"},
+	}, nil)
+	firewall := expectFirewall(ctrl, client, "/nodes/pve2/lxc/200/firewall")
+	firewall.EXPECT().Rules(gomock.Any()).Return([]*proxmox.FirewallRule{
+		{Pos: 1, Type: "in", Action: "DROP", Proto: "tcp", Dport: "23"},
+		{Pos: 0, Type: "in", Action: "ACCEPT", Macro: "SSH", Source: "+management", Enable: 1},
+	}, nil)
+
+	out, err := runFirewall(t, "rules", "list", "--vmid", "200")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ssh := strings.Index(out, "+management")
+	telnet := strings.Index(out, "23")
+	if ssh < 0 || telnet < ssh || !strings.Contains(out, "1    no ") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestScopeFlagsAreExclusive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	setupFirewallMocks(t, ctrl)
+
+	if _, err := runFirewall(t, "rules", "list"); err == nil || !strings.Contains(err.Error(), "--cluster, --node, --vmid, --group") {
+		t.Fatalf("expected missing scope error, got %v", err)
+	}
+	if _, err := runFirewall(t, "rules", "list", "--cluster", "-n", "pve1"); err == nil || !strings.Contains(err.Error(), "cannot be combined") {
+		t.Fatalf("expected combined scope error, got %v", err)
+	}
+}
+
+func TestRulesAddSendsParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupFirewallMocks(t, ctrl)
+
+	firewall := expectFirewall(ctrl, client, "/cluster/firewall")
+	firewall.EXPECT().NewRule(gomock.Any(), map[string]any{
+		"type": "in", "action": "ACCEPT", "enable": 1, "proto": "tcp", "dport": "8006", "pos": 2,
+	}).Return(nil)
+
+	out, err := runFirewall(t, "rules", "add", "--cluster", "--type", "IN", "--action", "accept", "--proto", "tcp", "--dport", "8006", "--pos", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "Added rule to cluster: IN ACCEPT proto=tcp dport=8006") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestRulesUpdateSendsChangedFieldsOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupFirewallMocks(t, ctrl)
+
+	firewall := expectFirewall(ctrl, client, "/cluster/firewall/groups/web")
+	firewall.EXPECT().UpdateRule(gomock.Any(), 3, map[string]any{"enable": 0, "dport": "443", "delete": "source"}).Return(nil)
+
+	if _, err := runFirewall(t, "rules", "update", "3", "--group", "web", "--enable=false", "--dport", "443", "--clear", "source"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRulesMoveDownAccountsForRemoval(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupFirewallMocks(t, ctrl)
+
+	firewall := expectFirewall(ctrl, client, "/nodes/pve1/firewall")
+	firewall.EXPECT().UpdateRule(gomock.Any(), 0, map[string]any{"moveto": 3}).Return(nil)
+
+	if _, err := runFirewall(t, "rules", "move", "0", "--to", "2", "-n", "pve1"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeRuleFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestApplyDeletesAndInsertsInOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupFirewallMocks(t, ctrl)
+
+	path := writeRuleFile(t, `rules:
+  - type: in
+    action: ACCEPT
+    macro: SSH
+  - type: in
+    action: ACCEPT
+    proto: tcp
+    dport: "443"
+  - type: in
+    action: DROP
+    log: ${level}
+`)
+	firewall := expectFirewall(ctrl, client, "/cluster/firewall")
+	firewall.EXPECT().Rules(gomock.Any()).Return([]*proxmox.FirewallRule{
+		{Pos: 0, Type: "in", Action: "ACCEPT", Macro: "SSH", Enable: 1, Log: "nolog"},
+		{Pos: 1, Type: "in", Action: "ACCEPT", Proto: "tcp", Dport: "80", Enable: 1},
+		{Pos: 2, Type: "in", Action: "DROP", Enable: 1, Log: "info"},
+	}, nil)
+	gomock.InOrder(
+		firewall.EXPECT().DeleteRule(gomock.Any(), 1).Return(nil),
+		firewall.EXPECT().NewRule(gomock.Any(), map[string]any{
+			"type": "in", "action": "ACCEPT", "enable": 1, "proto": "tcp", "dport": "443", "pos": 1,
+		}).Return(nil),
+	)
+
+	out, err := runFirewall(t, "apply", "--cluster", "-f", path, "--set", "level=info", "--yes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"    0  IN ACCEPT macro=SSH\n",
+		"-   1  IN ACCEPT proto=tcp dport=80\n",
+		"+   1  IN ACCEPT proto=tcp dport=443\n",
+		"    2  IN DROP log=info\n",
+		"1 to add, 1 to delete",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestApplyDryRunAndUpToDate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupFirewallMocks(t, ctrl)
+
+	path := writeRuleFile(t, "rules:\n  - type: out\n    action: accept\n    enable: false\n")
+	firewall := expectFirewall(ctrl, client, "/nodes/pve1/firewall")
+	firewall.EXPECT().Rules(gomock.Any()).Return(nil, nil)
+
+	out, err := runFirewall(t, "apply", "-n", "pve1", "-f", path, "--dry-run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "+   0  OUT ACCEPT (disabled)") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+
+	firewall = expectFirewall(ctrl, client, "/nodes/pve1/firewall")
+	firewall.EXPECT().Rules(gomock.Any()).Return([]*proxmox.FirewallRule{{Type: "out", Action: "ACCEPT"}}, nil)
+	out, err = runFirewall(t, "apply", "-n", "pve1", "-f", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "up to date") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestApplyRejectsInvalidRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	setupFirewallMocks(t, ctrl)
+
+	path := writeRuleFile(t, "rules:\n  - type: in\n    action: ALLOW\n")
+	_, err := runFirewall(t, "apply", "--cluster", "-f", path)
+	if err == nil || !strings.Contains(err.Error(), `rule 1`) || !strings.Contains(err.Error(), `"ALLOW"`) {
+		t.Fatalf("expected invalid action error, got %v", err)
+	}
+}
+
+func TestPlanRulesHandlesReorder(t *testing.T) {
+	rule := func(pos int, dport string) ruleSpec {
+		spec, _ := ruleSpec{Pos: pos, Type: "in", Action: "ACCEPT", Dport: dport}.normalize()
+		return spec
+	}
+	live := []ruleSpec{rule(0, "22"), rule(1, "80"), rule(2, "443")}
+	desired := []ruleSpec{rule(0, "443"), rule(0, "22"), rule(0, "80")}
+
+	var ops []string
+	for _, change := range planRules(live, desired) {
+		ops = append(ops, string(change.op)+change.rule.Dport)
+	}
+	if got := strings.Join(ops, ","); got != "+443, 22, 80,-443" {
+		t.Fatalf("unexpected plan %s", got)
+	}
+}
+
+func TestOptionsSetRejectsUnsupportedOption(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupFirewallMocks(t, ctrl)
+
+	expectFirewall(ctrl, client, "/nodes/pve1/firewall")
+	_, err := runFirewall(t, "options", "set", "-n", "pve1", "--policy-in", "DROP")
+	if err == nil || !strings.Contains(err.Error(), "--policy-in is not available for the node pve1 firewall") {
+		t.Fatalf("expected unsupported option error, got %v", err)
+	}
+}
+
+func TestOptionsSetSendsValues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupFirewallMocks(t, ctrl)
+
+	firewall := expectFirewall(ctrl, client, "/cluster/firewall")
+	firewall.EXPECT().UpdateOptions(gomock.Any(), map[string]any{"enable": 1, "policy_in": "DROP"}).Return(nil)
+
+	if _, err := runFirewall(t, "options", "set", "--cluster", "--enable", "--policy-in", "drop"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLogShowsLastLinesMatchingGrep(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupFirewallMocks(t, ctrl)
+
+	firewall := expectFirewall(ctrl, client, "/nodes/pve1/firewall")
+	firewall.EXPECT().Log(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, options *proxmox.NodeFirewallLogOptions) ([]*proxmox.LogEntry, error) {
+			if options.Since == 0 || options.Start != 0 {
+				t.Fatalf("unexpected query: %+v", options)
+			}
+			return []*proxmox.LogEntry{
+				{N: 1, T: "100 DROP: IN=fwbr100i0 SRC=10.0.0.5 DPT=23"},
+				{N: 2, T: "100 ACCEPT: IN=fwbr100i0 SRC=10.0.0.6 DPT=22"},
+				{N: 3, T: "100 DROP: IN=fwbr100i0 SRC=10.0.0.7 DPT=25"},
+			}, nil
+		})
+
+	out, err := runFirewall(t, "log", "-n", "pve1", "--grep", "DROP", "--lines", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out, "DPT=23") || strings.Contains(out, "DPT=22") || !strings.Contains(out, "DPT=25") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestLogAcceptsSinceDateAndPrintsJSONLines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupFirewallMocks(t, ctrl)
+
+	since := time.Date(2026, 10, 19, 8, 0, 0, 0, time.Local).Unix()
+	firewall := expectFirewall(ctrl, client, "/nodes/pve1/firewall")
+	firewall.EXPECT().Log(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, options *proxmox.NodeFirewallLogOptions) ([]*proxmox.LogEntry, error) {
+			if options.Since != since {
+				t.Errorf("since = %d, want %d", options.Since, since)
+			}
+			return []*proxmox.LogEntry{{N: 4, T: "100 DROP: IN=fwbr100i0 SRC=10.0.0.5 DPT=23"}}, nil
+		})
+
+	out, err := runFirewall(t, "log", "-n", "pve1", "--since", "2026-10-19 08:00", "-o", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var line logLine
+	if err := json.Unmarshal([]byte(out), &line); err != nil {
+		t.Fatalf("invalid JSON line %q: %v", out, err)
+	}
+	if line.Target != "node pve1" || line.Line != 4 || !strings.Contains(line.Message, "DPT=23") {
+		t.Fatalf("unexpected line: %+v", line)
+	}
+}
@@ -0,0 +1,149 @@
+package firewall
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/spf13/cobra"
+)
+
+const clusterFirewallPath = "/cluster/firewall"
+
+func newGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "group",
+		Aliases: []string{"groups"},
+		Short:   "Manage firewall security groups",
+		Long: `Manage cluster-wide security groups: named rule lists that guests and nodes
+include with a rule of type group. Edit a group's rules with
+'proxmox-cli firewall rules ... --group <name>'.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newGroupListCmd(), newGroupCreateCmd(), newGroupDeleteCmd())
+	return cmd
+}
+
+func clusterFirewall() (interfaces.FirewallInterface, error) {
+	client, err := utility.AuthenticatedClient()
+	if err != nil {
+		return nil, fmt.Errorf("authenticate Proxmox client: %w", err)
+	}
+	return client.Firewall(clusterFirewallPath), nil
+}
+
+func newGroupListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List security groups",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			firewall, err := clusterFirewall()
+			if err != nil {
+				return err
+			}
+			groups, err := firewall.SecurityGroups(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list security groups: %w", err)
+			}
+			sort.Slice(groups, func(i, j int) bool { return groups[i].Group < groups[j].Group })
+
+			if format == "json" {
+				return utility.PrintJSON(out, groups)
+			}
+			if len(groups) == 0 {
+				fmt.Fprintln(out, "No security groups found")
+				return nil
+			}
+			fmt.Fprintf(out, "%-24s %s\n", "Group", "Comment")
+			fmt.Fprintf(out, "%-24s %s\n", "-----", "-------")
+			for _, group := range groups {
+				fmt.Fprintf(out, "%-24s %s\n", group.Group, utility.DashIfEmpty(group.Comment))
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newGroupCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <group>",
+		Short: "Create an empty security group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			comment, err := cmd.Flags().GetString("comment")
+			if err != nil {
+				return fmt.Errorf("get comment flag: %w", err)
+			}
+			firewall, err := clusterFirewall()
+			if err != nil {
+				return err
+			}
+			if err := firewall.NewSecurityGroup(cmd.Context(), args[0], comment); err != nil {
+				return fmt.Errorf("create security group %q: %w", args[0], err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Security group %s created\n", args[0])
+			return nil
+		},
+	}
+	cmd.Flags().String("comment", "", "Comment")
+	return cmd
+}
+
+func newGroupDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "delete <group>",
+		Short:             "Delete a security group",
+		Long:              `Delete a security group. Proxmox refuses while rules still include it or it still has rules.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeGroupArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Delete security group %s?", args[0])); err != nil {
+				return err
+			}
+			firewall, err := clusterFirewall()
+			if err != nil {
+				return err
+			}
+			if err := firewall.DeleteSecurityGroup(cmd.Context(), args[0]); err != nil {
+				return fmt.Errorf("delete security group %q: %w", args[0], err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Security group %s deleted\n", args[0])
+			return nil
+		},
+	}
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+// completeGroups completes security group names.
+func completeGroups(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	firewall, err := clusterFirewall()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	groups, err := firewall.SecurityGroups(cmd.Context())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	names := make([]string, 0, len(groups))
+	for _, group := range groups {
+		names = append(names, group.Group)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+func completeGroupArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeGroups(cmd, args, toComplete)
+}
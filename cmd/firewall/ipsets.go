@@ -0,0 +1,229 @@
+package firewall
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+type ipsetEntry struct {
+	CIDR    string `json:"cidr"`
+	NoMatch bool   `json:"nomatch"`
+	Comment string `json:"comment,omitempty"`
+}
+
+func newIPSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ipset",
+		Aliases: []string{"ipsets"},
+		Short:   "Manage firewall IP sets",
+		Long: `Manage named sets of addresses and networks at cluster or guest level.
+Rules refer to them as +<name>, e.g. --source +management.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newIPSetListCmd(), newIPSetShowCmd(), newIPSetCreateCmd(), newIPSetDeleteCmd(),
+		newIPSetAddCmd(), newIPSetRemoveCmd())
+	return cmd
+}
+
+func addIPSetScopeFlags(cmd *cobra.Command) {
+	addScopeFlags(cmd, scopeCluster, scopeGuest)
+}
+
+func newIPSetListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List IP sets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			ipsets, err := firewall.IPSets(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list IP sets of %s: %w", target.label, err)
+			}
+			sort.Slice(ipsets, func(i, j int) bool { return ipsets[i].Name < ipsets[j].Name })
+
+			if format == "json" {
+				return utility.PrintJSON(out, ipsets)
+			}
+			if len(ipsets) == 0 {
+				fmt.Fprintf(out, "No IP sets for %s\n", target.label)
+				return nil
+			}
+			fmt.Fprintf(out, "%-24s %s\n", "Name", "Comment")
+			fmt.Fprintf(out, "%-24s %s\n", "----", "-------")
+			for _, ipset := range ipsets {
+				fmt.Fprintf(out, "%-24s %s\n", ipset.Name, utility.DashIfEmpty(ipset.Comment))
+			}
+			return nil
+		},
+	}
+	addIPSetScopeFlags(cmd)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newIPSetShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <name>",
+		Short: "List the entries of an IP set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			entries, err := firewall.IPSetEntries(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("get IP set %q of %s: %w", args[0], target.label, err)
+			}
+			summaries := make([]ipsetEntry, 0, len(entries))
+			for _, entry := range entries {
+				if entry != nil {
+					summaries = append(summaries, ipsetEntry{CIDR: entry.CIDR, NoMatch: entry.NoMatch, Comment: entry.Comment})
+				}
+			}
+			sort.Slice(summaries, func(i, j int) bool { return summaries[i].CIDR < summaries[j].CIDR })
+
+			if format == "json" {
+				return utility.PrintJSON(out, summaries)
+			}
+			if len(summaries) == 0 {
+				fmt.Fprintf(out, "IP set %s is empty\n", args[0])
+				return nil
+			}
+			fmt.Fprintf(out, "%-32s %-8s %s\n", "CIDR", "NoMatch", "Comment")
+			fmt.Fprintf(out, "%-32s %-8s %s\n", "----", "-------", "-------")
+			for _, entry := range summaries {
+				noMatch := "no"
+				if entry.NoMatch {
+					noMatch = "yes"
+				}
+				fmt.Fprintf(out, "%-32s %-8s %s\n", entry.CIDR, noMatch, utility.DashIfEmpty(entry.Comment))
+			}
+			return nil
+		},
+	}
+	addIPSetScopeFlags(cmd)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newIPSetCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create an empty IP set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			comment, err := cmd.Flags().GetString("comment")
+			if err != nil {
+				return fmt.Errorf("get comment flag: %w", err)
+			}
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := firewall.NewIPSet(cmd.Context(), args[0], comment); err != nil {
+				return fmt.Errorf("create IP set %q on %s: %w", args[0], target.label, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "IP set %s created on %s\n", args[0], target.label)
+			return nil
+		},
+	}
+	addIPSetScopeFlags(cmd)
+	cmd.Flags().String("comment", "", "Comment")
+	return cmd
+}
+
+func newIPSetDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete an IP set and its entries",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Delete IP set %s of %s with all its entries?", args[0], target.label)); err != nil {
+				return err
+			}
+			if err := firewall.DeleteIPSet(cmd.Context(), args[0]); err != nil {
+				return fmt.Errorf("delete IP set %q of %s: %w", args[0], target.label, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "IP set %s deleted from %s\n", args[0], target.label)
+			return nil
+		},
+	}
+	addIPSetScopeFlags(cmd)
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func newIPSetAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <name> <cidr>",
+		Short: "Add an address or network to an IP set",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entry := &proxmox.FirewallIPSetEntryCreationOption{CIDR: args[1]}
+			var err error
+			if entry.Comment, err = cmd.Flags().GetString("comment"); err != nil {
+				return fmt.Errorf("get comment flag: %w", err)
+			}
+			if entry.NoMatch, err = cmd.Flags().GetBool("nomatch"); err != nil {
+				return fmt.Errorf("get nomatch flag: %w", err)
+			}
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := firewall.AddIPSetEntry(cmd.Context(), args[0], entry); err != nil {
+				return fmt.Errorf("add %s to IP set %q of %s: %w", args[1], args[0], target.label, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Added %s to IP set %s\n", args[1], args[0])
+			return nil
+		},
+	}
+	addIPSetScopeFlags(cmd)
+	cmd.Flags().String("comment", "", "Comment")
+	cmd.Flags().Bool("nomatch", false, "Exclude this entry from the set")
+	return cmd
+}
+
+func newIPSetRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove <name> <cidr>",
+		Short: "Remove an address or network from an IP set",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := firewall.DeleteIPSetEntry(cmd.Context(), args[0], args[1]); err != nil {
+				return fmt.Errorf("remove %s from IP set %q of %s: %w", args[1], args[0], target.label, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Removed %s from IP set %s\n", args[1], args[0])
+			return nil
+		},
+	}
+	addIPSetScopeFlags(cmd)
+	return cmd
+}
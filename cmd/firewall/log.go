@@ -0,0 +1,159 @@
+package firewall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// logLine is one firewall log line in -o json output.
+type logLine struct {
+	Target  string `json:"target"`
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+func newLogCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log",
+		Short: "Show the firewall log of a node or guest",
+		Long: `Print firewall log lines, e.g. to see which rule dropped traffic. Logging
+must be enabled with a log level on the rule or with the log-level options.
+
+  proxmox-cli firewall log --vmid 100 --follow
+  proxmox-cli firewall log --node pve1 --since 2h --grep 'DROP'
+  proxmox-cli firewall log --node pve1 --since '2026-10-19 08:00' -o json
+
+--since takes a duration or a date/time, as for 'nodes syslog'. --follow
+continues after the last line number read. -o json prints one object per
+line.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			sinceValue, err := flags.GetString("since")
+			if err != nil {
+				return fmt.Errorf("get since flag: %w", err)
+			}
+			since, err := utility.ParseLogTime(sinceValue, time.Now())
+			if err != nil {
+				return fmt.Errorf("invalid --since: %w", err)
+			}
+			lines, err := flags.GetInt("lines")
+			if err != nil {
+				return fmt.Errorf("get lines flag: %w", err)
+			}
+			if lines < 0 {
+				return fmt.Errorf("--lines cannot be negative")
+			}
+			follow, err := flags.GetBool("follow")
+			if err != nil {
+				return fmt.Errorf("get follow flag: %w", err)
+			}
+			interval, err := flags.GetDuration("interval")
+			if err != nil {
+				return fmt.Errorf("get interval flag: %w", err)
+			}
+			if interval <= 0 {
+				return fmt.Errorf("--interval must be positive")
+			}
+			grep, err := flags.GetString("grep")
+			if err != nil {
+				return fmt.Errorf("get grep flag: %w", err)
+			}
+			var pattern *regexp.Regexp
+			if grep != "" {
+				if pattern, err = regexp.Compile(grep); err != nil {
+					return fmt.Errorf("invalid --grep pattern: %w", err)
+				}
+			}
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			out := cmd.OutOrStdout()
+			printEntries := func(entries []*proxmox.LogEntry) error {
+				for _, entry := range entries {
+					if pattern != nil && !pattern.MatchString(entry.T) {
+						continue
+					}
+					if format == "json" {
+						if err := json.NewEncoder(out).Encode(logLine{Target: target.label, Line: entry.N, Message: entry.T}); err != nil {
+							return err
+						}
+						continue
+					}
+					fmt.Fprintln(out, entry.T)
+				}
+				return nil
+			}
+
+			query := proxmox.NodeFirewallLogOptions{Since: since.Unix()}
+			entries, err := readLog(ctx, firewall, query, 0)
+			if err != nil {
+				return fmt.Errorf("read firewall log of %s: %w", target.label, err)
+			}
+			last := 0
+			if len(entries) > 0 {
+				last = entries[len(entries)-1].N
+			}
+			if lines > 0 && len(entries) > lines {
+				entries = entries[len(entries)-lines:]
+			}
+			if err := printEntries(entries); err != nil {
+				return err
+			}
+
+			for follow {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(interval):
+				}
+				entries, err := readLog(ctx, firewall, query, last)
+				if err != nil {
+					if ctx.Err() != nil {
+						return nil
+					}
+					return fmt.Errorf("read firewall log of %s: %w", target.label, err)
+				}
+				if len(entries) > 0 {
+					last = entries[len(entries)-1].N
+				}
+				if err := printEntries(entries); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	addScopeFlags(cmd, scopeNode, scopeGuest)
+	cmd.Flags().String("since", "1h", "Start time: a duration ago (15m, 2h) or a date/time (2006-01-02 15:04)")
+	cmd.Flags().Int("lines", 50, "Show at most the last N lines (0 for all)")
+	cmd.Flags().BoolP("follow", "f", false, "Keep polling for new lines until interrupted")
+	cmd.Flags().Duration("interval", 2*time.Second, "Poll interval for --follow")
+	cmd.Flags().String("grep", "", "Only show lines matching this regular expression")
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+// readLog returns the firewall log lines after line number after.
+func readLog(ctx context.Context, firewall interfaces.FirewallInterface, query proxmox.NodeFirewallLogOptions, after int) ([]*proxmox.LogEntry, error) {
+	return utility.ReadLogPages(ctx, after, func(ctx context.Context, start, limit int) ([]*proxmox.LogEntry, error) {
+		page := query
+		page.Start, page.Limit = start, limit
+		return firewall.Log(ctx, &page)
+	})
+}
@@ -0,0 +1,153 @@
+package firewall
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/spf13/cobra"
+)
+
+// optionFlags maps the flags of options set to their API keys and the scopes
+// that have the option.
+var optionFlags = []struct {
+	flag, key string
+	scopes    []string
+}{
+	{"enable", "enable", []string{scopeCluster, scopeNode, scopeGuest}},
+	{"policy-in", "policy_in", []string{scopeCluster, scopeGuest}},
+	{"policy-out", "policy_out", []string{scopeCluster, scopeGuest}},
+	{"log-level-in", "log_level_in", []string{scopeNode, scopeGuest}},
+	{"log-level-out", "log_level_out", []string{scopeNode, scopeGuest}},
+}
+
+func newOptionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "options",
+		Short: "Show and change firewall options",
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(newOptionsShowCmd(), newOptionsSetCmd())
+	return cmd
+}
+
+func newOptionsShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show firewall options",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			options, err := firewall.Options(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("get firewall options of %s: %w", target.label, err)
+			}
+			delete(options, "digest")
+
+			if format == "json" {
+				return utility.PrintJSON(out, options)
+			}
+			keys := make([]string, 0, len(options))
+			for key := range options {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			fmt.Fprintf(out, "Firewall options of %s:\n", target.label)
+			if _, ok := options["enable"]; !ok {
+				fmt.Fprintf(out, "  %-22s %s\n", "enable", "0 (default)")
+			}
+			for _, key := range keys {
+				fmt.Fprintf(out, "  %-22s %v\n", key, options[key])
+			}
+			return nil
+		},
+	}
+	addScopeFlags(cmd, scopeCluster, scopeNode, scopeGuest)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newOptionsSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Change firewall options",
+		Long: `Change firewall options. Policies exist at cluster and guest level, log
+levels at node and guest level:
+
+  proxmox-cli firewall options set --cluster --enable --policy-in DROP
+  proxmox-cli firewall options set --vmid 100 --enable --log-level-in info
+  proxmox-cli firewall options set --node pve1 --enable=false`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			params := map[string]any{}
+			for _, option := range optionFlags {
+				if !flags.Changed(option.flag) {
+					continue
+				}
+				if !slices.Contains(option.scopes, target.kind) {
+					return fmt.Errorf("--%s is not available for the %s firewall", option.flag, target.label)
+				}
+				if option.flag == "enable" {
+					enabled, err := flags.GetBool("enable")
+					if err != nil {
+						return fmt.Errorf("get enable flag: %w", err)
+					}
+					params[option.key] = 0
+					if enabled {
+						params[option.key] = 1
+					}
+					continue
+				}
+				value, err := flags.GetString(option.flag)
+				if err != nil {
+					return fmt.Errorf("get %s flag: %w", option.flag, err)
+				}
+				valid := logLevels
+				if strings.HasPrefix(option.flag, "policy-") {
+					value = strings.ToUpper(value)
+					valid = ruleActions
+				}
+				if !slices.Contains(valid, value) {
+					return fmt.Errorf("invalid --%s %q: use %s", option.flag, value, strings.Join(valid, ", "))
+				}
+				params[option.key] = value
+			}
+			if len(params) == 0 {
+				return fmt.Errorf("nothing to update: pass at least one option flag")
+			}
+			if err := firewall.UpdateOptions(cmd.Context(), params); err != nil {
+				return fmt.Errorf("update firewall options of %s: %w", target.label, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Firewall options of %s updated\n", target.label)
+			return nil
+		},
+	}
+	addScopeFlags(cmd, scopeCluster, scopeNode, scopeGuest)
+	cmd.Flags().Bool("enable", true, "Enable (--enable) or disable (--enable=false) the firewall")
+	cmd.Flags().String("policy-in", "", "Default policy for incoming traffic: ACCEPT, DROP, or REJECT")
+	cmd.Flags().String("policy-out", "", "Default policy for outgoing traffic: ACCEPT, DROP, or REJECT")
+	cmd.Flags().String("log-level-in", "", "Log level for incoming traffic, e.g. info or nolog")
+	cmd.Flags().String("log-level-out", "", "Log level for outgoing traffic, e.g. info or nolog")
+	for _, name := range []string{"policy-in", "policy-out"} {
+		_ = cmd.RegisterFlagCompletionFunc(name, cobra.FixedCompletions(ruleActions, cobra.ShellCompDirectiveNoFileComp))
+	}
+	for _, name := range []string{"log-level-in", "log-level-out"} {
+		_ = cmd.RegisterFlagCompletionFunc(name, cobra.FixedCompletions(logLevels, cobra.ShellCompDirectiveNoFileComp))
+	}
+	return cmd
+}
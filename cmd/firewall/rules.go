@@ -0,0 +1,465 @@
+package firewall
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+var (
+	ruleTypes   = []string{"in", "out", "forward", "group"}
+	ruleActions = []string{"ACCEPT", "DROP", "REJECT"}
+	logLevels   = []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug", "nolog"}
+)
+
+// ruleFields are the optional rule fields; flags and API parameters share
+// their names.
+var ruleFields = []string{"macro", "proto", "source", "dest", "sport", "dport", "iface", "icmp-type", "log", "comment"}
+
+// ruleSpec is a firewall rule as listed, printed as JSON, and read from an
+// apply file.
+type ruleSpec struct {
+	Pos      int    `json:"pos" yaml:"-"`
+	Type     string `json:"type" yaml:"type"`
+	Action   string `json:"action" yaml:"action"`
+	Enable   *bool  `json:"enable" yaml:"enable,omitempty"`
+	Macro    string `json:"macro,omitempty" yaml:"macro,omitempty"`
+	Proto    string `json:"proto,omitempty" yaml:"proto,omitempty"`
+	Source   string `json:"source,omitempty" yaml:"source,omitempty"`
+	Dest     string `json:"dest,omitempty" yaml:"dest,omitempty"`
+	Sport    string `json:"sport,omitempty" yaml:"sport,omitempty"`
+	Dport    string `json:"dport,omitempty" yaml:"dport,omitempty"`
+	Iface    string `json:"iface,omitempty" yaml:"iface,omitempty"`
+	IcmpType string `json:"icmp-type,omitempty" yaml:"icmp-type,omitempty"`
+	Log      string `json:"log,omitempty" yaml:"log,omitempty"`
+	Comment  string `json:"comment,omitempty" yaml:"comment,omitempty"`
+}
+
+func specFromRule(rule *proxmox.FirewallRule) ruleSpec {
+	enabled := rule.IsEnable()
+	return ruleSpec{
+		Pos:      rule.Pos,
+		Type:     rule.Type,
+		Action:   rule.Action,
+		Enable:   &enabled,
+		Macro:    rule.Macro,
+		Proto:    rule.Proto,
+		Source:   rule.Source,
+		Dest:     rule.Dest,
+		Sport:    rule.Sport,
+		Dport:    rule.Dport,
+		Iface:    rule.Iface,
+		IcmpType: rule.IcmpType,
+		Log:      rule.Log,
+		Comment:  rule.Comment,
+	}
+}
+
+func (r ruleSpec) enabled() bool {
+	return r.Enable == nil || *r.Enable
+}
+
+// normalize validates a rule and brings it into the form the server
+// reports, so rules from a file compare equal to live ones.
+func (r ruleSpec) normalize() (ruleSpec, error) {
+	r.Type = strings.ToLower(strings.TrimSpace(r.Type))
+	if !slices.Contains(ruleTypes, r.Type) {
+		return r, fmt.Errorf("invalid rule type %q: use %s", r.Type, strings.Join(ruleTypes, ", "))
+	}
+	r.Action = strings.TrimSpace(r.Action)
+	if r.Type != "group" {
+		r.Action = strings.ToUpper(r.Action)
+		if !slices.Contains(ruleActions, r.Action) {
+			return r, fmt.Errorf("invalid rule action %q: use %s", r.Action, strings.Join(ruleActions, ", "))
+		}
+	} else if r.Action == "" {
+		return r, fmt.Errorf("group rules need the security group name as action")
+	}
+	if r.Log == "nolog" {
+		r.Log = ""
+	}
+	if r.Log != "" && !slices.Contains(logLevels, r.Log) {
+		return r, fmt.Errorf("invalid log level %q: use %s", r.Log, strings.Join(logLevels, ", "))
+	}
+	enabled := r.enabled()
+	r.Enable = &enabled
+	return r, nil
+}
+
+// params returns the API parameters that create the rule.
+func (r ruleSpec) params() map[string]any {
+	params := map[string]any{"type": r.Type, "action": r.Action, "enable": 0}
+	if r.enabled() {
+		params["enable"] = 1
+	}
+	for param, value := range map[string]string{
+		"macro": r.Macro, "proto": r.Proto, "source": r.Source, "dest": r.Dest, "sport": r.Sport,
+		"dport": r.Dport, "iface": r.Iface, "icmp-type": r.IcmpType, "log": r.Log, "comment": r.Comment,
+	} {
+		if value != "" {
+			params[param] = value
+		}
+	}
+	return params
+}
+
+// describe renders a rule on one line, e.g.
+// "IN ACCEPT proto=tcp dport=22 source=+management # ssh".
+func (r ruleSpec) describe() string {
+	parts := []string{strings.ToUpper(r.Type), r.Action}
+	for _, field := range []struct{ name, value string }{
+		{"macro", r.Macro}, {"proto", r.Proto}, {"source", r.Source}, {"dest", r.Dest},
+		{"sport", r.Sport}, {"dport", r.Dport}, {"iface", r.Iface}, {"icmp-type", r.IcmpType},
+	} {
+		if field.value != "" {
+			parts = append(parts, field.name+"="+field.value)
+		}
+	}
+	if r.Log != "" && r.Log != "nolog" {
+		parts = append(parts, "log="+r.Log)
+	}
+	if !r.enabled() {
+		parts = append(parts, "(disabled)")
+	}
+	if r.Comment != "" {
+		parts = append(parts, "# "+r.Comment)
+	}
+	return strings.Join(parts, " ")
+}
+
+func newRulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rules",
+		Aliases: []string{"rule"},
+		Short:   "Manage firewall rules",
+		Long: `Manage the ordered rule list of the cluster, a node, a guest, or a security
+group (--group). Rules are addressed by position, starting at 0; the first
+matching rule wins.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newRulesListCmd(), newRulesAddCmd(), newRulesUpdateCmd(), newRulesDeleteCmd(), newRulesMoveCmd())
+	return cmd
+}
+
+func addRuleScopeFlags(cmd *cobra.Command) {
+	addScopeFlags(cmd, scopeCluster, scopeNode, scopeGuest, scopeGroup)
+}
+
+func newRulesListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List firewall rules in order",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			rules, err := firewall.Rules(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list firewall rules of %s: %w", target.label, err)
+			}
+			specs := liveRules(rules)
+
+			if format == "json" {
+				return utility.PrintJSON(out, specs)
+			}
+			if len(specs) == 0 {
+				fmt.Fprintf(out, "No firewall rules for %s\n", target.label)
+				return nil
+			}
+			fmt.Fprintf(out, "%-4s %-3s %-8s %-10s %-10s %-6s %-20s %-20s %-12s %s\n", "Pos", "On", "Type", "Action", "Macro", "Proto", "Source", "Dest", "DPort", "Comment")
+			fmt.Fprintf(out, "%-4s %-3s %-8s %-10s %-10s %-6s %-20s %-20s %-12s %s\n", "---", "--", "----", "------", "-----", "-----", "------", "----", "-----", "-------")
+			for _, spec := range specs {
+				on := "yes"
+				if !spec.enabled() {
+					on = "no"
+				}
+				fmt.Fprintf(out, "%-4d %-3s %-8s %-10s %-10s %-6s %-20s %-20s %-12s %s\n", spec.Pos, on, spec.Type, spec.Action,
+					utility.DashIfEmpty(spec.Macro), utility.DashIfEmpty(spec.Proto), utility.DashIfEmpty(spec.Source), utility.DashIfEmpty(spec.Dest),
+					utility.DashIfEmpty(spec.Dport), utility.DashIfEmpty(spec.Comment))
+			}
+			return nil
+		},
+	}
+	addRuleScopeFlags(cmd)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+// liveRules converts the server's rules, sorted by position.
+func liveRules(rules []*proxmox.FirewallRule) []ruleSpec {
+	specs := make([]ruleSpec, 0, len(rules))
+	for _, rule := range rules {
+		if rule != nil {
+			specs = append(specs, specFromRule(rule))
+		}
+	}
+	sort.SliceStable(specs, func(i, j int) bool { return specs[i].Pos < specs[j].Pos })
+	return specs
+}
+
+func addRuleFlags(cmd *cobra.Command) {
+	cmd.Flags().String("type", "", "Direction: in, out, forward, or group")
+	cmd.Flags().String("action", "", "ACCEPT, DROP, REJECT, or the security group name for group rules")
+	cmd.Flags().String("macro", "", "Predefined service macro, e.g. SSH or HTTPS")
+	cmd.Flags().String("proto", "", "Protocol, e.g. tcp, udp, icmp")
+	cmd.Flags().String("source", "", "Source address, CIDR, alias, or +ipset")
+	cmd.Flags().String("dest", "", "Destination address, CIDR, alias, or +ipset")
+	cmd.Flags().String("sport", "", "Source ports, e.g. 1024:65535")
+	cmd.Flags().String("dport", "", "Destination ports, e.g. 22 or 80,443")
+	cmd.Flags().String("iface", "", "Network interface, e.g. net0")
+	cmd.Flags().String("icmp-type", "", "ICMP type, when --proto is icmp")
+	cmd.Flags().String("log", "", "Log level: "+strings.Join(logLevels, ", "))
+	cmd.Flags().String("comment", "", "Comment")
+	_ = cmd.RegisterFlagCompletionFunc("type", cobra.FixedCompletions(ruleTypes, cobra.ShellCompDirectiveNoFileComp))
+	_ = cmd.RegisterFlagCompletionFunc("log", cobra.FixedCompletions(logLevels, cobra.ShellCompDirectiveNoFileComp))
+}
+
+// specFromFlags reads the rule flags of rules add.
+func specFromFlags(cmd *cobra.Command) (ruleSpec, error) {
+	flags := cmd.Flags()
+	values := map[string]string{}
+	for _, name := range append([]string{"type", "action"}, ruleFields...) {
+		value, err := flags.GetString(name)
+		if err != nil {
+			return ruleSpec{}, fmt.Errorf("get %s flag: %w", name, err)
+		}
+		values[name] = strings.TrimSpace(value)
+	}
+	disabled, err := flags.GetBool("disable")
+	if err != nil {
+		return ruleSpec{}, fmt.Errorf("get disable flag: %w", err)
+	}
+	enabled := !disabled
+	return ruleSpec{
+		Type: values["type"], Action: values["action"], Enable: &enabled, Macro: values["macro"], Proto: values["proto"],
+		Source: values["source"], Dest: values["dest"], Sport: values["sport"], Dport: values["dport"],
+		Iface: values["iface"], IcmpType: values["icmp-type"], Log: values["log"], Comment: values["comment"],
+	}.normalize()
+}
+
+func newRulesAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a firewall rule",
+		Long: `Add a rule. Like the web interface, new rules go to the top of the list
+unless --pos is given.
+
+  proxmox-cli firewall rules add --vmid 100 --type in --action ACCEPT --macro SSH --source +management
+  proxmox-cli firewall rules add --cluster --type in --action ACCEPT --proto tcp --dport 8006 --pos 2`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spec, err := specFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			params := spec.params()
+			if cmd.Flags().Changed("pos") {
+				pos, err := cmd.Flags().GetInt("pos")
+				if err != nil {
+					return fmt.Errorf("get pos flag: %w", err)
+				}
+				if pos < 0 {
+					return fmt.Errorf("--pos cannot be negative")
+				}
+				params["pos"] = pos
+			}
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := firewall.NewRule(cmd.Context(), params); err != nil {
+				return fmt.Errorf("add firewall rule to %s: %w", target.label, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Added rule to %s: %s\n", target.label, spec.describe())
+			return nil
+		},
+	}
+	addRuleScopeFlags(cmd)
+	addRuleFlags(cmd)
+	cmd.Flags().Bool("disable", false, "Add the rule disabled")
+	cmd.Flags().Int("pos", 0, "Position to insert the rule at (default: the top)")
+	for _, name := range []string{"type", "action"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+	return cmd
+}
+
+func newRulesUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <pos>",
+		Short: "Change a firewall rule",
+		Long: `Change the given fields of the rule at a position; other fields keep their
+values. --clear removes fields, e.g. --clear source,dport.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pos, err := parsePos(args[0])
+			if err != nil {
+				return err
+			}
+			flags := cmd.Flags()
+			params := map[string]any{}
+			for _, name := range append([]string{"type", "action"}, ruleFields...) {
+				if !flags.Changed(name) {
+					continue
+				}
+				value, err := flags.GetString(name)
+				if err != nil {
+					return fmt.Errorf("get %s flag: %w", name, err)
+				}
+				value = strings.TrimSpace(value)
+				switch {
+				case name == "type":
+					value = strings.ToLower(value)
+					if !slices.Contains(ruleTypes, value) {
+						return fmt.Errorf("invalid rule type %q: use %s", value, strings.Join(ruleTypes, ", "))
+					}
+				case name == "log" && !slices.Contains(logLevels, value):
+					return fmt.Errorf("invalid log level %q: use %s", value, strings.Join(logLevels, ", "))
+				}
+				params[name] = value
+			}
+			if flags.Changed("enable") {
+				enabled, err := flags.GetBool("enable")
+				if err != nil {
+					return fmt.Errorf("get enable flag: %w", err)
+				}
+				params["enable"] = 0
+				if enabled {
+					params["enable"] = 1
+				}
+			}
+			clear, err := flags.GetStringSlice("clear")
+			if err != nil {
+				return fmt.Errorf("get clear flag: %w", err)
+			}
+			for _, name := range clear {
+				if !slices.Contains(ruleFields, name) {
+					return fmt.Errorf("cannot clear %q: use one of %s", name, strings.Join(ruleFields, ", "))
+				}
+			}
+			if len(clear) > 0 {
+				params["delete"] = strings.Join(clear, ",")
+			}
+			if len(params) == 0 {
+				return fmt.Errorf("nothing to update: pass at least one rule flag")
+			}
+
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := firewall.UpdateRule(cmd.Context(), pos, params); err != nil {
+				return fmt.Errorf("update firewall rule %d of %s: %w", pos, target.label, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Updated rule %d of %s\n", pos, target.label)
+			return nil
+		},
+	}
+	addRuleScopeFlags(cmd)
+	addRuleFlags(cmd)
+	cmd.Flags().Bool("enable", true, "Enable (--enable) or disable (--enable=false) the rule")
+	cmd.Flags().StringSlice("clear", nil, "Fields to remove, e.g. source,dport")
+	return cmd
+}
+
+func newRulesDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <pos>",
+		Short: "Delete a firewall rule",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pos, err := parsePos(args[0])
+			if err != nil {
+				return err
+			}
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Delete rule %d of %s?", pos, target.label)); err != nil {
+				return err
+			}
+			if err := firewall.DeleteRule(cmd.Context(), pos); err != nil {
+				return fmt.Errorf("delete firewall rule %d of %s: %w", pos, target.label, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Deleted rule %d of %s\n", pos, target.label)
+			return nil
+		},
+	}
+	addRuleScopeFlags(cmd)
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func newRulesMoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "move <pos>",
+		Short: "Move a firewall rule to another position",
+		Long: `Move the rule at <pos> so that it ends up at position --to, shifting the
+rules in between.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pos, err := parsePos(args[0])
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt("to")
+			if err != nil {
+				return fmt.Errorf("get to flag: %w", err)
+			}
+			if to < 0 {
+				return fmt.Errorf("--to cannot be negative")
+			}
+			if to == pos {
+				return fmt.Errorf("rule %d is already at position %d", pos, to)
+			}
+			firewall, target, err := firewallFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := firewall.UpdateRule(cmd.Context(), pos, map[string]any{"moveto": moveTo(pos, to)}); err != nil {
+				return fmt.Errorf("move firewall rule %d of %s: %w", pos, target.label, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Moved rule %d of %s to position %d\n", pos, target.label, to)
+			return nil
+		},
+	}
+	addRuleScopeFlags(cmd)
+	cmd.Flags().Int("to", 0, "Final position of the rule (required)")
+	if err := cmd.MarkFlagRequired("to"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// moveTo converts a final position to the API's moveto parameter, which
+// inserts the rule before the rule currently at that position.
+func moveTo(pos, to int) int {
+	if to > pos {
+		return to + 1
+	}
+	return to
+}
+
+func parsePos(value string) (int, error) {
+	pos, err := strconv.Atoi(value)
+	if err != nil || pos < 0 {
+		return 0, fmt.Errorf("invalid rule position %q: use the Pos column of 'firewall rules list'", value)
+	}
+	return pos, nil
+}
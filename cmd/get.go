@@ -14,6 +14,7 @@ type resourceSummary struct {
 	Name   string `json:"name"`
 	Node   string `json:"node"`
 	Status string `json:"status"`
+	Pool   string `json:"pool,omitempty"`
 	Uptime uint64 `json:"uptime_seconds,omitempty"`
 }
 
@@ -48,6 +49,10 @@ single view, using one API call instead of querying each node.`,
 			if err != nil {
 				return fmt.Errorf("get status flag: %w", err)
 			}
+			poolFilter, err := cmd.Flags().GetString("pool")
+			if err != nil {
+				return fmt.Errorf("get pool flag: %w", err)
+			}
 
 			client, err := utility.AuthenticatedClient()
 			if err != nil {
@@ -92,6 +97,9 @@ single view, using one API call instead of querying each node.`,
 				if statusFilter != "" && resource.Status != statusFilter {
 					continue
 				}
+				if poolFilter != "" && resource.Pool != poolFilter {
+					continue
+				}
 				name := resource.Name
 				if kind == "storage" {
 					name = resource.Storage
@@ -102,6 +110,7 @@ single view, using one API call instead of querying each node.`,
 					Name:   name,
 					Node:   resource.Node,
 					Status: resource.Status,
+					Pool:   resource.Pool,
 					Uptime: resource.Uptime,
 				})
 			}
@@ -112,8 +121,8 @@ single view, using one API call instead of querying each node.`,
 
 			fmt.Fprintln(out, "Cluster resources:")
 			fmt.Fprintln(out, "==================")
-			fmt.Fprintf(out, "%-8s %-8s %-24s %-15s %-10s %s\n", "Type", "VMID", "Name", "Node", "Status", "Uptime")
-			fmt.Fprintf(out, "%-8s %-8s %-24s %-15s %-10s %s\n", "----", "----", "----", "----", "------", "------")
+			fmt.Fprintf(out, "%-8s %-8s %-24s %-15s %-10s %-12s %s\n", "Type", "VMID", "Name", "Node", "Status", "Pool", "Uptime")
+			fmt.Fprintf(out, "%-8s %-8s %-24s %-15s %-10s %-12s %s\n", "----", "----", "----", "----", "------", "----", "------")
 			for _, summary := range summaries {
 				vmid := "-"
 				if summary.VMID > 0 {
@@ -125,8 +134,12 @@ single view, using one API call instead of querying each node.`,
 					hours := (summary.Uptime % 86400) / 3600
 					uptime = fmt.Sprintf("%dd %dh", days, hours)
 				}
-				fmt.Fprintf(out, "%-8s %-8s %-24s %-15s %-10s %s\n",
-					summary.Type, vmid, summary.Name, summary.Node, summary.Status, uptime)
+				pool := summary.Pool
+				if pool == "" {
+					pool = "-"
+				}
+				fmt.Fprintf(out, "%-8s %-8s %-24s %-15s %-10s %-12s %s\n",
+					summary.Type, vmid, summary.Name, summary.Node, summary.Status, pool, uptime)
 			}
 			if len(summaries) == 0 {
 				fmt.Fprintln(out, "No resources found")
@@ -138,10 +151,12 @@ single view, using one API call instead of querying each node.`,
 	cmd.Flags().String("type", "", "Only list resources of this type: vm, lxc, or storage")
 	cmd.Flags().StringP("node", "n", "", "Only list resources on this node")
 	cmd.Flags().String("status", "", "Only list resources with this status")
+	cmd.Flags().String("pool", "", "Only list resources in this resource pool")
 	_ = cmd.RegisterFlagCompletionFunc("type", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
 		return []string{"vm", "lxc", "storage"}, cobra.ShellCompDirectiveNoFileComp
 	})
 	utility.RegisterNodeFlagCompletion(cmd, "node")
+	utility.RegisterPoolFlagCompletion(cmd, "pool")
 	utility.AddOutputFlag(cmd)
 	return cmd
 }
@@ -36,7 +36,7 @@ func setupResourcesMocks(t *testing.T, ctrl *gomock.Controller) *mocks.MockProxm
 
 func clusterResourcesFixture() proxmox.ClusterResources {
 	return proxmox.ClusterResources{
-		&proxmox.ClusterResource{ID: "qemu/100", Type: "qemu", VMID: 100, Name: "web", Node: "pve1", Status: "running", Uptime: 86400},
+		&proxmox.ClusterResource{ID: "qemu/100", Type: "qemu", VMID: 100, Name: "web", Node: "pve1", Status: "running", Pool: "web-team", Uptime: 86400},
 		&proxmox.ClusterResource{ID: "lxc/200", Type: "lxc", VMID: 200, Name: "db", Node: "pve2", Status: "stopped"},
 		&proxmox.ClusterResource{ID: "storage/pve1/local", Type: "storage", Storage: "local", Node: "pve1", Status: "available"},
 		&proxmox.ClusterResource{ID: "node/pve1", Type: "node", Node: "pve1", Status: "online"},
@@ -93,6 +93,31 @@ func TestResourcesCommandTypeAndStatusFilterJSON(t *testing.T) {
 	}
 }
 
+func TestResourcesCommandPoolFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupResourcesMocks(t, ctrl)
+	cluster := mocks.NewMockClusterInterface(ctrl)
+	client.EXPECT().Cluster(gomock.Any()).Return(cluster, nil)
+	cluster.EXPECT().Resources(gomock.Any()).Return(clusterResourcesFixture(), nil)
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetErr(&out)
+	root.SetArgs([]string{"get", "--pool", "web-team"})
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "web-team") {
+		t.Errorf("expected pool column in output:\n%s", out.String())
+	}
+	for _, unwanted := range []string{"db", "local"} {
+		if strings.Contains(out.String(), unwanted) {
+			t.Errorf("resources outside the pool should be excluded, found %q:\n%s", unwanted, out.String())
+		}
+	}
+}
+
 func TestResourcesCommandRejectsUnknownType(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	setupResourcesMocks(t, ctrl)
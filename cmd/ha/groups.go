@@ -0,0 +1,481 @@
+package ha
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// ruleTypes and ruleAffinities are the HA rule kinds of PVE 9 and later.
+var (
+	ruleTypes      = []string{"node-affinity", "resource-affinity"}
+	ruleAffinities = []string{"positive", "negative"}
+)
+
+func newGroupsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "groups",
+		Aliases: []string{"group"},
+		Short:   "Manage HA groups",
+		Long: `Manage HA groups, which restrict and prioritise the nodes HA resources run
+on. PVE 9 replaces groups with rules; use 'proxmox-cli ha rules' there.
+
+Nodes are given as a comma-separated list with optional priorities, e.g.
+"pve1:2,pve2:1".`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newGroupsListCmd(), newGroupsCreateCmd(), newGroupsUpdateCmd(), newGroupsDeleteCmd())
+	return cmd
+}
+
+func newGroupsListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List HA groups",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			groups, err := cluster.HAGroups(ctx)
+			if err != nil {
+				return fmt.Errorf("list HA groups: %w", err)
+			}
+			sort.Slice(groups, func(i, j int) bool { return groups[i].Group < groups[j].Group })
+
+			if format == "json" {
+				return utility.PrintJSON(out, groups)
+			}
+			if len(groups) == 0 {
+				fmt.Fprintln(out, "No HA groups configured")
+				return nil
+			}
+			fmt.Fprintf(out, "%-16s %-30s %-11s %-11s %s\n", "Group", "Nodes", "Restricted", "NoFailback", "Comment")
+			fmt.Fprintf(out, "%-16s %-30s %-11s %-11s %s\n", "-----", "-----", "----------", "----------", "-------")
+			for _, group := range groups {
+				fmt.Fprintf(out, "%-16s %-30s %-11s %-11s %s\n", group.Group, utility.DashIfEmpty(group.Nodes),
+					utility.YesNo(bool(group.Restricted)), utility.YesNo(bool(group.NoFailback)), utility.DashIfEmpty(group.Comment))
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newGroupsCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <group>",
+		Short: "Create an HA group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			flags := cmd.Flags()
+			option := &proxmox.HAGroupCreateOption{Group: args[0]}
+			var err error
+			if option.Nodes, err = flags.GetString("nodes"); err != nil {
+				return fmt.Errorf("get nodes flag: %w", err)
+			}
+			if option.Comment, err = flags.GetString("comment"); err != nil {
+				return fmt.Errorf("get comment flag: %w", err)
+			}
+			restricted, err := flags.GetBool("restricted")
+			if err != nil {
+				return fmt.Errorf("get restricted flag: %w", err)
+			}
+			noFailback, err := flags.GetBool("nofailback")
+			if err != nil {
+				return fmt.Errorf("get nofailback flag: %w", err)
+			}
+			option.Restricted, option.NoFailback = proxmox.IntOrBool(restricted), proxmox.IntOrBool(noFailback)
+
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			if err := cluster.NewHAGroup(ctx, option); err != nil {
+				return fmt.Errorf("create HA group %q: %w", option.Group, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "HA group %s created\n", option.Group)
+			return nil
+		},
+	}
+	addGroupFlags(cmd)
+	if err := cmd.MarkFlagRequired("nodes"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func newGroupsUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <group>",
+		Short: "Change an HA group",
+		Long:  `Change an HA group. Only the flags given are sent.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			flags := cmd.Flags()
+			name := args[0]
+			option := &proxmox.HAGroupUpdateOption{}
+			var deletes []string
+			var err error
+			if flags.Changed("nodes") {
+				if option.Nodes, err = flags.GetString("nodes"); err != nil {
+					return fmt.Errorf("get nodes flag: %w", err)
+				}
+				if option.Nodes == "" {
+					return fmt.Errorf("--nodes cannot be empty")
+				}
+			}
+			if flags.Changed("comment") {
+				if option.Comment, err = flags.GetString("comment"); err != nil {
+					return fmt.Errorf("get comment flag: %w", err)
+				}
+				if option.Comment == "" {
+					deletes = append(deletes, "comment")
+				}
+			}
+			// Both options default to off and are omitted when false, so
+			// turning one off means deleting it.
+			for _, flag := range []struct {
+				name  string
+				value *proxmox.IntOrBool
+			}{{"restricted", &option.Restricted}, {"nofailback", &option.NoFailback}} {
+				if !flags.Changed(flag.name) {
+					continue
+				}
+				enabled, err := flags.GetBool(flag.name)
+				if err != nil {
+					return fmt.Errorf("get %s flag: %w", flag.name, err)
+				}
+				*flag.value = proxmox.IntOrBool(enabled)
+				if !enabled {
+					deletes = append(deletes, flag.name)
+				}
+			}
+			if len(deletes) == 0 && option.Nodes == "" && option.Comment == "" && !option.Restricted && !option.NoFailback {
+				return fmt.Errorf("nothing to update: pass at least one of --nodes, --restricted, --nofailback, --comment")
+			}
+			option.Delete = strings.Join(deletes, ",")
+
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			if err := cluster.UpdateHAGroup(ctx, name, option); err != nil {
+				return fmt.Errorf("update HA group %q: %w", name, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "HA group %s updated\n", name)
+			return nil
+		},
+	}
+	addGroupFlags(cmd)
+	return cmd
+}
+
+func newGroupsDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <group>",
+		Short: "Delete an HA group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			name := args[0]
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Delete HA group %s?", name)); err != nil {
+				return err
+			}
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			if err := cluster.DeleteHAGroup(ctx, name); err != nil {
+				return fmt.Errorf("delete HA group %q: %w", name, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "HA group %s deleted\n", name)
+			return nil
+		},
+	}
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func addGroupFlags(cmd *cobra.Command) {
+	cmd.Flags().String("nodes", "", "Member nodes with optional priorities (e.g. pve1:2,pve2:1)")
+	cmd.Flags().Bool("restricted", false, "Only run resources on the group's nodes")
+	cmd.Flags().Bool("nofailback", false, "Do not move resources back to a higher-priority node")
+	cmd.Flags().String("comment", "", "Comment")
+}
+
+func newRulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rules",
+		Aliases: []string{"rule"},
+		Short:   "Manage HA rules (PVE 9 and later)",
+		Long: `Manage HA rules, which replace HA groups on PVE 9 and later.
+
+A node-affinity rule keeps --resources on --nodes (with optional priorities,
+e.g. "pve1:2,pve2:1"); --strict forbids running them anywhere else. A
+resource-affinity rule keeps --resources together (--affinity positive) or
+apart (--affinity negative).`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newRulesListCmd(), newRulesCreateCmd(), newRulesUpdateCmd(), newRulesDeleteCmd())
+	return cmd
+}
+
+func newRulesListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List HA rules",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			rules, err := cluster.HARules(ctx)
+			if err != nil {
+				return fmt.Errorf("list HA rules: %w", err)
+			}
+			sort.Slice(rules, func(i, j int) bool { return rules[i].Rule < rules[j].Rule })
+
+			if format == "json" {
+				return utility.PrintJSON(out, rules)
+			}
+			if len(rules) == 0 {
+				fmt.Fprintln(out, "No HA rules configured")
+				return nil
+			}
+			fmt.Fprintf(out, "%-16s %-18s %-20s %-20s %-9s %s\n", "Rule", "Type", "Resources", "Nodes/Affinity", "Strict", "Enabled")
+			fmt.Fprintf(out, "%-16s %-18s %-20s %-20s %-9s %s\n", "----", "----", "---------", "--------------", "------", "-------")
+			for _, rule := range rules {
+				target := rule.Nodes
+				if rule.Type == "resource-affinity" {
+					target = rule.Affinity
+				}
+				fmt.Fprintf(out, "%-16s %-18s %-20s %-20s %-9s %s\n", rule.Rule, rule.Type, utility.DashIfEmpty(rule.Resources),
+					utility.DashIfEmpty(target), utility.YesNo(bool(rule.Strict)), utility.YesNo(!bool(rule.Disable)))
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newRulesCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <rule>",
+		Short: "Create an HA rule",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			flags := cmd.Flags()
+			option := &proxmox.HARuleCreateOption{Rule: args[0]}
+			var err error
+			if option.Type, err = ruleTypeFromFlags(cmd); err != nil {
+				return err
+			}
+			if option.Resources, err = flags.GetString("resources"); err != nil {
+				return fmt.Errorf("get resources flag: %w", err)
+			}
+			if option.Nodes, err = flags.GetString("nodes"); err != nil {
+				return fmt.Errorf("get nodes flag: %w", err)
+			}
+			if option.Affinity, err = affinityFromFlags(cmd); err != nil {
+				return err
+			}
+			switch {
+			case option.Type == "node-affinity" && option.Nodes == "":
+				return fmt.Errorf("--nodes is required for node-affinity rules")
+			case option.Type == "resource-affinity" && option.Affinity == "":
+				return fmt.Errorf("--affinity is required for resource-affinity rules")
+			}
+			if option.Comment, err = flags.GetString("comment"); err != nil {
+				return fmt.Errorf("get comment flag: %w", err)
+			}
+			strict, err := flags.GetBool("strict")
+			if err != nil {
+				return fmt.Errorf("get strict flag: %w", err)
+			}
+			disable, err := flags.GetBool("disable")
+			if err != nil {
+				return fmt.Errorf("get disable flag: %w", err)
+			}
+			option.Strict, option.Disable = proxmox.IntOrBool(strict), proxmox.IntOrBool(disable)
+
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			if err := cluster.NewHARule(ctx, option); err != nil {
+				return fmt.Errorf("create HA rule %q: %w", option.Rule, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "HA rule %s created\n", option.Rule)
+			return nil
+		},
+	}
+	addRuleFlags(cmd)
+	if err := cmd.MarkFlagRequired("resources"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func newRulesUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <rule>",
+		Short: "Change an HA rule",
+		Long:  `Change an HA rule. Only the flags given are sent; --type is required by the API.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			flags := cmd.Flags()
+			name := args[0]
+			option := &proxmox.HARuleUpdateOption{}
+			var deletes []string
+			var err error
+			if option.Type, err = ruleTypeFromFlags(cmd); err != nil {
+				return err
+			}
+			if flags.Changed("resources") {
+				if option.Resources, err = flags.GetString("resources"); err != nil {
+					return fmt.Errorf("get resources flag: %w", err)
+				}
+			}
+			if flags.Changed("nodes") {
+				if option.Nodes, err = flags.GetString("nodes"); err != nil {
+					return fmt.Errorf("get nodes flag: %w", err)
+				}
+			}
+			if flags.Changed("affinity") {
+				if option.Affinity, err = affinityFromFlags(cmd); err != nil {
+					return err
+				}
+			}
+			if flags.Changed("comment") {
+				if option.Comment, err = flags.GetString("comment"); err != nil {
+					return fmt.Errorf("get comment flag: %w", err)
+				}
+				if option.Comment == "" {
+					deletes = append(deletes, "comment")
+				}
+			}
+			for _, flag := range []struct {
+				name  string
+				value *proxmox.IntOrBool
+			}{{"strict", &option.Strict}, {"disable", &option.Disable}} {
+				if !flags.Changed(flag.name) {
+					continue
+				}
+				enabled, err := flags.GetBool(flag.name)
+				if err != nil {
+					return fmt.Errorf("get %s flag: %w", flag.name, err)
+				}
+				*flag.value = proxmox.IntOrBool(enabled)
+				if !enabled {
+					deletes = append(deletes, flag.name)
+				}
+			}
+			if len(deletes) == 0 && option.Resources == "" && option.Nodes == "" && option.Affinity == "" && option.Comment == "" && !option.Strict && !option.Disable {
+				return fmt.Errorf("nothing to update: pass at least one of --resources, --nodes, --affinity, --strict, --disable, --comment")
+			}
+			option.Delete = strings.Join(deletes, ",")
+
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			if err := cluster.UpdateHARule(ctx, name, option); err != nil {
+				return fmt.Errorf("update HA rule %q: %w", name, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "HA rule %s updated\n", name)
+			return nil
+		},
+	}
+	addRuleFlags(cmd)
+	return cmd
+}
+
+func newRulesDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <rule>",
+		Short: "Delete an HA rule",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			name := args[0]
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Delete HA rule %s?", name)); err != nil {
+				return err
+			}
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			if err := cluster.DeleteHARule(ctx, name); err != nil {
+				return fmt.Errorf("delete HA rule %q: %w", name, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "HA rule %s deleted\n", name)
+			return nil
+		},
+	}
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func addRuleFlags(cmd *cobra.Command) {
+	cmd.Flags().String("type", "", "Rule type ("+strings.Join(ruleTypes, ", ")+")")
+	cmd.Flags().String("resources", "", "HA resources the rule applies to (e.g. vm:100,ct:200)")
+	cmd.Flags().String("nodes", "", "Nodes for node-affinity rules, with optional priorities")
+	cmd.Flags().String("affinity", "", "Resource-affinity direction ("+strings.Join(ruleAffinities, ", ")+")")
+	cmd.Flags().Bool("strict", false, "Never run the resources outside the rule's nodes")
+	cmd.Flags().Bool("disable", false, "Keep the rule but do not enforce it")
+	cmd.Flags().String("comment", "", "Comment")
+	_ = cmd.RegisterFlagCompletionFunc("type", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return ruleTypes, cobra.ShellCompDirectiveNoFileComp
+	})
+	_ = cmd.RegisterFlagCompletionFunc("affinity", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return ruleAffinities, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+func ruleTypeFromFlags(cmd *cobra.Command) (string, error) {
+	ruleType, err := cmd.Flags().GetString("type")
+	if err != nil {
+		return "", fmt.Errorf("get type flag: %w", err)
+	}
+	if !slices.Contains(ruleTypes, ruleType) {
+		return "", fmt.Errorf("invalid --type %q: must be one of %s", ruleType, strings.Join(ruleTypes, ", "))
+	}
+	return ruleType, nil
+}
+
+func affinityFromFlags(cmd *cobra.Command) (string, error) {
+	affinity, err := cmd.Flags().GetString("affinity")
+	if err != nil {
+		return "", fmt.Errorf("get affinity flag: %w", err)
+	}
+	if affinity != "" && !slices.Contains(ruleAffinities, affinity) {
+		return "", fmt.Errorf("invalid --affinity %q: must be one of %s", affinity, strings.Join(ruleAffinities, ", "))
+	}
+	return affinity, nil
+}
@@ -0,0 +1,175 @@
+// Package ha implements High Availability status, resource, group, and rule
+// management.
+package ha
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ha",
+		Short: "Manage High Availability",
+		Long: `Inspect the HA stack and manage HA resources, groups, and rules. Resources
+are addressed by service ID, e.g. vm:100 or ct:200; a bare VMID is resolved
+to the right prefix.`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newStatusCmd())
+	cmd.AddCommand(newResourcesCmd())
+	cmd.AddCommand(newGroupsCmd())
+	cmd.AddCommand(newRulesCmd())
+	cmd.AddCommand(newMoveCmd("migrate"))
+	cmd.AddCommand(newMoveCmd("relocate"))
+	return cmd
+}
+
+func clusterFromContext(ctx context.Context) (interfaces.ClusterInterface, error) {
+	client, err := utility.AuthenticatedClient()
+	if err != nil {
+		return nil, fmt.Errorf("authenticate Proxmox client: %w", err)
+	}
+	cluster, err := client.Cluster(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get cluster: %w", err)
+	}
+	return cluster, nil
+}
+
+// normalizeSID turns a bare VMID into vm:<id> or ct:<id> by looking the guest
+// up, since the HA status reports service IDs with their type prefix.
+func normalizeSID(ctx context.Context, cluster interfaces.ClusterInterface, sid string) (string, error) {
+	sid = strings.TrimSpace(sid)
+	if sid == "" {
+		return "", fmt.Errorf("service ID cannot be empty")
+	}
+	if strings.Contains(sid, ":") {
+		return sid, nil
+	}
+	vmid, err := strconv.ParseUint(sid, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid service ID %q: use vm:<vmid>, ct:<vmid>, or a VMID", sid)
+	}
+	resources, err := cluster.Resources(ctx, "vm")
+	if err != nil {
+		return "", fmt.Errorf("list cluster resources: %w", err)
+	}
+	for _, resource := range resources {
+		if resource == nil || resource.VMID != vmid {
+			continue
+		}
+		if resource.Type == "lxc" {
+			return "ct:" + sid, nil
+		}
+		return "vm:" + sid, nil
+	}
+	return "", fmt.Errorf("guest %d not found", vmid)
+}
+
+// serviceStatus returns the HA status row of a service, or nil.
+func serviceStatus(entries []*proxmox.HAStatusEntry, sid string) *proxmox.HAStatusEntry {
+	for _, entry := range entries {
+		if entry != nil && entry.Type == "service" && entry.ID == "service:"+sid {
+			return entry
+		}
+	}
+	return nil
+}
+
+type haStatus struct {
+	Quorum   string          `json:"quorum"`
+	Quorate  bool            `json:"quorate"`
+	Manager  string          `json:"manager"`
+	LRMs     []haNodeStatus  `json:"lrms"`
+	Services []haServiceLine `json:"services"`
+}
+
+type haNodeStatus struct {
+	Node   string `json:"node"`
+	Status string `json:"status"`
+}
+
+type haServiceLine struct {
+	SID   string `json:"sid"`
+	Node  string `json:"node"`
+	State string `json:"state"`
+}
+
+func newStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show HA manager, quorum, and per-node LRM state",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			cluster, err := clusterFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+			entries, err := cluster.HAStatus(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("get HA status: %w", err)
+			}
+
+			status := haStatus{LRMs: []haNodeStatus{}, Services: []haServiceLine{}}
+			for _, entry := range entries {
+				if entry == nil {
+					continue
+				}
+				switch entry.Type {
+				case "quorum":
+					status.Quorum, status.Quorate = entry.Status, entry.Quorate == 1
+				case "master":
+					status.Manager = entry.Status
+				case "lrm":
+					status.LRMs = append(status.LRMs, haNodeStatus{Node: entry.Node, Status: entry.Status})
+				case "service":
+					status.Services = append(status.Services, haServiceLine{
+						SID:   strings.TrimPrefix(entry.ID, "service:"),
+						Node:  entry.Node,
+						State: entry.ServiceState,
+					})
+				}
+			}
+			sort.Slice(status.LRMs, func(i, j int) bool { return status.LRMs[i].Node < status.LRMs[j].Node })
+			sort.Slice(status.Services, func(i, j int) bool { return status.Services[i].SID < status.Services[j].SID })
+
+			if format == "json" {
+				return utility.PrintJSON(out, status)
+			}
+			fmt.Fprintf(out, "%-10s %s (quorate: %s)\n", "Quorum:", utility.DashIfEmpty(status.Quorum), utility.YesNo(status.Quorate))
+			fmt.Fprintf(out, "%-10s %s\n", "Manager:", utility.DashIfEmpty(status.Manager))
+			fmt.Fprintln(out)
+			fmt.Fprintf(out, "%-16s %s\n", "Node", "LRM")
+			fmt.Fprintf(out, "%-16s %s\n", "----", "---")
+			for _, lrm := range status.LRMs {
+				fmt.Fprintf(out, "%-16s %s\n", lrm.Node, lrm.Status)
+			}
+			if len(status.Services) > 0 {
+				fmt.Fprintln(out)
+				fmt.Fprintf(out, "%-12s %-16s %s\n", "Service", "Node", "State")
+				fmt.Fprintf(out, "%-12s %-16s %s\n", "-------", "----", "-----")
+				for _, service := range status.Services {
+					fmt.Fprintf(out, "%-12s %-16s %s\n", service.SID, service.Node, service.State)
+				}
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
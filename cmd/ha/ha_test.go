@@ -0,0 +1,217 @@
+package ha
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/viper"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/Adz-ai/proxmox-cli/test/mocks"
+)
+
+func setupHAMocks(t *testing.T, ctrl *gomock.Controller) *mocks.MockClusterInterface {
+	t.Helper()
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set("server_url", "https://pve.example.com:8006")
+	viper.Set("auth_ticket.ticket", "ticket")
+	viper.Set("auth_ticket.CSRFPreventionToken", "token")
+
+	client := mocks.NewMockProxmoxClientInterface(ctrl)
+	cluster := mocks.NewMockClusterInterface(ctrl)
+	client.EXPECT().Cluster(gomock.Any()).Return(cluster, nil).AnyTimes()
+	utility.SetClientFactory(func() interfaces.ProxmoxClientInterface { return client })
+	t.Cleanup(utility.ResetClientFactory)
+	return cluster
+}
+
+func runHA(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestStatusShowsManagerQuorumAndLRMs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cluster := setupHAMocks(t, ctrl)
+
+	cluster.EXPECT().HAStatus(gomock.Any()).Return([]*proxmox.HAStatusEntry{
+		{ID: "quorum", Type: "quorum", Status: "OK", Quorate: 1},
+		{ID: "master", Type: "master", Node: "pve1", Status: "pve1 (active, Mon Oct 19 10:00:00 2026)"},
+		{ID: "lrm:pve2", Type: "lrm", Node: "pve2", Status: "pve2 (idle, Mon Oct 19 10:00:00 2026)"},
+		{ID: "lrm:pve1", Type: "lrm", Node: "pve1", Status: "pve1 (active, Mon Oct 19 10:00:00 2026)"},
+		{ID: "service:vm:100", Type: "service", Node: "pve1", ServiceState: "started"},
+	}, nil)
+
+	out, err := runHA(t, "status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"OK (quorate: yes)", "pve1 (active", "pve2 (idle", "vm:100"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "\npve2 ") < strings.Index(out, "\npve1 ") {
+		t.Fatalf("expected LRMs sorted by node:\n%s", out)
+	}
+}
+
+func TestResourcesAddResolvesVMIDAndSendsOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cluster := setupHAMocks(t, ctrl)
+
+	cluster.EXPECT().Resources(gomock.Any(), "vm").Return(proxmox.ClusterResources{
+		&proxmox.ClusterResource{VMID: 200, Type: "lxc"},
+	}, nil)
+	cluster.EXPECT().NewHAResource(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, option *proxmox.HAResourceCreateOption) error {
+			if option.SID != "ct:200" || option.Group != "prod" {
+				t.Fatalf("unexpected resource: %+v", option)
+			}
+			if option.State == nil || *option.State != "stopped" {
+				t.Fatalf("expected state stopped, got %v", option.State)
+			}
+			if option.MaxRestart == nil || *option.MaxRestart != 3 || option.MaxRelocate != nil {
+				t.Fatalf("expected only max_restart set: %+v", option)
+			}
+			return nil
+		})
+
+	out, err := runHA(t, "resources", "add", "200", "--state", "stopped", "--group", "prod", "--max-restart", "3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "HA resource ct:200 added") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestResourcesUpdateClearsGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cluster := setupHAMocks(t, ctrl)
+
+	cluster.EXPECT().UpdateHAResource(gomock.Any(), "vm:100", gomock.Any()).DoAndReturn(
+		func(_ any, _ string, option *proxmox.HAResourceUpdateOption) error {
+			if option.Delete != "group" || option.State != nil || option.MaxRestart != nil {
+				t.Fatalf("unexpected update: %+v", option)
+			}
+			return nil
+		})
+
+	if _, err := runHA(t, "resources", "update", "vm:100", "--group", ""); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResourcesUpdateRequiresChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	setupHAMocks(t, ctrl)
+
+	_, err := runHA(t, "resources", "update", "vm:100")
+	if err == nil || !strings.Contains(err.Error(), "nothing to update") {
+		t.Fatalf("expected nothing-to-update error, got %v", err)
+	}
+}
+
+func TestRulesCreateValidatesType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	setupHAMocks(t, ctrl)
+
+	_, err := runHA(t, "rules", "create", "keep-apart", "--type", "anti", "--resources", "vm:100,vm:101")
+	if err == nil || !strings.Contains(err.Error(), "invalid --type") {
+		t.Fatalf("expected invalid type error, got %v", err)
+	}
+}
+
+func TestRulesUpdateRequiresChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	setupHAMocks(t, ctrl)
+
+	_, err := runHA(t, "rules", "update", "keep-apart", "--type", "resource-affinity")
+	if err == nil || !strings.Contains(err.Error(), "nothing to update") {
+		t.Fatalf("expected nothing-to-update error, got %v", err)
+	}
+}
+
+func TestRequiredFlags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	setupHAMocks(t, ctrl)
+
+	for _, args := range [][]string{
+		{"migrate", "vm:100"},
+		{"groups", "create", "prefer-pve1"},
+		{"rules", "create", "keep-apart", "--type", "resource-affinity", "--affinity", "negative"},
+	} {
+		_, err := runHA(t, args...)
+		if err == nil || !strings.Contains(err.Error(), "required flag") {
+			t.Errorf("%v: expected required flag error, got %v", args, err)
+		}
+	}
+}
+
+func TestMigrateWaitsForNewLocation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cluster := setupHAMocks(t, ctrl)
+
+	gomock.InOrder(
+		cluster.EXPECT().MigrateHAResource(gomock.Any(), "vm:100", "pve2").Return(nil),
+		cluster.EXPECT().HAStatus(gomock.Any()).Return([]*proxmox.HAStatusEntry{
+			{ID: "service:vm:100", Type: "service", Node: "pve1", ServiceState: "migrate"},
+		}, nil),
+		cluster.EXPECT().HAStatus(gomock.Any()).Return([]*proxmox.HAStatusEntry{
+			{ID: "service:vm:100", Type: "service", Node: "pve2", ServiceState: "started"},
+		}, nil),
+	)
+
+	out, err := runHA(t, "migrate", "vm:100", "--target", "pve2", "--interval", "1ms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "vm:100 is running on pve2") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestRelocateReportsStoppedResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cluster := setupHAMocks(t, ctrl)
+
+	cluster.EXPECT().RelocateHAResource(gomock.Any(), "ct:200", "pve2").Return(nil)
+	cluster.EXPECT().HAStatus(gomock.Any()).Return([]*proxmox.HAStatusEntry{
+		{ID: "service:ct:200", Type: "service", Node: "pve2", ServiceState: "stopped", Request: "stopped"},
+	}, nil)
+
+	out, err := runHA(t, "relocate", "ct:200", "--target", "pve2", "--interval", "1ms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "ct:200 is stopped on pve2") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestRelocateFailsOnErrorState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cluster := setupHAMocks(t, ctrl)
+
+	cluster.EXPECT().RelocateHAResource(gomock.Any(), "ct:200", "pve2").Return(nil)
+	cluster.EXPECT().HAStatus(gomock.Any()).Return([]*proxmox.HAStatusEntry{
+		{ID: "service:ct:200", Type: "service", Node: "pve1", ServiceState: "error"},
+	}, nil)
+
+	_, err := runHA(t, "relocate", "ct:200", "--target", "pve2", "--interval", "1ms")
+	if err == nil || !strings.Contains(err.Error(), "error state") {
+		t.Fatalf("expected error state failure, got %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+package ha
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+func newMoveCmd(action string) *cobra.Command {
+	short := "Live-migrate an HA resource to another node"
+	detail := "VMs are migrated online"
+	if action == "relocate" {
+		short = "Relocate an HA resource to another node"
+		detail = "The guest is stopped, moved, and started again"
+	}
+	cmd := &cobra.Command{
+		Use:   action + " <sid>",
+		Short: short,
+		Long: short + `. ` + detail + `. The request is handed to the HA
+stack, and the command then polls the HA status every --interval until the
+resource is reported on --target, failing if it ends up in the error state
+or --timeout passes.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			target, err := cmd.Flags().GetString("target")
+			if err != nil {
+				return fmt.Errorf("get target flag: %w", err)
+			}
+			interval, err := cmd.Flags().GetDuration("interval")
+			if err != nil {
+				return fmt.Errorf("get interval flag: %w", err)
+			}
+			if interval <= 0 {
+				return fmt.Errorf("--interval must be positive")
+			}
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			sid, err := normalizeSID(ctx, cluster, args[0])
+			if err != nil {
+				return err
+			}
+
+			if action == "relocate" {
+				err = cluster.RelocateHAResource(ctx, sid, target)
+			} else {
+				err = cluster.MigrateHAResource(ctx, sid, target)
+			}
+			if err != nil {
+				return fmt.Errorf("%s HA resource %q: %w", action, sid, err)
+			}
+			fmt.Fprintf(out, "Requested %s of %s to %s\n", action, sid, target)
+
+			waitCtx, cancel := context.WithTimeout(ctx, utility.TaskTimeout(cmd))
+			defer cancel()
+			started := time.Now()
+			status, err := waitForResourceOn(waitCtx, cluster, sid, target, interval)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "%s is %s on %s (%s)\n", sid, arrivedState(status), target, time.Since(started).Round(time.Second))
+			return nil
+		},
+	}
+
+	cmd.Flags().String("target", "", "Node to move the resource to")
+	cmd.Flags().Duration("interval", 2*time.Second, "Poll interval while waiting for the move")
+	if err := cmd.MarkFlagRequired("target"); err != nil {
+		panic(err)
+	}
+	utility.RegisterNodeFlagCompletion(cmd, "target")
+	return cmd
+}
+
+// waitForResourceOn polls the HA status until the service is reported on the
+// target node and no longer migrating or relocating, and returns that status.
+func waitForResourceOn(ctx context.Context, cluster interfaces.ClusterInterface, sid, target string, interval time.Duration) (*proxmox.HAStatusEntry, error) {
+	for {
+		entries, err := cluster.HAStatus(ctx)
+		if err == nil {
+			if status := serviceStatus(entries, sid); status != nil {
+				switch {
+				case status.ServiceState == "error":
+					return nil, fmt.Errorf("HA resource %q entered the error state on node %q", sid, status.Node)
+				case status.Node == target && status.ServiceState != "migrate" && status.ServiceState != "relocate":
+					return status, nil
+				}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for HA resource %q to reach node %q", sid, target)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// arrivedState words the state of a moved resource by what the HA stack was
+// asked for, since a stopped resource is moved without being started.
+func arrivedState(status *proxmox.HAStatusEntry) string {
+	state := status.Request
+	if state == "" {
+		state = status.ServiceState
+	}
+	if state == "started" {
+		return "running"
+	}
+	return state
+}
@@ -0,0 +1,303 @@
+package ha
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// resourceStates are the requested states an HA resource can be put in.
+var resourceStates = []string{"started", "stopped", "disabled", "ignored"}
+
+type resourceLine struct {
+	SID         string `json:"sid"`
+	State       string `json:"state"`
+	Group       string `json:"group,omitempty"`
+	MaxRestart  *int   `json:"max_restart,omitempty"`
+	MaxRelocate *int   `json:"max_relocate,omitempty"`
+	Comment     string `json:"comment,omitempty"`
+	Node        string `json:"node,omitempty"`
+	Status      string `json:"status,omitempty"`
+}
+
+func newResourcesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resources",
+		Aliases: []string{"resource"},
+		Short:   "Manage HA resources",
+		Args:    cobra.NoArgs,
+	}
+	cmd.AddCommand(newResourcesListCmd(), newResourcesAddCmd(), newResourcesUpdateCmd(), newResourcesRemoveCmd())
+	return cmd
+}
+
+func newResourcesListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List HA resources with their current node and state",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			resources, err := cluster.HAResources(ctx, "")
+			if err != nil {
+				return fmt.Errorf("list HA resources: %w", err)
+			}
+			entries, err := cluster.HAStatus(ctx)
+			if err != nil {
+				return fmt.Errorf("get HA status: %w", err)
+			}
+
+			lines := make([]resourceLine, 0, len(resources))
+			for _, resource := range resources {
+				if resource == nil {
+					continue
+				}
+				line := resourceLine{
+					SID:         resource.SID,
+					State:       "started",
+					Group:       resource.Group,
+					MaxRestart:  resource.MaxRestart,
+					MaxRelocate: resource.MaxRelocate,
+					Comment:     resource.Comment,
+				}
+				if resource.State != nil {
+					line.State = *resource.State
+				}
+				if status := serviceStatus(entries, resource.SID); status != nil {
+					line.Node, line.Status = status.Node, status.ServiceState
+				}
+				lines = append(lines, line)
+			}
+			sort.Slice(lines, func(i, j int) bool { return lines[i].SID < lines[j].SID })
+
+			if format == "json" {
+				return utility.PrintJSON(out, lines)
+			}
+			if len(lines) == 0 {
+				fmt.Fprintln(out, "No HA resources configured")
+				return nil
+			}
+			fmt.Fprintf(out, "%-12s %-10s %-12s %-8s %-9s %-14s %s\n", "SID", "State", "Group", "Restart", "Relocate", "Node", "Status")
+			fmt.Fprintf(out, "%-12s %-10s %-12s %-8s %-9s %-14s %s\n", "---", "-----", "-----", "-------", "--------", "----", "------")
+			for _, line := range lines {
+				fmt.Fprintf(out, "%-12s %-10s %-12s %-8s %-9s %-14s %s\n", line.SID, line.State, utility.DashIfEmpty(line.Group),
+					intOrDash(line.MaxRestart), intOrDash(line.MaxRelocate), utility.DashIfEmpty(line.Node), utility.DashIfEmpty(line.Status))
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newResourcesAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <sid>",
+		Short: "Put a guest under HA management",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			sid, err := normalizeSID(ctx, cluster, args[0])
+			if err != nil {
+				return err
+			}
+			option := &proxmox.HAResourceCreateOption{SID: sid}
+			flags := cmd.Flags()
+			if option.Group, err = flags.GetString("group"); err != nil {
+				return fmt.Errorf("get group flag: %w", err)
+			}
+			if option.Comment, err = flags.GetString("comment"); err != nil {
+				return fmt.Errorf("get comment flag: %w", err)
+			}
+			if flags.Changed("state") {
+				state, err := stateFromFlags(cmd)
+				if err != nil {
+					return err
+				}
+				option.State = &state
+			}
+			if flags.Changed("max-restart") {
+				if option.MaxRestart, err = intFlag(cmd, "max-restart"); err != nil {
+					return err
+				}
+			}
+			if flags.Changed("max-relocate") {
+				if option.MaxRelocate, err = intFlag(cmd, "max-relocate"); err != nil {
+					return err
+				}
+			}
+
+			if err := cluster.NewHAResource(ctx, option); err != nil {
+				return fmt.Errorf("add HA resource %q: %w", sid, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "HA resource %s added\n", sid)
+			return nil
+		},
+	}
+	addResourceFlags(cmd)
+	return cmd
+}
+
+func newResourcesUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <sid>",
+		Short: "Change an HA resource's state or policy",
+		Long: `Change an HA resource. Only the flags given are sent; pass an empty
+--group or --comment to clear them.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			sid, err := normalizeSID(ctx, cluster, args[0])
+			if err != nil {
+				return err
+			}
+			option := &proxmox.HAResourceUpdateOption{}
+			flags := cmd.Flags()
+			var deletes []string
+			if flags.Changed("group") {
+				if option.Group, err = flags.GetString("group"); err != nil {
+					return fmt.Errorf("get group flag: %w", err)
+				}
+				if option.Group == "" {
+					deletes = append(deletes, "group")
+				}
+			}
+			if flags.Changed("comment") {
+				if option.Comment, err = flags.GetString("comment"); err != nil {
+					return fmt.Errorf("get comment flag: %w", err)
+				}
+				if option.Comment == "" {
+					deletes = append(deletes, "comment")
+				}
+			}
+			if flags.Changed("state") {
+				state, err := stateFromFlags(cmd)
+				if err != nil {
+					return err
+				}
+				option.State = &state
+			}
+			if flags.Changed("max-restart") {
+				if option.MaxRestart, err = intFlag(cmd, "max-restart"); err != nil {
+					return err
+				}
+			}
+			if flags.Changed("max-relocate") {
+				if option.MaxRelocate, err = intFlag(cmd, "max-relocate"); err != nil {
+					return err
+				}
+			}
+			if len(deletes) == 0 && option.State == nil && option.Group == "" && option.Comment == "" &&
+				option.MaxRestart == nil && option.MaxRelocate == nil {
+				return fmt.Errorf("nothing to update: pass at least one of --state, --group, --max-restart, --max-relocate, --comment")
+			}
+			option.Delete = strings.Join(deletes, ",")
+
+			if err := cluster.UpdateHAResource(ctx, sid, option); err != nil {
+				return fmt.Errorf("update HA resource %q: %w", sid, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "HA resource %s updated\n", sid)
+			return nil
+		},
+	}
+	addResourceFlags(cmd)
+	return cmd
+}
+
+func newResourcesRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove <sid>",
+		Short: "Take a guest out of HA management",
+		Long: `Remove an HA resource. The guest itself is left as it is. --purge also
+removes the guest from HA groups and rules that reference it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			purge, err := cmd.Flags().GetBool("purge")
+			if err != nil {
+				return fmt.Errorf("get purge flag: %w", err)
+			}
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			sid, err := normalizeSID(ctx, cluster, args[0])
+			if err != nil {
+				return err
+			}
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Remove HA resource %s?", sid)); err != nil {
+				return err
+			}
+			if err := cluster.DeleteHAResource(ctx, sid, purge); err != nil {
+				return fmt.Errorf("remove HA resource %q: %w", sid, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "HA resource %s removed\n", sid)
+			return nil
+		},
+	}
+	utility.AddYesFlag(cmd)
+	cmd.Flags().Bool("purge", false, "Also remove the resource from HA groups and rules")
+	return cmd
+}
+
+func addResourceFlags(cmd *cobra.Command) {
+	cmd.Flags().String("state", "started", "Requested state ("+strings.Join(resourceStates, ", ")+")")
+	cmd.Flags().String("group", "", "HA group the resource belongs to")
+	cmd.Flags().Int("max-restart", 1, "Restart attempts on the same node before relocating")
+	cmd.Flags().Int("max-relocate", 1, "Relocation attempts before giving up")
+	cmd.Flags().String("comment", "", "Comment")
+	_ = cmd.RegisterFlagCompletionFunc("state", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return resourceStates, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+func stateFromFlags(cmd *cobra.Command) (string, error) {
+	state, err := cmd.Flags().GetString("state")
+	if err != nil {
+		return "", fmt.Errorf("get state flag: %w", err)
+	}
+	if !slices.Contains(resourceStates, state) {
+		return "", fmt.Errorf("invalid --state %q: must be one of %s", state, strings.Join(resourceStates, ", "))
+	}
+	return state, nil
+}
+
+func intFlag(cmd *cobra.Command, name string) (*int, error) {
+	value, err := cmd.Flags().GetInt(name)
+	if err != nil {
+		return nil, fmt.Errorf("get %s flag: %w", name, err)
+	}
+	if value < 0 {
+		return nil, fmt.Errorf("--%s cannot be negative", name)
+	}
+	return &value, nil
+}
+
+func intOrDash(value *int) string {
+	if value == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d", *value)
+}
@@ -1,29 +1,38 @@
 package lxc
 
 import (
+	"context"
 	"fmt"
 	"github.com/Adz-ai/proxmox-cli/cmd/utility"
-	"os"
 	"sort"
 	"strings"
 
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
 	"github.com/luthermonson/go-proxmox"
 	"github.com/spf13/cobra"
-	"gopkg.in/yaml.v3"
 )
 
 func newCreateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a new LXC container",
-		Args:  cobra.NoArgs,
+		Long: `Create containers from a YAML spec of pct create parameters. Specs may use
+variables (--set, --values, or the environment) through ${name} or Go
+template syntax, "extends: base.yaml" to inherit a shared base, and
+several YAML documents to create one container per document:
+
+  proxmox-cli lxc create -n pve -s ct.yaml --set hostname=web1 --set ip=10.0.0.11/24
+
+ostemplate (or --template) may be a volume ID or a short name such as
+debian-12. Short names resolve to the newest matching base OS appliance,
+which is downloaded to --template-storage first when it is not there yet.
+
+The bridge of each netN entry must exist on the node or be an SDN vnet,
+e.g. net0: name=eth0,bridge=vnet20,ip=dhcp.`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			out := cmd.OutOrStdout()
 			ctx := cmd.Context()
-			client, err := utility.AuthenticatedClient()
-			if err != nil {
-				return fmt.Errorf("authenticate Proxmox client: %w", err)
-			}
 
 			nodeName, err := cmd.Flags().GetString("node")
 			if err != nil {
@@ -46,39 +55,85 @@ func newCreateCmd() *cobra.Command {
 			if strings.TrimSpace(specFile) == "" {
 				return fmt.Errorf("spec path cannot be empty")
 			}
-			vmid, err = utility.ResolveVMID(ctx, client, vmid)
+			template, err := cmd.Flags().GetString("template")
 			if err != nil {
-				return err
+				return fmt.Errorf("read template flag: %w", err)
 			}
-
-			data, err := os.ReadFile(specFile)
+			templateStorage, err := cmd.Flags().GetString("template-storage")
 			if err != nil {
-				return fmt.Errorf("read spec file %q: %w", specFile, err)
+				return fmt.Errorf("read template-storage flag: %w", err)
+			}
+			templateStorage = strings.TrimSpace(templateStorage)
+			if templateStorage == "" {
+				return fmt.Errorf("template storage cannot be empty")
 			}
 
-			var spec map[string]any
-			if err := yaml.Unmarshal(data, &spec); err != nil {
-				return fmt.Errorf("parse spec file %q: %w", specFile, err)
+			values, err := utility.SpecValuesFromFlags(cmd)
+			if err != nil {
+				return err
 			}
-			options, err := containerOptionsFromSpec(spec)
+			specs, err := utility.LoadSpecs(specFile, values)
 			if err != nil {
-				return fmt.Errorf("validate container spec: %w", err)
+				return fmt.Errorf("read spec file %q: %w", specFile, err)
+			}
+			if len(specs) > 1 && vmid != 0 {
+				return fmt.Errorf("--vmid cannot be used with a multi-document spec; IDs are auto-assigned")
+			}
+			allOptions := make([][]proxmox.ContainerOption, 0, len(specs))
+			for index, spec := range specs {
+				if template = strings.TrimSpace(template); template != "" {
+					spec["ostemplate"] = template
+				}
+				options, err := containerOptionsFromSpec(spec)
+				if err != nil {
+					if len(specs) > 1 {
+						return fmt.Errorf("validate container spec document %d: %w", index+1, err)
+					}
+					return fmt.Errorf("validate container spec: %w", err)
+				}
+				allOptions = append(allOptions, options)
 			}
 
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
 			node, err := client.Node(ctx, nodeName)
 			if err != nil {
 				return fmt.Errorf("get node %q: %w", nodeName, err)
 			}
 
-			task, err := node.NewContainer(ctx, vmid, options...)
-			if err != nil {
-				return fmt.Errorf("create container %d: %w", vmid, err)
-			}
-			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
-				return fmt.Errorf("create container %d: %w", vmid, err)
+			if err := validateNetBridges(ctx, client, node, nodeName, allOptions); err != nil {
+				return err
 			}
 
-			fmt.Fprintf(out, "Container %d created successfully\n", vmid)
+			resolver := &templateResolver{node: node, storage: templateStorage, timeout: utility.TaskTimeout(cmd), progress: out}
+			for _, options := range allOptions {
+				for i, option := range options {
+					if option.Name != "ostemplate" {
+						continue
+					}
+					if name := option.Value.(string); !isTemplateVolume(name) {
+						volid, err := resolver.resolve(ctx, name)
+						if err != nil {
+							return err
+						}
+						options[i].Value = volid
+					}
+				}
+				id, err := utility.ResolveVMID(ctx, client, vmid)
+				if err != nil {
+					return err
+				}
+				task, err := node.NewContainer(ctx, id, options...)
+				if err != nil {
+					return fmt.Errorf("create container %d: %w", id, err)
+				}
+				if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+					return fmt.Errorf("create container %d: %w", id, err)
+				}
+				fmt.Fprintf(out, "Container %d created successfully\n", id)
+			}
 			return nil
 		},
 	}
@@ -86,6 +141,9 @@ func newCreateCmd() *cobra.Command {
 	cmd.Flags().StringP("node", "n", "", "Node name")
 	cmd.Flags().IntP("vmid", "i", 0, "Container ID (omit to auto-assign the next free ID)")
 	cmd.Flags().StringP("spec", "s", "", "YAML specification file")
+	cmd.Flags().String("template", "", "OS template overriding the spec's ostemplate, e.g. debian-12")
+	cmd.Flags().String("template-storage", "local", "Storage that holds (or receives) short-name templates")
+	utility.AddSpecValueFlags(cmd)
 	for _, flag := range []string{"node", "spec"} {
 		if err := cmd.MarkFlagRequired(flag); err != nil {
 			panic(err)
@@ -134,6 +192,30 @@ func containerOptionsFromSpec(spec map[string]any) ([]proxmox.ContainerOption, e
 	return options, nil
 }
 
+// validateNetBridges checks the bridge of every netN entry once, so typos fail
+// before any container is created.
+func validateNetBridges(ctx context.Context, client interfaces.ProxmoxClientInterface, node interfaces.NodeInterface, nodeName string, allOptions [][]proxmox.ContainerOption) error {
+	checked := map[string]bool{}
+	for _, options := range allOptions {
+		for _, option := range options {
+			if !strings.HasPrefix(option.Name, "net") {
+				continue
+			}
+			for _, part := range strings.Split(fmt.Sprint(option.Value), ",") {
+				bridge, ok := strings.CutPrefix(strings.TrimSpace(part), "bridge=")
+				if !ok || checked[bridge] {
+					continue
+				}
+				if err := utility.ValidateBridge(ctx, client, node, nodeName, bridge); err != nil {
+					return fmt.Errorf("%s: %w", option.Name, err)
+				}
+				checked[bridge] = true
+			}
+		}
+	}
+	return nil
+}
+
 func indexedContainerCreateKey(key string) bool {
 	for _, prefix := range []string{"dev", "mp", "net"} {
 		if suffix := strings.TrimPrefix(key, prefix); suffix != key && suffix != "" {
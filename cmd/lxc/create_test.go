@@ -2,6 +2,8 @@ package lxc
 
 import (
 	"testing"
+
+	"github.com/luthermonson/go-proxmox"
 )
 
 func TestContainerOptionsFromSpec(t *testing.T) {
@@ -65,3 +67,43 @@ func TestValidateContainerTarget(t *testing.T) {
 		t.Error("expected error for nonpositive vmid")
 	}
 }
+
+func TestCompareVersions(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"12.10-1", "12.7-1", 1},
+		{"12.7-1", "12.7-1", 0},
+		{"11.7-1", "12.2-1", -1},
+		{"24.04-2", "24.04", 1},
+	}
+	for _, c := range cases {
+		got := compareVersions(c.a, c.b)
+		if (got > 0) != (c.want > 0) || (got < 0) != (c.want < 0) {
+			t.Errorf("compareVersions(%q, %q) = %d, want sign %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestNewestApplianceSkipsTurnKeyForShortNames(t *testing.T) {
+	appliances := proxmox.Appliances{
+		{Type: "lxc", Section: "system", Template: "debian-12-standard_12.7-1_amd64.tar.zst", Version: "12.7-1"},
+		{Type: "lxc", Section: "turnkey", Template: "debian-12-turnkey-nextcloud_18.2-1_amd64.tar.gz", Version: "18.2-1"},
+	}
+	appliance, err := newestAppliance(appliances, "debian-12")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if appliance.Template != "debian-12-standard_12.7-1_amd64.tar.zst" {
+		t.Fatalf("debian-12 resolved to %s", appliance.Template)
+	}
+
+	appliance, err = newestAppliance(appliances, "debian-12-turnkey-nextcloud_18.2-1_amd64.tar.gz")
+	if err != nil || appliance.Section != "turnkey" {
+		t.Fatalf("exact TurnKey name not resolved: %+v, %v", appliance, err)
+	}
+	if _, err := newestAppliance(appliances, "debian-12-turnkey-nextcloud"); err == nil {
+		t.Fatal("expected no match for a TurnKey prefix")
+	}
+}
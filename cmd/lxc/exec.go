@@ -0,0 +1,122 @@
+package lxc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// execPasswordEnv supplies the container login password for lxc exec when
+// the console asks for one and stdin is not a terminal.
+const execPasswordEnv = "PROXMOX_CLI_LXC_PASSWORD"
+
+func newExecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec -n <node> -i <vmid> -- command [args...]",
+		Short: "Run a command inside an LXC container via its console",
+		Long: `Execute a command in the container by driving its console through the
+Proxmox terminal proxy, and print its output, e.g.:
+
+  proxmox-cli lxc exec -n pve -i 200 -- apt-get update
+
+If the console shows a login prompt, the CLI logs in as --user with the
+password from ` + execPasswordEnv + ` (or a prompt when run interactively)
+and logs out again afterwards. Arguments are shell-quoted; use
+"-- sh -c '...'" for pipelines. The console is a terminal, so stdout and
+stderr arrive interleaved on stdout and the command gets no stdin. A
+nonzero exit status is returned as an error.
+
+Requires password (session) authentication; Proxmox does not allow API
+tokens to open console websockets.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			nodeName, vmid, err := containerTargetFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			user, err := cmd.Flags().GetString("user")
+			if err != nil {
+				return fmt.Errorf("read user flag: %w", err)
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), utility.TaskTimeout(cmd))
+			defer cancel()
+
+			client, err := utility.SessionClient()
+			if err != nil {
+				return err
+			}
+			retrievedNode, err := client.Node(ctx, nodeName)
+			if err != nil {
+				return fmt.Errorf("get node %q: %w", nodeName, err)
+			}
+			container, err := retrievedNode.Container(ctx, vmid)
+			if err != nil {
+				return fmt.Errorf("get container %d: %w", vmid, err)
+			}
+			output, exitCode, err := execInContainer(ctx, cmd, container, vmid, user, utility.ShellQuote(args))
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprint(out, output)
+			if output != "" && !strings.HasSuffix(output, "\n") {
+				fmt.Fprintln(out)
+			}
+			if exitCode != 0 {
+				return fmt.Errorf("command exited with code %d", exitCode)
+			}
+			return nil
+		},
+	}
+
+	addContainerTargetFlags(cmd)
+	cmd.Flags().String("user", "root", "User to log in as if the console asks for a login")
+	return cmd
+}
+
+// execInContainer runs a shell command line in the container through its
+// console, logging in as user when the console asks for it.
+func execInContainer(ctx context.Context, cmd *cobra.Command, container interfaces.ContainerInterface, vmid int, user, command string) (string, int, error) {
+	term, err := container.TermProxy(ctx)
+	if err != nil {
+		return "", 0, fmt.Errorf("open terminal proxy for container %d: %w", vmid, err)
+	}
+	send, recv, errs, closer, err := container.TermWebSocket(term)
+	if err != nil {
+		return "", 0, fmt.Errorf("connect console websocket for container %d: %w", vmid, err)
+	}
+	defer func() { _ = closer() }()
+
+	session := utility.NewConsoleSession(send, recv, errs)
+	password := func() (string, error) { return execPassword(cmd, user, vmid) }
+	output, exitCode, err := session.Run(ctx, user, password, command)
+	if err != nil {
+		return "", 0, fmt.Errorf("execute command in container %d: %w", vmid, err)
+	}
+	return output, exitCode, nil
+}
+
+func execPassword(cmd *cobra.Command, user string, vmid int) (string, error) {
+	if password, ok := os.LookupEnv(execPasswordEnv); ok {
+		return password, nil
+	}
+	file, ok := cmd.InOrStdin().(*os.File)
+	if !ok || !term.IsTerminal(int(file.Fd())) {
+		return "", fmt.Errorf("the container console asks for a login; set %s", execPasswordEnv)
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "Password for %s in container %d: ", user, vmid)
+	password, err := term.ReadPassword(int(file.Fd()))
+	fmt.Fprintln(cmd.ErrOrStderr())
+	if err != nil {
+		return "", fmt.Errorf("read password: %w", err)
+	}
+	return string(password), nil
+}
@@ -15,6 +15,7 @@ type containerSummary struct {
 	VMID   uint64 `json:"vmid"`
 	Name   string `json:"name"`
 	Status string `json:"status"`
+	Pool   string `json:"pool,omitempty"`
 	Uptime uint64 `json:"uptime_seconds"`
 }
 
@@ -37,6 +38,10 @@ func newGetCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("get status flag: %w", err)
 			}
+			poolFilter, err := cmd.Flags().GetString("pool")
+			if err != nil {
+				return fmt.Errorf("get pool flag: %w", err)
+			}
 			ctx := cmd.Context()
 			client, err := utility.AuthenticatedClient()
 			if err != nil {
@@ -47,6 +52,11 @@ func newGetCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("list nodes: %w", err)
 			}
+			// Pools only decorate the listing unless --pool filters on them.
+			pools, err := utility.GuestPools(ctx, client)
+			if err != nil && poolFilter != "" {
+				return err
+			}
 
 			summaries := []containerSummary{}
 			var nodeErrors []error
@@ -70,11 +80,16 @@ func newGetCmd() *cobra.Command {
 					if statusFilter != "" && container.Status != statusFilter {
 						continue
 					}
+					pool := pools[uint64(container.VMID)]
+					if poolFilter != "" && pool != poolFilter {
+						continue
+					}
 					summaries = append(summaries, containerSummary{
 						Node:   nodeStatus.Node,
 						VMID:   uint64(container.VMID),
 						Name:   container.Name,
 						Status: container.Status,
+						Pool:   pool,
 						Uptime: container.Uptime,
 					})
 				}
@@ -96,7 +111,9 @@ func newGetCmd() *cobra.Command {
 
 	cmd.Flags().StringP("node", "n", "", "Only list containers on this node")
 	cmd.Flags().String("status", "", "Only list containers with this status")
+	cmd.Flags().String("pool", "", "Only list containers in this resource pool")
 	utility.RegisterNodeFlagCompletion(cmd, "node")
+	utility.RegisterPoolFlagCompletion(cmd, "pool")
 	utility.AddOutputFlag(cmd)
 	return cmd
 }
@@ -110,8 +127,8 @@ func printContainerTable(out io.Writer, summaries []containerSummary) {
 		if summary.Node != currentNode {
 			currentNode = summary.Node
 			fmt.Fprintf(out, "\nNode: %s\n", summary.Node)
-			fmt.Fprintf(out, "%-10s %-20s %-10s %-12s %-12s\n", "VMID", "Name", "Status", "Type", "Uptime")
-			fmt.Fprintf(out, "%-10s %-20s %-10s %-12s %-12s\n", "----", "----", "------", "----", "------")
+			fmt.Fprintf(out, "%-10s %-20s %-10s %-12s %-12s %-12s\n", "VMID", "Name", "Status", "Type", "Pool", "Uptime")
+			fmt.Fprintf(out, "%-10s %-20s %-10s %-12s %-12s %-12s\n", "----", "----", "------", "----", "----", "------")
 		}
 		uptime := "N/A"
 		if summary.Uptime > 0 {
@@ -119,11 +136,16 @@ func printContainerTable(out io.Writer, summaries []containerSummary) {
 			hours := (summary.Uptime % 86400) / 3600
 			uptime = fmt.Sprintf("%dd %dh", days, hours)
 		}
-		fmt.Fprintf(out, "%-10v %-20s %-10s %-12s %-12s\n",
+		pool := summary.Pool
+		if pool == "" {
+			pool = "-"
+		}
+		fmt.Fprintf(out, "%-10v %-20s %-10s %-12s %-12s %-12s\n",
 			summary.VMID,
 			summary.Name,
 			summary.Status,
 			"lxc",
+			pool,
 			uptime)
 	}
 
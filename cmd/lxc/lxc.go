@@ -36,6 +36,9 @@ func NewCmd() *cobra.Command {
 		newIPCmd(),
 		newStatsCmd(),
 		newConsoleCmd(),
+		newExecCmd(),
+		newMountCmd(),
+		newPublishCmd(),
 	)
 	return cmd
 }
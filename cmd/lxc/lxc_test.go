@@ -3,6 +3,9 @@ package lxc
 import (
 	"bytes"
 	"context"
+	"errors"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -301,3 +304,380 @@ func TestDescribeJSONOutput(t *testing.T) {
 		}
 	}
 }
+
+func TestCreateMultiDocumentSpec(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+	cluster := mocks.NewMockClusterInterface(ctrl)
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "base.yaml"), []byte("ostemplate: local:vztmpl/debian-12.tar.zst\nmemory: 512\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	spec := filepath.Join(dir, "fleet.yaml")
+	if err := os.WriteFile(spec, []byte("extends: base.yaml\nhostname: ${prefix}-1\n---\nextends: base.yaml\nhostname: ${prefix}-2\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	client.EXPECT().Cluster(ctx).Return(cluster, nil).Times(2)
+	gomock.InOrder(
+		cluster.EXPECT().NextID(ctx).Return(300, nil),
+		cluster.EXPECT().NextID(ctx).Return(301, nil),
+	)
+	var hostnames []string
+	node.EXPECT().NewContainer(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int, options ...proxmox.ContainerOption) (*proxmox.Task, error) {
+			for _, option := range options {
+				if option.Name == "hostname" {
+					hostnames = append(hostnames, option.Value.(string))
+				}
+			}
+			return &proxmox.Task{IsSuccessful: true}, nil
+		}).Times(2)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"create", "-n", "pve", "-s", spec, "--set", "prefix=web"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(hostnames, ",") != "web-1,web-2" {
+		t.Errorf("unexpected hostnames %v", hostnames)
+	}
+	for _, want := range []string{"Container 300 created", "Container 301 created"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestCreateValidatesNetBridges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+	cluster := mocks.NewMockClusterInterface(ctrl)
+
+	spec := filepath.Join(t.TempDir(), "ct.yaml")
+	content := "ostemplate: local:vztmpl/debian-12.tar.zst\nnet0: name=eth0,bridge=vnet20,ip=dhcp\nnet1: name=eth1,bridge=${bridge}\n"
+	if err := os.WriteFile(spec, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	client.EXPECT().Cluster(ctx).Return(cluster, nil).Times(2)
+	node.EXPECT().Networks(ctx).Return(proxmox.NodeNetworks{{Iface: "vmbr0", Type: "bridge"}}, nil).Times(2)
+	cluster.EXPECT().SDNVNets(ctx).Return([]*proxmox.VNet{{Name: "vnet20", Zone: "vlans"}, {Name: "vnet40", Zone: "remote"}}, nil).Times(2)
+	cluster.EXPECT().SDNZones(ctx).Return([]*proxmox.SDNZone{
+		{Name: "vlans"},
+		{Name: "remote", Nodes: proxmox.CSV{"pve2"}},
+	}, nil).Times(2)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"create", "-n", "pve", "-i", "200", "-s", spec, "--set", "bridge=vnet40"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), `net1: SDN vnet "vnet40" is in zone "remote", which does not include node "pve"`) {
+		t.Fatalf("expected zone error, got %v", err)
+	}
+}
+
+func TestMountAddUsesNextFreeSlot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+	container := mocks.NewMockContainerInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Container(ctx, 200).Return(container, nil)
+	container.EXPECT().CurrentConfig().Return(&proxmox.ContainerConfig{
+		Mps: map[string]string{"mp0": "local-lvm:vm-200-disk-1,mp=/srv/data,size=8G"},
+	}).AnyTimes()
+	container.EXPECT().Config(ctx, proxmox.ContainerOption{Name: "mp1", Value: "local-lvm:16,mp=/srv/logs,backup=1"}).
+		Return(&proxmox.Task{IsSuccessful: true}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"mount", "add", "-n", "pve", "-i", "200", "--storage", "local-lvm", "--size", "16G", "--path", "/srv/logs", "--backup"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "Mount point mp1 added to container 200") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestMountAddRejectsMixedSources(t *testing.T) {
+	cmd := NewCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"mount", "add", "-n", "pve", "-i", "200", "--storage", "local-lvm", "--size", "8", "--bind", "/mnt/media", "--path", "/media"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "not both") {
+		t.Fatalf("expected mixed source error, got %v", err)
+	}
+}
+
+func TestMountMoveRejectsBindMount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+	container := mocks.NewMockContainerInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Container(ctx, 200).Return(container, nil)
+	container.EXPECT().CurrentConfig().Return(&proxmox.ContainerConfig{
+		Mps: map[string]string{"mp0": "/mnt/media,mp=/media,ro=1"},
+	})
+
+	cmd := NewCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"mount", "move", "-n", "pve", "-i", "200", "--mp", "mp0", "--target-storage", "ceph"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "bind mount") {
+		t.Fatalf("expected bind mount error, got %v", err)
+	}
+}
+
+func TestMountMoveSendsVolume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+	container := mocks.NewMockContainerInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Container(ctx, 200).Return(container, nil)
+	container.EXPECT().CurrentConfig().Return(&proxmox.ContainerConfig{
+		Mps: map[string]string{"mp0": "local-lvm:vm-200-disk-1,mp=/data,size=8G"},
+	})
+	container.EXPECT().MoveVolume(ctx, &interfaces.ContainerMoveVolumeOptions{
+		Volume: "mp0", Storage: "ceph", Delete: proxmox.IntOrBool(true),
+	}).Return(&proxmox.Task{IsSuccessful: true}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"mount", "move", "-n", "pve", "-i", "200", "--mp", "mp0", "--target-storage", "ceph", "--delete-source", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "Volume mp0 of container 200 moved to ceph") {
+		t.Fatalf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestParseMountRoundTrip(t *testing.T) {
+	value := "local-lvm:vm-200-disk-1,mp=/srv/data,size=8G,backup=1,acl=1"
+	mount, err := parseMount("mp0", value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mount.Path != "/srv/data" || !mount.Backup || mount.isBind() || mount.String() != value {
+		t.Errorf("unexpected mount %+v (%s)", mount, mount)
+	}
+}
+
+func TestCreateDownloadsShortNameTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	spec := filepath.Join(t.TempDir(), "ct.yaml")
+	if err := os.WriteFile(spec, []byte("hostname: web\nmemory: 512\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Appliances(ctx).Return(proxmox.Appliances{
+		{Type: "lxc", Section: "system", Template: "debian-11-standard_11.7-1_amd64.tar.zst", Version: "11.7-1"},
+		{Type: "lxc", Section: "system", Template: "debian-12-standard_12.2-1_amd64.tar.zst", Version: "12.2-1"},
+		{Type: "lxc", Section: "system", Template: "debian-12-standard_12.10-1_amd64.tar.zst", Version: "12.10-1"},
+		{Type: "lxc", Section: "turnkey", Template: "debian-12-turnkey-wordpress_18.1-1_amd64.tar.gz", Version: "18.1-1"},
+	}, nil)
+	node.EXPECT().VzTmpls(ctx, "local").Return(proxmox.VzTmpls{}, nil)
+	node.EXPECT().DownloadAppliance(ctx, "debian-12-standard_12.10-1_amd64.tar.zst", "local").Return("UPID:pve:download", nil)
+	node.EXPECT().Task("UPID:pve:download").Return(&proxmox.Task{IsSuccessful: true})
+	node.EXPECT().NewContainer(ctx, 200, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int, options ...proxmox.ContainerOption) (*proxmox.Task, error) {
+			for _, option := range options {
+				if option.Name == "ostemplate" && option.Value != "local:vztmpl/debian-12-standard_12.10-1_amd64.tar.zst" {
+					t.Errorf("ostemplate = %v", option.Value)
+				}
+			}
+			return &proxmox.Task{IsSuccessful: true}, nil
+		})
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"create", "-n", "pve", "-i", "200", "-s", spec, "--template", "debian-12"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "Downloading template debian-12-standard_12.10-1_amd64.tar.zst") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestPublishRegistersBackupAsTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+	container := mocks.NewMockContainerInterface(ctrl)
+	storage := mocks.NewMockStorageInterface(ctrl)
+
+	ctx := gomock.Any()
+	upid := "UPID:pve:00001234:00005678:6710A000:vzdump:200:root@pam:"
+	archive := "local:backup/vzdump-lxc-200-2026_10_19-10_00_00.tar.zst"
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Container(ctx, 200).Return(container, nil)
+	container.EXPECT().Details().Return(interfaces.ContainerDetails{Status: "stopped"})
+	node.EXPECT().VzTmpls(ctx, "local").Return(proxmox.VzTmpls{}, nil)
+	node.EXPECT().Vzdump(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, options *proxmox.VirtualMachineBackupOptions) (*proxmox.Task, error) {
+			if options.VMID != 200 || options.Mode != "stop" || options.Storage != "local" {
+				t.Errorf("unexpected vzdump options %+v", options)
+			}
+			return &proxmox.Task{UPID: proxmox.UPID(upid), IsSuccessful: true}, nil
+		})
+	node.EXPECT().TaskLog(ctx, upid).Return([]string{
+		"INFO: starting new backup job: vzdump 200 --mode stop --compress zstd --storage local",
+		"INFO: creating vzdump archive '/var/lib/vz/dump/vzdump-lxc-200-2026_10_19-10_00_00.tar.zst'",
+		"INFO: Finished Backup of VM 200 (00:00:12)",
+	}, nil)
+	node.EXPECT().Storage(ctx, "local").Return(storage, nil)
+	storage.EXPECT().CopyContent(ctx, archive, "local:vztmpl/myapp-base.tar.zst", "").Return(&proxmox.Task{IsSuccessful: true}, nil)
+	storage.EXPECT().DeleteContent(ctx, archive).Return(&proxmox.Task{IsSuccessful: true}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"publish", "-n", "pve", "-i", "200", "--name", "myapp-base"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "published as local:vztmpl/myapp-base.tar.zst") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestPublishCleanChecksStatusBeforePrompting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+	container := mocks.NewMockContainerInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Container(ctx, 200).Return(container, nil)
+	container.EXPECT().Details().Return(interfaces.ContainerDetails{Status: "running"})
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetIn(strings.NewReader("y\n"))
+	cmd.SetArgs([]string{"publish", "-n", "pve", "-i", "200", "--name", "myapp-base", "--clean"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "shut it down") {
+		t.Fatalf("expected running container error, got %v", err)
+	}
+	if strings.Contains(out.String(), "remove its SSH host keys") {
+		t.Errorf("prompted before checking the container status:\n%s", out.String())
+	}
+}
+
+func TestPublishFailsWithoutArchiveInTaskLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+	container := mocks.NewMockContainerInterface(ctrl)
+
+	ctx := gomock.Any()
+	upid := "UPID:pve:00001234:00005678:6710A000:vzdump:200:root@pam:"
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Container(ctx, 200).Return(container, nil)
+	container.EXPECT().Details().Return(interfaces.ContainerDetails{Status: "stopped"})
+	node.EXPECT().VzTmpls(ctx, "local").Return(proxmox.VzTmpls{}, nil)
+	node.EXPECT().Vzdump(ctx, gomock.Any()).Return(&proxmox.Task{UPID: proxmox.UPID(upid), IsSuccessful: true}, nil)
+	node.EXPECT().TaskLog(ctx, upid).Return([]string{"INFO: Finished Backup of VM 200 (00:00:12)"}, nil)
+
+	cmd := NewCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"publish", "-n", "pve", "-i", "200", "--name", "myapp-base"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "did not log an archive name") {
+		t.Fatalf("expected missing archive error, got %v", err)
+	}
+}
+
+func TestPublishRejectsRunningContainer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+	container := mocks.NewMockContainerInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Container(ctx, 200).Return(container, nil)
+	container.EXPECT().Details().Return(interfaces.ContainerDetails{Status: "running"})
+
+	cmd := NewCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"publish", "-n", "pve", "-i", "200", "--name", "myapp-base"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "shut it down") {
+		t.Fatalf("expected running container error, got %v", err)
+	}
+}
+
+func TestGetShowsDashWhenPoolLookupFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Nodes(ctx).Return(proxmox.NodeStatuses{{Node: "pve", Status: "online"}}, nil)
+	client.EXPECT().Cluster(ctx).Return(nil, errors.New("permission denied"))
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Containers(ctx).Return(proxmox.Containers{{VMID: 200, Name: "app", Status: "running"}}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"get"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "app") || !strings.Contains(out.String(), " - ") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestGetPoolFilterFailsWithoutPoolLookup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Nodes(ctx).Return(proxmox.NodeStatuses{{Node: "pve", Status: "online"}}, nil)
+	client.EXPECT().Cluster(ctx).Return(nil, errors.New("permission denied"))
+
+	cmd := NewCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"get", "--pool", "web-team"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Fatalf("expected pool lookup error, got %v", err)
+	}
+}
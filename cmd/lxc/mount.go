@@ -0,0 +1,544 @@
+package lxc
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// maxMountPoints is the number of mpN slots Proxmox allows per container.
+const maxMountPoints = 256
+
+// containerMount is a parsed mpN value. Options the CLI has no typed flag
+// for (acl, mountoptions, replicate, ...) are kept verbatim in Extra so
+// edits preserve them.
+type containerMount struct {
+	Name     string   `json:"name"`
+	Volume   string   `json:"volume"`
+	Path     string   `json:"path"`
+	Size     string   `json:"size,omitempty"`
+	ReadOnly bool     `json:"read_only"`
+	Backup   bool     `json:"backup"`
+	Quota    bool     `json:"quota"`
+	Shared   bool     `json:"shared"`
+	Extra    []string `json:"extra,omitempty"`
+}
+
+// isBind reports whether the mount point is a host bind mount rather than
+// a storage volume.
+func (m containerMount) isBind() bool {
+	return strings.HasPrefix(m.Volume, "/")
+}
+
+// parseMount decodes an mpN value such as
+// "local-lvm:vm-200-disk-1,mp=/srv/data,backup=1,size=8G".
+func parseMount(name, value string) (containerMount, error) {
+	mount := containerMount{Name: name}
+	for i, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, val, found := strings.Cut(part, "=")
+		switch {
+		case i == 0 && !found:
+			mount.Volume = part
+		case key == "volume":
+			mount.Volume = val
+		case key == "mp":
+			mount.Path = val
+		case key == "size":
+			mount.Size = val
+		case key == "ro":
+			mount.ReadOnly = val == "1"
+		case key == "backup":
+			mount.Backup = val == "1"
+		case key == "quota":
+			mount.Quota = val == "1"
+		case key == "shared":
+			mount.Shared = val == "1"
+		default:
+			mount.Extra = append(mount.Extra, part)
+		}
+	}
+	if mount.Volume == "" {
+		return containerMount{}, fmt.Errorf("parse %s: no volume in %q", name, value)
+	}
+	return mount, nil
+}
+
+// String encodes the mount point back into the mpN property format.
+func (m containerMount) String() string {
+	parts := []string{m.Volume}
+	if m.Path != "" {
+		parts = append(parts, "mp="+m.Path)
+	}
+	if m.Size != "" {
+		parts = append(parts, "size="+m.Size)
+	}
+	if m.ReadOnly {
+		parts = append(parts, "ro=1")
+	}
+	if m.Backup {
+		parts = append(parts, "backup=1")
+	}
+	if m.Quota {
+		parts = append(parts, "quota=1")
+	}
+	if m.Shared {
+		parts = append(parts, "shared=1")
+	}
+	return strings.Join(append(parts, m.Extra...), ",")
+}
+
+// sortedMounts parses every mpN entry of a container config, ordered by
+// index.
+func sortedMounts(config *proxmox.ContainerConfig) ([]containerMount, error) {
+	names := make([]string, 0, len(config.Mps))
+	for name := range config.Mps {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return mountIndex(names[i]) < mountIndex(names[j]) })
+
+	mounts := make([]containerMount, 0, len(names))
+	for _, name := range names {
+		mount, err := parseMount(name, config.Mps[name])
+		if err != nil {
+			return nil, err
+		}
+		mounts = append(mounts, mount)
+	}
+	return mounts, nil
+}
+
+func mountIndex(name string) int {
+	index, err := strconv.Atoi(strings.TrimPrefix(name, "mp"))
+	if err != nil {
+		return -1
+	}
+	return index
+}
+
+// nextFreeMount returns the lowest mpN key not used by the config.
+func nextFreeMount(config *proxmox.ContainerConfig) (string, error) {
+	for index := 0; index < maxMountPoints; index++ {
+		name := fmt.Sprintf("mp%d", index)
+		if _, used := config.Mps[name]; !used {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("all %d mount point slots are in use", maxMountPoints)
+}
+
+func validateMountName(name string) error {
+	if index := mountIndex(name); !strings.HasPrefix(name, "mp") || index < 0 || index >= maxMountPoints {
+		return fmt.Errorf("invalid mount point %q; use mp0 to mp%d", name, maxMountPoints-1)
+	}
+	return nil
+}
+
+// parseMountSize converts a size such as 8, 8G, or 0.5G into the GiB
+// number Proxmox expects when allocating a new volume.
+func parseMountSize(size string) (string, error) {
+	trimmed := strings.TrimSuffix(strings.TrimSuffix(strings.ToUpper(strings.TrimSpace(size)), "B"), "G")
+	gib, err := strconv.ParseFloat(trimmed, 64)
+	if err != nil || gib <= 0 {
+		return "", fmt.Errorf("invalid size %q; use GiB, e.g. 8 or 8G", size)
+	}
+	return strconv.FormatFloat(gib, 'f', -1, 64), nil
+}
+
+func newMountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mount",
+		Short: "Manage LXC container mount points",
+		Long: `List, add, remove, and move container mount points with typed flags
+instead of hand-written mpN strings.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newMountListCmd(), newMountAddCmd(), newMountRemoveCmd(), newMountMoveCmd())
+	return cmd
+}
+
+func newMountListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List LXC container mount points",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			container, vmid, err := containerFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			mounts, err := sortedMounts(container.CurrentConfig())
+			if err != nil {
+				return fmt.Errorf("read mount points of container %d: %w", vmid, err)
+			}
+
+			if format == "json" {
+				return utility.PrintJSON(out, mounts)
+			}
+			printMountTable(out, vmid, mounts)
+			return nil
+		},
+	}
+
+	addContainerTargetFlags(cmd)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func printMountTable(out io.Writer, vmid int, mounts []containerMount) {
+	fmt.Fprintf(out, "Mount points of container %d:\n", vmid)
+	fmt.Fprintf(out, "%-6s %-32s %-20s %-8s %s\n", "MP", "Volume", "Path", "Size", "Options")
+	fmt.Fprintf(out, "%-6s %-32s %-20s %-8s %s\n", "--", "------", "----", "----", "-------")
+	for _, mount := range mounts {
+		size := mount.Size
+		if size == "" {
+			size = "-"
+		}
+		options := []string{}
+		if mount.isBind() {
+			options = append(options, "bind")
+		}
+		for _, flag := range []struct {
+			set  bool
+			name string
+		}{{mount.ReadOnly, "ro"}, {mount.Backup, "backup"}, {mount.Quota, "quota"}, {mount.Shared, "shared"}} {
+			if flag.set {
+				options = append(options, flag.name)
+			}
+		}
+		options = append(options, mount.Extra...)
+		optionText := strings.Join(options, ",")
+		if optionText == "" {
+			optionText = "-"
+		}
+		fmt.Fprintf(out, "%-6s %-32s %-20s %-8s %s\n", mount.Name, mount.Volume, mount.Path, size, optionText)
+	}
+	if len(mounts) == 0 {
+		fmt.Fprintln(out, "No mount points configured")
+	}
+}
+
+// mountFromFlags builds a new mount point from the add flags, checking
+// that the storage and bind options are not mixed.
+func mountFromFlags(cmd *cobra.Command) (containerMount, error) {
+	flags := cmd.Flags()
+	storage, err := flags.GetString("storage")
+	if err != nil {
+		return containerMount{}, fmt.Errorf("read storage flag: %w", err)
+	}
+	size, err := flags.GetString("size")
+	if err != nil {
+		return containerMount{}, fmt.Errorf("read size flag: %w", err)
+	}
+	bind, err := flags.GetString("bind")
+	if err != nil {
+		return containerMount{}, fmt.Errorf("read bind flag: %w", err)
+	}
+	mountPath, err := flags.GetString("path")
+	if err != nil {
+		return containerMount{}, fmt.Errorf("read path flag: %w", err)
+	}
+	mount := containerMount{Path: strings.TrimSpace(mountPath)}
+	if mount.ReadOnly, err = flags.GetBool("ro"); err != nil {
+		return containerMount{}, fmt.Errorf("read ro flag: %w", err)
+	}
+	if mount.Backup, err = flags.GetBool("backup"); err != nil {
+		return containerMount{}, fmt.Errorf("read backup flag: %w", err)
+	}
+	if mount.Quota, err = flags.GetBool("quota"); err != nil {
+		return containerMount{}, fmt.Errorf("read quota flag: %w", err)
+	}
+	if mount.Shared, err = flags.GetBool("shared"); err != nil {
+		return containerMount{}, fmt.Errorf("read shared flag: %w", err)
+	}
+
+	if !path.IsAbs(mount.Path) || mount.Path == "/" {
+		return containerMount{}, fmt.Errorf("--path must be an absolute path inside the container other than /")
+	}
+	storage, bind = strings.TrimSpace(storage), strings.TrimSpace(bind)
+	switch {
+	case storage != "" && bind != "":
+		return containerMount{}, fmt.Errorf("use either --storage with --size or --bind, not both")
+	case storage != "":
+		if strings.TrimSpace(size) == "" {
+			return containerMount{}, fmt.Errorf("--size is required with --storage")
+		}
+		gib, err := parseMountSize(size)
+		if err != nil {
+			return containerMount{}, err
+		}
+		if mount.Shared {
+			return containerMount{}, fmt.Errorf("--shared only applies to bind mounts")
+		}
+		mount.Volume = storage + ":" + gib
+	case bind != "":
+		if !path.IsAbs(bind) {
+			return containerMount{}, fmt.Errorf("--bind must be an absolute host path")
+		}
+		if size != "" || mount.Backup || mount.Quota {
+			return containerMount{}, fmt.Errorf("--size, --backup, and --quota only apply to storage volumes")
+		}
+		mount.Volume = bind
+	default:
+		return containerMount{}, fmt.Errorf("pass --storage with --size for a new volume, or --bind for a host path")
+	}
+	return mount, nil
+}
+
+func newMountAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a mount point to an LXC container",
+		Long: `Attach a new mount point in the next free mpN slot, either a new volume
+allocated on a storage or a bind mount of a host directory, e.g.:
+
+  proxmox-cli lxc mount add -n pve -i 200 --storage local-lvm --size 8G --path /srv/data --backup
+  proxmox-cli lxc mount add -n pve -i 200 --bind /mnt/media --path /media --ro
+
+Bind mounts require root@pam and, for unprivileged containers, matching
+ownership on the host.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			name, err := cmd.Flags().GetString("mp")
+			if err != nil {
+				return fmt.Errorf("read mp flag: %w", err)
+			}
+			name = strings.TrimSpace(name)
+			if name != "" {
+				if err := validateMountName(name); err != nil {
+					return err
+				}
+			}
+			mount, err := mountFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			container, vmid, err := containerFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			config := container.CurrentConfig()
+			if name == "" {
+				if name, err = nextFreeMount(config); err != nil {
+					return fmt.Errorf("add mount point to container %d: %w", vmid, err)
+				}
+			} else if _, used := config.Mps[name]; used {
+				return fmt.Errorf("%s already exists on container %d", name, vmid)
+			}
+			existing, err := sortedMounts(config)
+			if err != nil {
+				return fmt.Errorf("read mount points of container %d: %w", vmid, err)
+			}
+			for _, other := range existing {
+				if other.Path == mount.Path {
+					return fmt.Errorf("%s is already mounted at %s in container %d", other.Name, mount.Path, vmid)
+				}
+			}
+
+			mount.Name = name
+			if err := applyMount(cmd, container, mount); err != nil {
+				return fmt.Errorf("add %s to container %d: %w", name, vmid, err)
+			}
+			fmt.Fprintf(out, "Mount point %s added to container %d (%s)\n", name, vmid, mount)
+			return nil
+		},
+	}
+
+	addContainerTargetFlags(cmd)
+	cmd.Flags().String("storage", "", "Storage to allocate a new volume on, e.g. local-lvm")
+	cmd.Flags().String("size", "", "Size of the new volume in GiB, e.g. 8G")
+	cmd.Flags().String("bind", "", "Host directory to bind mount instead of a volume")
+	cmd.Flags().String("path", "", "Mount path inside the container, e.g. /srv/data")
+	cmd.Flags().Bool("ro", false, "Mount read-only")
+	cmd.Flags().Bool("backup", false, "Include the volume in backups")
+	cmd.Flags().Bool("quota", false, "Enable user quotas on the volume")
+	cmd.Flags().Bool("shared", false, "Mark a bind mount as available on all nodes")
+	cmd.Flags().String("mp", "", "Mount point slot to use, e.g. mp1 (default: next free)")
+	if err := cmd.MarkFlagRequired("path"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func newMountRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove a mount point from an LXC container",
+		Long: `Detach a mount point. A storage volume is kept as an unusedN entry
+so its data is not lost; bind mounts are simply removed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			name, err := mountNameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			container, vmid, err := containerFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if _, ok := container.CurrentConfig().Mps[name]; !ok {
+				return fmt.Errorf("container %d has no %s", vmid, name)
+			}
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Remove %s from container %d?", name, vmid)); err != nil {
+				return err
+			}
+
+			task, err := container.Config(ctx, proxmox.ContainerOption{Name: "delete", Value: name})
+			if err != nil {
+				return fmt.Errorf("remove %s from container %d: %w", name, vmid, err)
+			}
+			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+				return fmt.Errorf("remove %s from container %d: %w", name, vmid, err)
+			}
+
+			fmt.Fprintf(out, "Mount point %s removed from container %d\n", name, vmid)
+			return nil
+		},
+	}
+
+	addContainerTargetFlags(cmd)
+	addMountNameFlag(cmd)
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func newMountMoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "move",
+		Short: "Move a container volume to another storage",
+		Long: `Copy a mount point volume (or rootfs) to another storage and switch the
+container over to the copy, e.g.:
+
+  proxmox-cli lxc mount move -n pve -i 200 --mp mp0 --target-storage ceph
+
+The source volume is kept as unusedN unless --delete-source is passed.
+Bind mounts cannot be moved.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			name, err := cmd.Flags().GetString("mp")
+			if err != nil {
+				return fmt.Errorf("read mp flag: %w", err)
+			}
+			name = strings.TrimSpace(name)
+			if name != "rootfs" {
+				if err := validateMountName(name); err != nil {
+					return err
+				}
+			}
+			storage, err := cmd.Flags().GetString("target-storage")
+			if err != nil {
+				return fmt.Errorf("read target-storage flag: %w", err)
+			}
+			storage = strings.TrimSpace(storage)
+			if storage == "" {
+				return fmt.Errorf("target storage cannot be empty")
+			}
+			deleteSource, err := cmd.Flags().GetBool("delete-source")
+			if err != nil {
+				return fmt.Errorf("read delete-source flag: %w", err)
+			}
+
+			container, vmid, err := containerFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if name != "rootfs" {
+				current, ok := container.CurrentConfig().Mps[name]
+				if !ok {
+					return fmt.Errorf("container %d has no %s", vmid, name)
+				}
+				mount, err := parseMount(name, current)
+				if err != nil {
+					return err
+				}
+				if mount.isBind() {
+					return fmt.Errorf("%s is a bind mount of %s and cannot be moved", name, mount.Volume)
+				}
+			}
+			if deleteSource {
+				if err := utility.ConfirmAction(cmd, fmt.Sprintf("Move %s of container %d to %s and delete the source volume?", name, vmid, storage)); err != nil {
+					return err
+				}
+			}
+
+			options := &interfaces.ContainerMoveVolumeOptions{Volume: name, Storage: storage}
+			if deleteSource {
+				options.Delete = proxmox.IntOrBool(true)
+			}
+			task, err := container.MoveVolume(ctx, options)
+			if err != nil {
+				return fmt.Errorf("move %s of container %d: %w", name, vmid, err)
+			}
+			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+				return fmt.Errorf("move %s of container %d: %w", name, vmid, err)
+			}
+
+			fmt.Fprintf(out, "Volume %s of container %d moved to %s\n", name, vmid, storage)
+			return nil
+		},
+	}
+
+	addContainerTargetFlags(cmd)
+	cmd.Flags().String("mp", "", "Volume to move: mpN or rootfs")
+	cmd.Flags().String("target-storage", "", "Storage to move the volume to")
+	cmd.Flags().Bool("delete-source", false, "Delete the source volume after a successful copy")
+	for _, flag := range []string{"mp", "target-storage"} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func addMountNameFlag(cmd *cobra.Command) {
+	cmd.Flags().String("mp", "", "Mount point to act on, e.g. mp0")
+	if err := cmd.MarkFlagRequired("mp"); err != nil {
+		panic(err)
+	}
+}
+
+func mountNameFromFlags(cmd *cobra.Command) (string, error) {
+	name, err := cmd.Flags().GetString("mp")
+	if err != nil {
+		return "", fmt.Errorf("read mp flag: %w", err)
+	}
+	name = strings.TrimSpace(name)
+	if err := validateMountName(name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func applyMount(cmd *cobra.Command, container interfaces.ContainerInterface, mount containerMount) error {
+	ctx := cmd.Context()
+	task, err := container.Config(ctx, proxmox.ContainerOption{Name: mount.Name, Value: mount.String()})
+	if err != nil {
+		return err
+	}
+	return utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), cmd.OutOrStdout())
+}
@@ -0,0 +1,252 @@
+package lxc
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// publishCleanCommand removes the state that must be unique per container:
+// SSH host keys (Proxmox generates new ones when a container is created
+// from the template) and the machine ID (regenerated on first boot).
+const publishCleanCommand = `rm -f /etc/ssh/ssh_host_*_key /etc/ssh/ssh_host_*_key.pub && ` +
+	`truncate -s 0 /etc/machine-id && rm -f /var/lib/dbus/machine-id && ` +
+	`rm -f /root/.bash_history`
+
+// publishBootDelay gives a freshly started container time to reach a login
+// prompt before --clean drives its console.
+const publishBootDelay = 5 * time.Second
+
+var templateNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// archiveLogPattern matches the line vzdump logs before writing an archive;
+// releases before Proxmox VE 7 leave out "vzdump".
+var archiveLogPattern = regexp.MustCompile(`creating (?:vzdump )?archive '([^']+)'`)
+
+func newPublishCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "publish",
+		Short: "Publish a stopped container as a reusable OS template",
+		Long: `Back up a stopped container with vzdump and register the archive as a
+vztmpl volume that other nodes and clusters can use as ostemplate, e.g.:
+
+  proxmox-cli lxc publish -n pve -i 200 --storage local --name myapp-base
+
+The template becomes local:vztmpl/myapp-base.tar.zst. The intermediate
+backup is written to --backup-storage and removed afterwards unless
+--keep-backup is set. The archive is the one named in the backup task log.
+
+--clean first starts the container, removes its SSH host keys, machine ID,
+and root shell history through the console (see 'lxc exec'), and shuts it
+down again. This modifies the source container.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			nodeName, vmid, err := containerTargetFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			flags := cmd.Flags()
+			storage, err := flags.GetString("storage")
+			if err != nil {
+				return fmt.Errorf("read storage flag: %w", err)
+			}
+			backupStorage, err := flags.GetString("backup-storage")
+			if err != nil {
+				return fmt.Errorf("read backup-storage flag: %w", err)
+			}
+			name, err := flags.GetString("name")
+			if err != nil {
+				return fmt.Errorf("read name flag: %w", err)
+			}
+			clean, err := flags.GetBool("clean")
+			if err != nil {
+				return fmt.Errorf("read clean flag: %w", err)
+			}
+			keepBackup, err := flags.GetBool("keep-backup")
+			if err != nil {
+				return fmt.Errorf("read keep-backup flag: %w", err)
+			}
+			storage, backupStorage = strings.TrimSpace(storage), strings.TrimSpace(backupStorage)
+			name = strings.TrimSuffix(strings.TrimSpace(name), ".tar.zst")
+			if storage == "" {
+				return fmt.Errorf("storage cannot be empty")
+			}
+			if backupStorage == "" {
+				backupStorage = storage
+			}
+			if !templateNamePattern.MatchString(name) {
+				return fmt.Errorf("invalid template name %q; use letters, digits, '.', '_', and '-'", name)
+			}
+			volid := storage + ":vztmpl/" + name + ".tar.zst"
+
+			// The console needs a session ticket, so --clean cannot use API tokens.
+			var client interfaces.ProxmoxClientInterface
+			if clean {
+				client, err = utility.SessionClient()
+			} else {
+				client, err = utility.AuthenticatedClient()
+			}
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			node, err := client.Node(ctx, nodeName)
+			if err != nil {
+				return fmt.Errorf("get node %q: %w", nodeName, err)
+			}
+			container, err := node.Container(ctx, vmid)
+			if err != nil {
+				return fmt.Errorf("get container %d: %w", vmid, err)
+			}
+			if status := container.Details().Status; status != "stopped" {
+				return fmt.Errorf("container %d is %s; shut it down before publishing", vmid, status)
+			}
+
+			templates, err := node.VzTmpls(ctx, storage)
+			if err != nil {
+				return fmt.Errorf("list templates on storage %q: %w", storage, err)
+			}
+			for _, template := range templates {
+				if template.VolID == volid {
+					return fmt.Errorf("template %s already exists", volid)
+				}
+			}
+
+			if clean {
+				if err := utility.ConfirmAction(cmd, fmt.Sprintf("Start container %d and remove its SSH host keys and machine ID before publishing?", vmid)); err != nil {
+					return err
+				}
+				if err := cleanContainerForPublish(cmd, container, vmid); err != nil {
+					return err
+				}
+			}
+
+			fmt.Fprintf(out, "Backing up container %d to %s\n", vmid, backupStorage)
+			task, err := node.Vzdump(ctx, &proxmox.VirtualMachineBackupOptions{
+				VMID:     uint64(vmid),
+				Storage:  backupStorage,
+				Mode:     proxmox.VirtualMachineBackupModeStop,
+				Compress: proxmox.VirtualMachineBackupCompressZstd,
+			})
+			if err != nil {
+				return fmt.Errorf("back up container %d: %w", vmid, err)
+			}
+			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+				return fmt.Errorf("back up container %d: %w", vmid, err)
+			}
+			archive, err := backupArchive(ctx, node, string(task.UPID), backupStorage)
+			if err != nil {
+				return err
+			}
+
+			source, err := node.Storage(ctx, backupStorage)
+			if err != nil {
+				return fmt.Errorf("get storage %q: %w", backupStorage, err)
+			}
+			fmt.Fprintf(out, "Registering %s as %s\n", archive, volid)
+			task, err = source.CopyContent(ctx, archive, volid, "")
+			if err != nil {
+				return fmt.Errorf("copy %s to %s: %w", archive, volid, err)
+			}
+			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+				return fmt.Errorf("copy %s to %s: %w", archive, volid, err)
+			}
+
+			if !keepBackup {
+				task, err = source.DeleteContent(ctx, archive)
+				if err != nil {
+					return fmt.Errorf("remove intermediate backup %s: %w", archive, err)
+				}
+				if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+					return fmt.Errorf("remove intermediate backup %s: %w", archive, err)
+				}
+			}
+
+			fmt.Fprintf(out, "Container %d published as %s\n", vmid, volid)
+			fmt.Fprintf(out, "Use it with: ostemplate: %s\n", volid)
+			return nil
+		},
+	}
+
+	addContainerTargetFlags(cmd)
+	cmd.Flags().String("storage", "local", "Storage to register the template on (needs vztmpl content)")
+	cmd.Flags().String("backup-storage", "", "Storage for the intermediate backup (default: --storage)")
+	cmd.Flags().String("name", "", "Template name, e.g. myapp-base")
+	cmd.Flags().Bool("clean", false, "Remove SSH host keys and machine ID from the container first")
+	cmd.Flags().Bool("keep-backup", false, "Keep the intermediate vzdump backup")
+	cmd.Flags().String("user", "root", "User to log in as if --clean finds a login prompt")
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+// backupArchive returns the volume ID of the archive that the vzdump task
+// upid wrote to storage, as named in the task log.
+func backupArchive(ctx context.Context, node interfaces.NodeInterface, upid, storage string) (string, error) {
+	lines, err := node.TaskLog(ctx, upid)
+	if err != nil {
+		return "", fmt.Errorf("read log of backup task %s: %w", upid, err)
+	}
+	for _, line := range lines {
+		if match := archiveLogPattern.FindStringSubmatch(line); match != nil {
+			return storage + ":backup/" + path.Base(match[1]), nil
+		}
+	}
+	return "", fmt.Errorf("backup task %s did not log an archive name", upid)
+}
+
+// cleanContainerForPublish boots the container, strips machine-specific
+// state through the console, and shuts it down again.
+func cleanContainerForPublish(cmd *cobra.Command, container interfaces.ContainerInterface, vmid int) error {
+	out := cmd.OutOrStdout()
+	ctx := cmd.Context()
+	user, err := cmd.Flags().GetString("user")
+	if err != nil {
+		return fmt.Errorf("read user flag: %w", err)
+	}
+
+	fmt.Fprintf(out, "Starting container %d to clean it\n", vmid)
+	task, err := container.Start(ctx)
+	if err != nil {
+		return fmt.Errorf("start container %d: %w", vmid, err)
+	}
+	if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+		return fmt.Errorf("start container %d: %w", vmid, err)
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(publishBootDelay):
+	}
+
+	execCtx, cancel := context.WithTimeout(ctx, utility.TaskTimeout(cmd))
+	defer cancel()
+	output, exitCode, cleanErr := execInContainer(execCtx, cmd, container, vmid, user, publishCleanCommand)
+	if cleanErr == nil && exitCode != 0 {
+		cleanErr = fmt.Errorf("clean container %d: command exited with code %d: %s", vmid, exitCode, strings.TrimSpace(output))
+	}
+
+	// Shut down even when cleaning failed so the container is left as found.
+	task, err = container.Shutdown(ctx, false, 0)
+	if err == nil {
+		err = utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out)
+	}
+	if cleanErr != nil {
+		return cleanErr
+	}
+	if err != nil {
+		return fmt.Errorf("shut down container %d: %w", vmid, err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package lxc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+)
+
+// templateResolver turns short ostemplate names such as debian-12 into a
+// volume ID on a node, downloading the appliance when it is missing.
+// Results are cached so multi-document specs resolve each name once.
+type templateResolver struct {
+	node     interfaces.NodeInterface
+	storage  string
+	timeout  time.Duration
+	progress io.Writer
+
+	appliances proxmox.Appliances
+	resolved   map[string]string
+}
+
+// isTemplateVolume reports whether ostemplate is already a volume ID
+// (storage:vztmpl/file) or a path rather than a short name.
+func isTemplateVolume(ostemplate string) bool {
+	return strings.Contains(ostemplate, ":") || strings.HasPrefix(ostemplate, "/")
+}
+
+func (r *templateResolver) resolve(ctx context.Context, name string) (string, error) {
+	if volid, ok := r.resolved[name]; ok {
+		return volid, nil
+	}
+	if r.appliances == nil {
+		appliances, err := r.node.Appliances(ctx)
+		if err != nil {
+			return "", fmt.Errorf("list available templates: %w", err)
+		}
+		r.appliances = appliances
+	}
+	appliance, err := newestAppliance(r.appliances, name)
+	if err != nil {
+		return "", err
+	}
+
+	volid := r.storage + ":vztmpl/" + appliance.Template
+	templates, err := r.node.VzTmpls(ctx, r.storage)
+	if err != nil {
+		return "", fmt.Errorf("list templates on storage %q: %w", r.storage, err)
+	}
+	downloaded := false
+	for _, template := range templates {
+		if template.VolID == volid {
+			downloaded = true
+			break
+		}
+	}
+	if !downloaded {
+		fmt.Fprintf(r.progress, "Downloading template %s to storage %s\n", appliance.Template, r.storage)
+		upid, err := r.node.DownloadAppliance(ctx, appliance.Template, r.storage)
+		if err != nil {
+			return "", fmt.Errorf("download template %q: %w", appliance.Template, err)
+		}
+		if err := utility.WaitForTask(ctx, r.node.Task(upid), r.timeout, r.progress); err != nil {
+			return "", fmt.Errorf("download template %q: %w", appliance.Template, err)
+		}
+	}
+
+	if r.resolved == nil {
+		r.resolved = map[string]string{}
+	}
+	r.resolved[name] = volid
+	return volid, nil
+}
+
+// newestAppliance picks the highest version among the LXC appliances
+// whose template file is name, or starts with name followed by - or _.
+// Prefix matches are limited to the system section so that debian-12 never
+// resolves to a debian-12-turnkey-* application image.
+func newestAppliance(appliances proxmox.Appliances, name string) (*proxmox.Appliance, error) {
+	var matches []*proxmox.Appliance
+	for _, appliance := range appliances {
+		if appliance == nil || (appliance.Type != "" && appliance.Type != "lxc") {
+			continue
+		}
+		if appliance.Template == name {
+			return appliance, nil
+		}
+		if appliance.Section == "system" &&
+			(strings.HasPrefix(appliance.Template, name+"-") || strings.HasPrefix(appliance.Template, name+"_")) {
+			matches = append(matches, appliance)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no appliance template matches %q; see 'proxmox-cli template available'", name)
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if order := compareVersions(matches[i].Version, matches[j].Version); order != 0 {
+			return order > 0
+		}
+		return compareVersions(matches[i].Template, matches[j].Template) > 0
+	})
+	return matches[0], nil
+}
+
+// compareVersions orders strings such as 12.7-1 and 12.10-1 by comparing
+// runs of digits numerically and everything else lexically.
+func compareVersions(a, b string) int {
+	partsA, partsB := versionParts(a), versionParts(b)
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		numberA, errA := strconv.Atoi(partsA[i])
+		numberB, errB := strconv.Atoi(partsB[i])
+		switch {
+		case errA == nil && errB == nil:
+			if numberA != numberB {
+				return numberA - numberB
+			}
+		case partsA[i] != partsB[i]:
+			return strings.Compare(partsA[i], partsB[i])
+		}
+	}
+	return len(partsA) - len(partsB)
+}
+
+func versionParts(version string) []string {
+	parts := []string{}
+	current := ""
+	for _, r := range version {
+		if current != "" && unicode.IsDigit(r) != unicode.IsDigit(rune(current[len(current)-1])) {
+			parts = append(parts, current)
+			current = ""
+		}
+		current += string(r)
+	}
+	if current != "" {
+		parts = append(parts, current)
+	}
+	return parts
+}
@@ -0,0 +1,754 @@
+package nodes
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// maxACMEDomains is the number of acmedomainN slots in the node config.
+const maxACMEDomains = 6
+
+type certificateSummary struct {
+	File          string    `json:"file"`
+	Subject       string    `json:"subject,omitempty"`
+	SANs          []string  `json:"sans,omitempty"`
+	Issuer        string    `json:"issuer,omitempty"`
+	Fingerprint   string    `json:"fingerprint,omitempty"`
+	NotAfter      time.Time `json:"not_after"`
+	DaysRemaining int       `json:"days_remaining"`
+}
+
+type acmeDomain struct {
+	Slot   string `json:"slot"`
+	Domain string `json:"domain"`
+	Plugin string `json:"plugin"`
+	Alias  string `json:"alias,omitempty"`
+}
+
+func newCertificatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "certificates",
+		Short: "Inspect and manage node TLS certificates",
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(newCertificatesListCmd(), newCertificatesUploadCmd(), newACMECmd())
+	return cmd
+}
+
+func newCertificatesListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the certificates of a node and when they expire",
+		Long: `List the node's certificates with subject, SANs, issuer, fingerprint, and
+expiry. With --warn-days the command fails when any certificate expires
+within that many days, for use in monitoring, e.g.:
+
+  proxmox-cli nodes certificates list -n pve --warn-days 14`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			warnDays, err := cmd.Flags().GetInt("warn-days")
+			if err != nil {
+				return fmt.Errorf("get warn-days flag: %w", err)
+			}
+			if warnDays < 0 {
+				return fmt.Errorf("--warn-days cannot be negative")
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			certificates, err := node.Certificates(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list certificates on node %q: %w", nodeName, err)
+			}
+
+			now := time.Now()
+			summaries := make([]certificateSummary, 0, len(certificates))
+			for _, certificate := range certificates {
+				if certificate == nil {
+					continue
+				}
+				summary, err := newCertificateSummary(certificate, now)
+				if err != nil {
+					return err
+				}
+				summaries = append(summaries, summary)
+			}
+			sort.Slice(summaries, func(i, j int) bool { return summaries[i].File < summaries[j].File })
+
+			if format == "json" {
+				if err := utility.PrintJSON(out, summaries); err != nil {
+					return err
+				}
+			} else {
+				printCertificates(out, nodeName, summaries)
+			}
+			return checkCertificateExpiry(summaries, warnDays)
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	cmd.Flags().Int("warn-days", 0, "Exit non-zero if a certificate expires within this many days")
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newCertificateSummary(certificate *proxmox.NodeCertificate, now time.Time) (certificateSummary, error) {
+	summary := certificateSummary{
+		File:        certificate.Filename,
+		Subject:     certificate.Subject,
+		SANs:        certificate.San,
+		Issuer:      certificate.Issuer,
+		Fingerprint: certificate.Fingerprint,
+	}
+	notAfter, err := certificateNotAfter(certificate)
+	if err != nil {
+		return summary, fmt.Errorf("read expiry of %s: %w", certificate.Filename, err)
+	}
+	summary.NotAfter = notAfter
+	summary.DaysRemaining = int(math.Floor(notAfter.Sub(now).Hours() / 24))
+	return summary, nil
+}
+
+// certificateNotAfter reads the expiry from the PEM, falling back to the
+// epoch Proxmox reports alongside it.
+func certificateNotAfter(certificate *proxmox.NodeCertificate) (time.Time, error) {
+	if block, _ := pem.Decode([]byte(certificate.Pem)); block != nil {
+		parsed, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return parsed.NotAfter, nil
+	}
+	epoch, err := strconv.ParseInt(certificate.NotAfter, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("no certificate data returned")
+	}
+	return time.Unix(epoch, 0), nil
+}
+
+func printCertificates(out io.Writer, nodeName string, summaries []certificateSummary) {
+	fmt.Fprintf(out, "Certificates on %s:\n", nodeName)
+	for _, summary := range summaries {
+		fmt.Fprintln(out)
+		fmt.Fprintf(out, "%-14s %s\n", "File:", summary.File)
+		fmt.Fprintf(out, "%-14s %s\n", "Subject:", utility.DashIfEmpty(summary.Subject))
+		fmt.Fprintf(out, "%-14s %s\n", "SANs:", utility.DashIfEmpty(strings.Join(summary.SANs, ", ")))
+		fmt.Fprintf(out, "%-14s %s\n", "Issuer:", utility.DashIfEmpty(summary.Issuer))
+		fmt.Fprintf(out, "%-14s %s\n", "Fingerprint:", utility.DashIfEmpty(summary.Fingerprint))
+		fmt.Fprintf(out, "%-14s %s (%s)\n", "Expires:", summary.NotAfter.Local().Format("2006-01-02 15:04"), daysRemainingText(summary.DaysRemaining))
+	}
+	if len(summaries) == 0 {
+		fmt.Fprintln(out, "No certificates found")
+	}
+}
+
+func daysRemainingText(days int) string {
+	if days < 0 {
+		return fmt.Sprintf("EXPIRED %d days ago", -days)
+	}
+	return fmt.Sprintf("%d days remaining", days)
+}
+
+func checkCertificateExpiry(summaries []certificateSummary, warnDays int) error {
+	if warnDays == 0 {
+		return nil
+	}
+	var expiring []string
+	for _, summary := range summaries {
+		if summary.DaysRemaining < warnDays {
+			expiring = append(expiring, fmt.Sprintf("%s (%s)", summary.File, daysRemainingText(summary.DaysRemaining)))
+		}
+	}
+	if len(expiring) > 0 {
+		return fmt.Errorf("certificates expiring within %d days: %s", warnDays, strings.Join(expiring, "; "))
+	}
+	return nil
+}
+
+func newCertificatesUploadCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload",
+		Short: "Install a custom certificate for the node's API and web UI",
+		Long: `Install a PEM certificate (chain) and private key as the node's custom
+pveproxy certificate. The pair is checked locally before upload. Without
+--restart, pveproxy picks the certificate up on its next restart.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			flags := cmd.Flags()
+			certPath, err := flags.GetString("cert")
+			if err != nil {
+				return fmt.Errorf("get cert flag: %w", err)
+			}
+			keyPath, err := flags.GetString("key")
+			if err != nil {
+				return fmt.Errorf("get key flag: %w", err)
+			}
+			restart, err := flags.GetBool("restart")
+			if err != nil {
+				return fmt.Errorf("get restart flag: %w", err)
+			}
+			force, err := flags.GetBool("force")
+			if err != nil {
+				return fmt.Errorf("get force flag: %w", err)
+			}
+
+			certPEM, err := os.ReadFile(certPath)
+			if err != nil {
+				return fmt.Errorf("read certificate: %w", err)
+			}
+			keyPEM, err := os.ReadFile(keyPath)
+			if err != nil {
+				return fmt.Errorf("read key: %w", err)
+			}
+			leaf, err := parseKeyPair(certPEM, keyPEM)
+			if err != nil {
+				return err
+			}
+			if time.Now().After(leaf.NotAfter) {
+				return fmt.Errorf("certificate expired on %s", leaf.NotAfter.Format("2006-01-02"))
+			}
+
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			err = node.UploadCertificate(cmd.Context(), &proxmox.CustomCertificate{
+				Certificates: string(certPEM),
+				Key:          string(keyPEM),
+				Force:        force,
+				Restart:      restart,
+			})
+			if err != nil {
+				return fmt.Errorf("upload certificate to node %q: %w", nodeName, err)
+			}
+			fmt.Fprintf(out, "Certificate for %s (expires %s) installed on node %s\n",
+				strings.Join(certificateNames(leaf), ", "), leaf.NotAfter.Format("2006-01-02"), nodeName)
+			if !restart {
+				fmt.Fprintf(out, "Restart pveproxy to use it: proxmox-cli nodes services restart pveproxy -n %s\n", nodeName)
+			}
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	cmd.Flags().String("cert", "", "PEM certificate file, optionally followed by the chain")
+	cmd.Flags().String("key", "", "PEM private key file")
+	cmd.Flags().Bool("restart", false, "Restart pveproxy to use the new certificate now")
+	cmd.Flags().Bool("force", false, "Replace an existing custom certificate")
+	for _, name := range []string{"cert", "key"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+	return cmd
+}
+
+// parseKeyPair checks that the key belongs to the first certificate and
+// returns that certificate.
+func parseKeyPair(certPEM, keyPEM []byte) (*x509.Certificate, error) {
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate or key: %w", err)
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("parse certificate: %w", err)
+	}
+	return leaf, nil
+}
+
+func certificateNames(certificate *x509.Certificate) []string {
+	if len(certificate.DNSNames) > 0 {
+		return certificate.DNSNames
+	}
+	return []string{certificate.Subject.CommonName}
+}
+
+func newACMECmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acme",
+		Short: "Manage ACME accounts, domains, and certificate orders",
+		Long: `Obtain certificates from an ACME CA such as Let's Encrypt:
+
+  proxmox-cli nodes certificates acme account register --contact admin@example.com --accept-tos
+  proxmox-cli nodes certificates acme domain add -n pve --domain pve.example.com
+  proxmox-cli nodes certificates acme order -n pve`,
+		Args: cobra.NoArgs,
+	}
+	account := &cobra.Command{
+		Use:   "account",
+		Short: "Manage cluster ACME accounts",
+		Args:  cobra.NoArgs,
+	}
+	account.AddCommand(newACMEAccountListCmd(), newACMEAccountRegisterCmd(), newACMEAccountDeactivateCmd())
+	domain := &cobra.Command{
+		Use:   "domain",
+		Short: "Manage the ACME domains of a node",
+		Args:  cobra.NoArgs,
+	}
+	domain.AddCommand(newACMEDomainListCmd(), newACMEDomainAddCmd(), newACMEDomainRemoveCmd())
+	cmd.AddCommand(account, domain, newACMEOrderCmd("order"), newACMEOrderCmd("renew"))
+	return cmd
+}
+
+func acmeCluster(cmd *cobra.Command) (interfaces.ClusterInterface, error) {
+	client, err := utility.AuthenticatedClient()
+	if err != nil {
+		return nil, fmt.Errorf("authenticate Proxmox client: %w", err)
+	}
+	cluster, err := client.Cluster(cmd.Context())
+	if err != nil {
+		return nil, fmt.Errorf("get cluster: %w", err)
+	}
+	return cluster, nil
+}
+
+func newACMEAccountListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List ACME accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			cluster, err := acmeCluster(cmd)
+			if err != nil {
+				return err
+			}
+			accounts, err := cluster.ACMEAccounts(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list ACME accounts: %w", err)
+			}
+			type accountSummary struct {
+				Name      string `json:"name"`
+				Directory string `json:"directory,omitempty"`
+				Contact   string `json:"contact,omitempty"`
+				Status    string `json:"status,omitempty"`
+			}
+			summaries := make([]accountSummary, 0, len(accounts))
+			for _, index := range accounts {
+				if index == nil {
+					continue
+				}
+				account, err := cluster.ACMEAccount(cmd.Context(), index.Name)
+				if err != nil {
+					return fmt.Errorf("get ACME account %q: %w", index.Name, err)
+				}
+				summary := accountSummary{Name: index.Name, Directory: account.Directory}
+				if status, ok := account.Account["status"].(string); ok {
+					summary.Status = status
+				}
+				if contacts, ok := account.Account["contact"].([]any); ok {
+					for _, contact := range contacts {
+						summary.Contact = strings.TrimSpace(summary.Contact + " " + strings.TrimPrefix(fmt.Sprint(contact), "mailto:"))
+					}
+				}
+				summaries = append(summaries, summary)
+			}
+
+			if format == "json" {
+				return utility.PrintJSON(out, summaries)
+			}
+			fmt.Fprintf(out, "%-16s %-10s %-30s %s\n", "Name", "Status", "Contact", "Directory")
+			fmt.Fprintf(out, "%-16s %-10s %-30s %s\n", "----", "------", "-------", "---------")
+			for _, summary := range summaries {
+				fmt.Fprintf(out, "%-16s %-10s %-30s %s\n", summary.Name, utility.DashIfEmpty(summary.Status), utility.DashIfEmpty(summary.Contact), summary.Directory)
+			}
+			if len(summaries) == 0 {
+				fmt.Fprintln(out, "No ACME accounts registered")
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newACMEAccountRegisterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register",
+		Short: "Register an ACME account with a CA",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			flags := cmd.Flags()
+			name, err := flags.GetString("name")
+			if err != nil {
+				return fmt.Errorf("get name flag: %w", err)
+			}
+			contact, err := flags.GetString("contact")
+			if err != nil {
+				return fmt.Errorf("get contact flag: %w", err)
+			}
+			directory, err := flags.GetString("directory")
+			if err != nil {
+				return fmt.Errorf("get directory flag: %w", err)
+			}
+			acceptTOS, err := flags.GetBool("accept-tos")
+			if err != nil {
+				return fmt.Errorf("get accept-tos flag: %w", err)
+			}
+			if !strings.Contains(contact, "@") {
+				return fmt.Errorf("contact must be an email address")
+			}
+
+			cluster, err := acmeCluster(cmd)
+			if err != nil {
+				return err
+			}
+			tos, err := cluster.ACMETermsOfService(ctx, directory)
+			if err != nil {
+				return fmt.Errorf("get terms of service: %w", err)
+			}
+			if tos != "" && !acceptTOS {
+				return fmt.Errorf("read the CA's terms of service at %s and pass --accept-tos to agree", tos)
+			}
+
+			task, err := cluster.NewACMEAccount(ctx, &proxmox.ACMEAccountOptions{
+				Name:      name,
+				Contact:   contact,
+				Directory: directory,
+				TOSURL:    tos,
+			})
+			if err != nil {
+				return fmt.Errorf("register ACME account %q: %w", name, err)
+			}
+			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+				return fmt.Errorf("register ACME account %q: %w", name, err)
+			}
+			fmt.Fprintf(out, "ACME account %s registered\n", name)
+			return nil
+		},
+	}
+	cmd.Flags().String("name", "default", "Account name")
+	cmd.Flags().String("contact", "", "Contact email address")
+	cmd.Flags().String("directory", "", "ACME directory URL (default: Let's Encrypt)")
+	cmd.Flags().Bool("accept-tos", false, "Agree to the CA's terms of service")
+	if err := cmd.MarkFlagRequired("contact"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func newACMEAccountDeactivateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deactivate <name>",
+		Short: "Deactivate an ACME account at the CA and remove it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			name := args[0]
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Deactivate ACME account %s? This cannot be undone at the CA.", name)); err != nil {
+				return err
+			}
+			cluster, err := acmeCluster(cmd)
+			if err != nil {
+				return err
+			}
+			task, err := cluster.DeleteACMEAccount(ctx, name)
+			if err != nil {
+				return fmt.Errorf("deactivate ACME account %q: %w", name, err)
+			}
+			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+				return fmt.Errorf("deactivate ACME account %q: %w", name, err)
+			}
+			fmt.Fprintf(out, "ACME account %s deactivated\n", name)
+			return nil
+		},
+	}
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+// acmeDomains parses the acmedomainN entries of the node config.
+func acmeDomains(config *proxmox.NodeConfig) []acmeDomain {
+	slots := []string{config.AcmeDomain0, config.AcmeDomain1, config.AcmeDomain2, config.AcmeDomain3, config.AcmeDomain4, config.AcmeDomain5}
+	var domains []acmeDomain
+	for index, value := range slots {
+		if value == "" {
+			continue
+		}
+		domain := acmeDomain{Slot: fmt.Sprintf("acmedomain%d", index), Plugin: "standalone"}
+		for _, part := range strings.Split(value, ",") {
+			key, val, found := strings.Cut(part, "=")
+			if !found {
+				domain.Domain = key
+				continue
+			}
+			switch key {
+			case "domain":
+				domain.Domain = val
+			case "plugin":
+				domain.Plugin = val
+			case "alias":
+				domain.Alias = val
+			}
+		}
+		domains = append(domains, domain)
+	}
+	return domains
+}
+
+// setACMEDomainSlot sets acmedomainN on options.
+func setACMEDomainSlot(options *proxmox.NodeConfigOptions, slot int, value string) {
+	fields := []*string{&options.AcmeDomain0, &options.AcmeDomain1, &options.AcmeDomain2, &options.AcmeDomain3, &options.AcmeDomain4, &options.AcmeDomain5}
+	*fields[slot] = value
+}
+
+// withACMEAccount returns the node's acme property with account= set to
+// account, keeping the other entries such as domains=.
+func withACMEAccount(property, account string) string {
+	parts := []string{"account=" + account}
+	for _, part := range strings.Split(property, ",") {
+		if part == "" || strings.HasPrefix(part, "account=") {
+			continue
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ",")
+}
+
+func newACMEDomainListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the ACME domains of a node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			config, err := node.Config(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("get config of node %q: %w", nodeName, err)
+			}
+			domains := acmeDomains(config)
+			if format == "json" {
+				if domains == nil {
+					domains = []acmeDomain{}
+				}
+				return utility.PrintJSON(out, domains)
+			}
+			fmt.Fprintf(out, "%-30s %-16s %s\n", "Domain", "Plugin", "Alias")
+			fmt.Fprintf(out, "%-30s %-16s %s\n", "------", "------", "-----")
+			for _, domain := range domains {
+				fmt.Fprintf(out, "%-30s %-16s %s\n", domain.Domain, domain.Plugin, utility.DashIfEmpty(domain.Alias))
+			}
+			if len(domains) == 0 {
+				fmt.Fprintln(out, "No ACME domains configured")
+			}
+			return nil
+		},
+	}
+	addNodeNameFlag(cmd)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newACMEDomainAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a domain to the node's ACME certificate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			flags := cmd.Flags()
+			domainName, err := flags.GetString("domain")
+			if err != nil {
+				return fmt.Errorf("get domain flag: %w", err)
+			}
+			plugin, err := flags.GetString("plugin")
+			if err != nil {
+				return fmt.Errorf("get plugin flag: %w", err)
+			}
+			alias, err := flags.GetString("alias")
+			if err != nil {
+				return fmt.Errorf("get alias flag: %w", err)
+			}
+			domainName = strings.TrimSpace(domainName)
+			if domainName == "" {
+				return fmt.Errorf("domain cannot be empty")
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			config, err := node.Config(ctx)
+			if err != nil {
+				return fmt.Errorf("get config of node %q: %w", nodeName, err)
+			}
+			used := map[string]bool{}
+			for _, existing := range acmeDomains(config) {
+				if existing.Domain == domainName {
+					return fmt.Errorf("domain %s is already configured on node %q", domainName, nodeName)
+				}
+				used[existing.Slot] = true
+			}
+			slot := -1
+			for index := 0; index < maxACMEDomains; index++ {
+				if !used[fmt.Sprintf("acmedomain%d", index)] {
+					slot = index
+					break
+				}
+			}
+			if slot < 0 {
+				return fmt.Errorf("node %q already has %d ACME domains", nodeName, maxACMEDomains)
+			}
+
+			value := "domain=" + domainName
+			if plugin != "" && plugin != "standalone" {
+				value += ",plugin=" + plugin
+			}
+			if alias != "" {
+				value += ",alias=" + alias
+			}
+			options := &proxmox.NodeConfigOptions{Digest: config.Digest}
+			setACMEDomainSlot(options, slot, value)
+			if err := node.UpdateConfig(ctx, options); err != nil {
+				return fmt.Errorf("add ACME domain %s to node %q: %w", domainName, nodeName, err)
+			}
+			fmt.Fprintf(out, "ACME domain %s added to node %s; order a certificate with 'proxmox-cli nodes certificates acme order -n %s'\n", domainName, nodeName, nodeName)
+			return nil
+		},
+	}
+	addNodeNameFlag(cmd)
+	cmd.Flags().String("domain", "", "Domain name, e.g. pve.example.com")
+	cmd.Flags().String("plugin", "", "DNS challenge plugin ID (default: standalone HTTP challenge)")
+	cmd.Flags().String("alias", "", "Alias domain for DNS challenge delegation")
+	if err := cmd.MarkFlagRequired("domain"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func newACMEDomainRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove a domain from the node's ACME certificate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			domainName, err := cmd.Flags().GetString("domain")
+			if err != nil {
+				return fmt.Errorf("get domain flag: %w", err)
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			config, err := node.Config(ctx)
+			if err != nil {
+				return fmt.Errorf("get config of node %q: %w", nodeName, err)
+			}
+			for _, existing := range acmeDomains(config) {
+				if existing.Domain != strings.TrimSpace(domainName) {
+					continue
+				}
+				if err := node.UpdateConfig(ctx, &proxmox.NodeConfigOptions{Delete: existing.Slot, Digest: config.Digest}); err != nil {
+					return fmt.Errorf("remove ACME domain %s from node %q: %w", domainName, nodeName, err)
+				}
+				fmt.Fprintf(out, "ACME domain %s removed from node %s\n", domainName, nodeName)
+				return nil
+			}
+			return fmt.Errorf("domain %s is not configured on node %q", domainName, nodeName)
+		},
+	}
+	addNodeNameFlag(cmd)
+	cmd.Flags().String("domain", "", "Domain name to remove")
+	if err := cmd.MarkFlagRequired("domain"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func newACMEOrderCmd(action string) *cobra.Command {
+	short := "Order a certificate for the node's ACME domains"
+	forceUsage := "Replace an existing custom certificate"
+	if action == "renew" {
+		short = "Renew the node's ACME certificate"
+		forceUsage = "Renew even if the certificate is not due (more than 30 days left)"
+	}
+	cmd := &cobra.Command{
+		Use:   action,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			force, err := cmd.Flags().GetBool("force")
+			if err != nil {
+				return fmt.Errorf("get force flag: %w", err)
+			}
+			account, err := cmd.Flags().GetString("account")
+			if err != nil {
+				return fmt.Errorf("get account flag: %w", err)
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if account = strings.TrimSpace(account); account != "" {
+				config, err := node.Config(ctx)
+				if err != nil {
+					return fmt.Errorf("get config of node %q: %w", nodeName, err)
+				}
+				options := &proxmox.NodeConfigOptions{Acme: withACMEAccount(config.Acme, account), Digest: config.Digest}
+				if err := node.UpdateConfig(ctx, options); err != nil {
+					return fmt.Errorf("set ACME account of node %q: %w", nodeName, err)
+				}
+			}
+
+			var task *proxmox.Task
+			if action == "renew" {
+				task, err = node.RenewACMECertificate(ctx, force)
+			} else {
+				task, err = node.OrderACMECertificate(ctx, force)
+			}
+			if err != nil {
+				return fmt.Errorf("%s ACME certificate for node %q: %w", action, nodeName, err)
+			}
+			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+				return fmt.Errorf("%s ACME certificate for node %q: %w", action, nodeName, err)
+			}
+			fmt.Fprintf(out, "ACME certificate for node %s: %s completed\n", nodeName, action)
+			return nil
+		},
+	}
+	addNodeNameFlag(cmd)
+	cmd.Flags().Bool("force", false, forceUsage)
+	cmd.Flags().String("account", "", "ACME account to use (saved in the node config)")
+	return cmd
+}
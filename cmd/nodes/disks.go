@@ -0,0 +1,603 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+var zfsRaidLevels = []string{"single", "mirror", "raid10", "raidz", "raidz2", "raidz3", "draid", "draid2", "draid3"}
+
+type diskSummary struct {
+	Device  string `json:"device"`
+	Type    string `json:"type,omitempty"`
+	Size    uint64 `json:"size_bytes"`
+	Model   string `json:"model,omitempty"`
+	Serial  string `json:"serial,omitempty"`
+	Wearout string `json:"wearout,omitempty"`
+	Health  string `json:"health,omitempty"`
+	Usage   string `json:"usage,omitempty"`
+	GPT     bool   `json:"gpt"`
+	Parent  string `json:"parent,omitempty"`
+}
+
+type smartAttribute struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Value     string `json:"value"`
+	Worst     string `json:"worst"`
+	Threshold string `json:"threshold"`
+	Raw       string `json:"raw"`
+	Fail      string `json:"fail,omitempty"`
+}
+
+type smartReport struct {
+	Device     string           `json:"device"`
+	Health     string           `json:"health"`
+	Type       string           `json:"type,omitempty"`
+	Attributes []smartAttribute `json:"attributes,omitempty"`
+	Text       string           `json:"text,omitempty"`
+}
+
+func newDisksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disks",
+		Short: "Inspect physical disks and create storage on them",
+		Long: `List the physical disks of a node, read their SMART data, and turn unused
+disks into ZFS pools, LVM-thin pools, or directory storages.
+
+Commands that write to a disk refuse disks that are in use and ask you to
+type the device path to confirm (or pass it with --confirm).`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(
+		newDisksListCmd(),
+		newDisksSmartCmd(),
+		newDisksInitGPTCmd(),
+		newDisksCreateZFSCmd(),
+		newDisksCreateLVMThinCmd(),
+		newDisksCreateDirectoryCmd(),
+	)
+	return cmd
+}
+
+func newDisksListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List physical disks of a node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			flags := cmd.Flags()
+			unused, err := flags.GetBool("unused")
+			if err != nil {
+				return fmt.Errorf("get unused flag: %w", err)
+			}
+			partitions, err := flags.GetBool("partitions")
+			if err != nil {
+				return fmt.Errorf("get partitions flag: %w", err)
+			}
+			skipSMART, err := flags.GetBool("skip-smart")
+			if err != nil {
+				return fmt.Errorf("get skip-smart flag: %w", err)
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			diskType := ""
+			if unused {
+				diskType = "unused"
+			}
+			disks, err := node.Disks(cmd.Context(), partitions, skipSMART, diskType)
+			if err != nil {
+				return fmt.Errorf("list disks on node %q: %w", nodeName, err)
+			}
+			summaries := make([]diskSummary, 0, len(disks))
+			for _, disk := range disks {
+				if disk == nil {
+					continue
+				}
+				summaries = append(summaries, diskSummary{
+					Device:  disk.DevPath,
+					Type:    disk.Type,
+					Size:    disk.Size,
+					Model:   disk.Model,
+					Serial:  disk.Serial,
+					Wearout: disk.Wearout,
+					Health:  disk.Health,
+					Usage:   disk.Used,
+					GPT:     bool(disk.GPT),
+					Parent:  disk.Parent,
+				})
+			}
+			sort.Slice(summaries, func(i, j int) bool { return summaries[i].Device < summaries[j].Device })
+
+			if format == "json" {
+				return utility.PrintJSON(out, summaries)
+			}
+			printDisksTable(out, nodeName, summaries)
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	cmd.Flags().Bool("unused", false, "Only list disks that are not in use")
+	cmd.Flags().Bool("partitions", false, "Also list partitions")
+	cmd.Flags().Bool("skip-smart", false, "Skip the SMART health check (faster)")
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func printDisksTable(out io.Writer, nodeName string, summaries []diskSummary) {
+	fmt.Fprintf(out, "Disks on %s:\n", nodeName)
+	fmt.Fprintf(out, "%-16s %-6s %-12s %-26s %-22s %-8s %-8s %-4s %s\n", "Device", "Type", "Size", "Model", "Serial", "Wearout", "Health", "GPT", "Usage")
+	fmt.Fprintf(out, "%-16s %-6s %-12s %-26s %-22s %-8s %-8s %-4s %s\n", "------", "----", "----", "-----", "------", "-------", "------", "---", "-----")
+	for _, disk := range summaries {
+		wearout := disk.Wearout
+		if wearout != "" && wearout != "N/A" && !strings.HasSuffix(wearout, "%") {
+			wearout += "%"
+		}
+		fmt.Fprintf(out, "%-16s %-6s %-12s %-26s %-22s %-8s %-8s %-4s %s\n",
+			disk.Device, utility.DashIfEmpty(disk.Type), formatStorageBytes(disk.Size), utility.DashIfEmpty(disk.Model),
+			utility.DashIfEmpty(disk.Serial), utility.DashIfEmpty(wearout), utility.DashIfEmpty(disk.Health), utility.YesNo(disk.GPT), diskUsage(disk.Usage))
+	}
+	if len(summaries) == 0 {
+		fmt.Fprintln(out, "No disks found")
+	}
+}
+
+func diskUsage(used string) string {
+	if used == "" {
+		return "unused"
+	}
+	return used
+}
+
+func newDisksSmartCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "smart",
+		Short: "Show SMART data of a disk",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			healthOnly, err := cmd.Flags().GetBool("health-only")
+			if err != nil {
+				return fmt.Errorf("get health-only flag: %w", err)
+			}
+			device, err := deviceFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			smart, err := node.DiskSMART(cmd.Context(), device, healthOnly)
+			if err != nil {
+				return fmt.Errorf("read SMART data of %s on node %q: %w", device, nodeName, err)
+			}
+			report := newSmartReport(device, smart)
+
+			if format == "json" {
+				return utility.PrintJSON(out, report)
+			}
+			fmt.Fprintf(out, "Device: %s\n", report.Device)
+			fmt.Fprintf(out, "Health: %s\n", utility.DashIfEmpty(report.Health))
+			if len(report.Attributes) > 0 {
+				fmt.Fprintln(out)
+				fmt.Fprintf(out, "%-4s %-28s %-6s %-6s %-9s %-8s %s\n", "ID", "Attribute", "Value", "Worst", "Threshold", "Failing", "Raw")
+				fmt.Fprintf(out, "%-4s %-28s %-6s %-6s %-9s %-8s %s\n", "--", "---------", "-----", "-----", "---------", "-------", "---")
+				for _, attribute := range report.Attributes {
+					fmt.Fprintf(out, "%-4s %-28s %-6s %-6s %-9s %-8s %s\n", attribute.ID, attribute.Name, attribute.Value,
+						attribute.Worst, attribute.Threshold, utility.DashIfEmpty(attribute.Fail), attribute.Raw)
+				}
+			}
+			if report.Text != "" {
+				fmt.Fprintln(out)
+				fmt.Fprintln(out, strings.TrimRight(report.Text, "\n"))
+			}
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	cmd.Flags().Bool("health-only", false, "Only report the overall health")
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newSmartReport(device string, smart *proxmox.DiskSMART) smartReport {
+	report := smartReport{Device: device}
+	if smart == nil {
+		return report
+	}
+	report.Health, report.Type, report.Text = smart.Health, smart.Type, smart.Text
+	for _, attribute := range smart.Attributes {
+		fail := smartField(attribute, "fail")
+		if fail == "-" {
+			fail = ""
+		}
+		report.Attributes = append(report.Attributes, smartAttribute{
+			ID:        smartField(attribute, "id"),
+			Name:      smartField(attribute, "name"),
+			Value:     smartField(attribute, "value"),
+			Worst:     smartField(attribute, "worst"),
+			Threshold: smartField(attribute, "threshold"),
+			Raw:       smartField(attribute, "raw"),
+			Fail:      fail,
+		})
+	}
+	return report
+}
+
+// smartField renders one attribute value; Proxmox mixes numbers and
+// strings depending on the drive.
+func smartField(attribute map[string]any, key string) string {
+	switch value := attribute[key].(type) {
+	case nil:
+		return ""
+	case float64:
+		return fmt.Sprintf("%g", value)
+	default:
+		return strings.TrimSpace(fmt.Sprint(value))
+	}
+}
+
+// devicePath accepts sdb as shorthand for /dev/sdb.
+func devicePath(device string) string {
+	device = strings.TrimSpace(device)
+	if device != "" && !strings.HasPrefix(device, "/") {
+		device = "/dev/" + device
+	}
+	return device
+}
+
+// requireUnusedDisks checks that every device exists on the node and is
+// not in use, so a typo cannot point a destructive command at a live disk.
+func requireUnusedDisks(ctx context.Context, node interfaces.NodeInterface, nodeName string, devices []string) error {
+	disks, err := node.Disks(ctx, false, true, "")
+	if err != nil {
+		return fmt.Errorf("list disks on node %q: %w", nodeName, err)
+	}
+	byPath := make(map[string]*proxmox.Disk, len(disks))
+	for _, disk := range disks {
+		if disk != nil {
+			byPath[disk.DevPath] = disk
+		}
+	}
+	for _, device := range devices {
+		disk, ok := byPath[device]
+		if !ok {
+			return fmt.Errorf("disk %s not found on node %q; see 'proxmox-cli nodes disks list -n %s'", device, nodeName, nodeName)
+		}
+		if disk.Used != "" {
+			return fmt.Errorf("disk %s on node %q is in use (%s)", device, nodeName, disk.Used)
+		}
+	}
+	return nil
+}
+
+// runDiskTask confirms, starts, and waits for a disk-writing task.
+func runDiskTask(cmd *cobra.Command, node interfaces.NodeInterface, nodeName string, devices []string, action string, start func(context.Context) (*proxmox.Task, error)) error {
+	ctx := cmd.Context()
+	out := cmd.OutOrStdout()
+	if err := requireUnusedDisks(ctx, node, nodeName, devices); err != nil {
+		return err
+	}
+	expected := strings.Join(devices, ",")
+	warning := fmt.Sprintf("This will %s on node %s and destroy all data on %s.", action, nodeName, expected)
+	if err := utility.ConfirmTyped(cmd, warning, expected); err != nil {
+		return err
+	}
+	task, err := start(ctx)
+	if err != nil {
+		return fmt.Errorf("%s on node %q: %w", action, nodeName, err)
+	}
+	if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+		return fmt.Errorf("%s on node %q: %w", action, nodeName, err)
+	}
+	return nil
+}
+
+func addDiskWriteFlags(cmd *cobra.Command) {
+	addNodeNameFlag(cmd)
+	utility.AddConfirmFlag(cmd, "Device path(s) to confirm without a prompt, comma-separated as prompted")
+}
+
+func addStorageNameFlags(cmd *cobra.Command, example string) {
+	cmd.Flags().String("name", "", "Name of the new storage, e.g. "+example)
+	cmd.Flags().Bool("add-storage", true, "Also add it as a Proxmox storage")
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+}
+
+func storageNameFromFlags(cmd *cobra.Command) (string, bool, error) {
+	name, err := cmd.Flags().GetString("name")
+	if err != nil {
+		return "", false, fmt.Errorf("get name flag: %w", err)
+	}
+	addStorage, err := cmd.Flags().GetBool("add-storage")
+	if err != nil {
+		return "", false, fmt.Errorf("get add-storage flag: %w", err)
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", false, fmt.Errorf("name cannot be empty")
+	}
+	return name, addStorage, nil
+}
+
+func deviceFromFlags(cmd *cobra.Command) (string, error) {
+	device, err := cmd.Flags().GetString("device")
+	if err != nil {
+		return "", fmt.Errorf("get device flag: %w", err)
+	}
+	device = devicePath(device)
+	if device == "" {
+		return "", fmt.Errorf("device cannot be empty")
+	}
+	return device, nil
+}
+
+func addDeviceFlag(cmd *cobra.Command) {
+	cmd.Flags().String("device", "", "Disk to use, e.g. /dev/sdb")
+	if err := cmd.MarkFlagRequired("device"); err != nil {
+		panic(err)
+	}
+	_ = cmd.RegisterFlagCompletionFunc("device", completeDisks)
+}
+
+// completeDisks completes the device paths of the disks on the node given
+// with --node.
+func completeDisks(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	node, _, err := nodeFromFlags(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	disks, err := node.Disks(cmd.Context(), false, true, "")
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	paths := make([]string, 0, len(disks))
+	for _, disk := range disks {
+		if disk != nil && strings.HasPrefix(disk.DevPath, toComplete) {
+			paths = append(paths, disk.DevPath)
+		}
+	}
+	return paths, cobra.ShellCompDirectiveNoFileComp
+}
+
+func newDisksInitGPTCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init-gpt",
+		Short: "Write a new GPT partition table to an unused disk",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			device, err := deviceFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			err = runDiskTask(cmd, node, nodeName, []string{device}, "initialize "+device+" with GPT", func(ctx context.Context) (*proxmox.Task, error) {
+				return node.DiskInitGPT(ctx, device)
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Initialized %s with GPT on node %s\n", device, nodeName)
+			return nil
+		},
+	}
+	addDiskWriteFlags(cmd)
+	addDeviceFlag(cmd)
+	return cmd
+}
+
+func newDisksCreateZFSCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-zfs",
+		Short: "Create a ZFS pool on unused disks",
+		Long: `Create a ZFS pool, e.g.:
+
+  proxmox-cli nodes disks create-zfs -n pve --name tank --raid mirror --devices /dev/sdb,/dev/sdc`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			name, addStorage, err := storageNameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			rawDevices, err := flags.GetStringSlice("devices")
+			if err != nil {
+				return fmt.Errorf("get devices flag: %w", err)
+			}
+			raid, err := flags.GetString("raid")
+			if err != nil {
+				return fmt.Errorf("get raid flag: %w", err)
+			}
+			ashift, err := flags.GetInt("ashift")
+			if err != nil {
+				return fmt.Errorf("get ashift flag: %w", err)
+			}
+			compression, err := flags.GetString("compression")
+			if err != nil {
+				return fmt.Errorf("get compression flag: %w", err)
+			}
+
+			devices := make([]string, 0, len(rawDevices))
+			for _, device := range rawDevices {
+				if device = devicePath(device); device != "" {
+					devices = append(devices, device)
+				}
+			}
+			if err := validateZFSLayout(raid, len(devices)); err != nil {
+				return err
+			}
+			if ashift != 0 && (ashift < 9 || ashift > 16) {
+				return fmt.Errorf("ashift must be between 9 and 16")
+			}
+
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			options := &proxmox.NodeZFSPoolOptions{
+				Name:        name,
+				Devices:     strings.Join(devices, " "),
+				RaidLevel:   raid,
+				Ashift:      ashift,
+				Compression: compression,
+				AddStorage:  proxmox.IntOrBool(addStorage),
+			}
+			err = runDiskTask(cmd, node, nodeName, devices, fmt.Sprintf("create ZFS pool %s (%s)", name, raid), func(ctx context.Context) (*proxmox.Task, error) {
+				return node.NewZFSPool(ctx, options)
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "ZFS pool %s created on node %s\n", name, nodeName)
+			return nil
+		},
+	}
+
+	addDiskWriteFlags(cmd)
+	addStorageNameFlags(cmd, "tank")
+	cmd.Flags().StringSlice("devices", nil, "Disks for the pool, e.g. /dev/sdb,/dev/sdc")
+	cmd.Flags().String("raid", "single", "RAID level: "+strings.Join(zfsRaidLevels, ", "))
+	cmd.Flags().Int("ashift", 12, "Pool sector size exponent (12 for 4K sectors)")
+	cmd.Flags().String("compression", "on", "Compression: on, off, lz4, zstd, gzip, lzjb, or zle")
+	if err := cmd.MarkFlagRequired("devices"); err != nil {
+		panic(err)
+	}
+	_ = cmd.RegisterFlagCompletionFunc("devices", completeDisks)
+	_ = cmd.RegisterFlagCompletionFunc("raid", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return zfsRaidLevels, cobra.ShellCompDirectiveNoFileComp
+	})
+	return cmd
+}
+
+// validateZFSLayout checks the device count ZFS needs for a RAID level.
+func validateZFSLayout(raid string, devices int) error {
+	minimum := map[string]int{
+		"single": 1, "mirror": 2, "raid10": 4, "raidz": 3, "raidz2": 4, "raidz3": 5,
+		"draid": 2, "draid2": 3, "draid3": 4,
+	}
+	needed, ok := minimum[raid]
+	if !ok {
+		return fmt.Errorf("unsupported RAID level %q; use %s", raid, strings.Join(zfsRaidLevels, ", "))
+	}
+	if devices == 0 {
+		return fmt.Errorf("at least one device is required")
+	}
+	if raid == "single" && devices != 1 {
+		return fmt.Errorf("RAID level single takes exactly one device; use mirror or raidz for %d", devices)
+	}
+	if devices < needed {
+		return fmt.Errorf("RAID level %s needs at least %d devices, got %d", raid, needed, devices)
+	}
+	if raid == "raid10" && devices%2 != 0 {
+		return fmt.Errorf("RAID level raid10 needs an even number of devices")
+	}
+	return nil
+}
+
+func newDisksCreateLVMThinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-lvmthin",
+		Short: "Create an LVM-thin pool on an unused disk",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, addStorage, err := storageNameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			device, err := deviceFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			options := &proxmox.NodeLVMThinOptions{Name: name, Device: device, AddStorage: proxmox.IntOrBool(addStorage)}
+			err = runDiskTask(cmd, node, nodeName, []string{device}, "create LVM-thin pool "+name, func(ctx context.Context) (*proxmox.Task, error) {
+				return node.NewLVMThin(ctx, options)
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "LVM-thin pool %s created on node %s\n", name, nodeName)
+			return nil
+		},
+	}
+
+	addDiskWriteFlags(cmd)
+	addStorageNameFlags(cmd, "fastdata")
+	addDeviceFlag(cmd)
+	return cmd
+}
+
+func newDisksCreateDirectoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-directory",
+		Short: "Format an unused disk and mount it as a directory storage",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, addStorage, err := storageNameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			device, err := deviceFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			filesystem, err := cmd.Flags().GetString("filesystem")
+			if err != nil {
+				return fmt.Errorf("get filesystem flag: %w", err)
+			}
+			if filesystem != "ext4" && filesystem != "xfs" {
+				return fmt.Errorf("unsupported filesystem %q; use ext4 or xfs", filesystem)
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			options := &proxmox.NodeDirectoryOptions{Name: name, Device: device, Filesystem: filesystem, AddStorage: proxmox.IntOrBool(addStorage)}
+			err = runDiskTask(cmd, node, nodeName, []string{device}, fmt.Sprintf("format %s as %s for directory storage %s", device, filesystem, name), func(ctx context.Context) (*proxmox.Task, error) {
+				return node.NewDirectory(ctx, options)
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Directory storage %s created on node %s (mounted at /mnt/pve/%s)\n", name, nodeName, name)
+			return nil
+		},
+	}
+
+	addDiskWriteFlags(cmd)
+	addStorageNameFlags(cmd, "backups")
+	addDeviceFlag(cmd)
+	cmd.Flags().String("filesystem", "ext4", "Filesystem: ext4 or xfs")
+	return cmd
+}
@@ -0,0 +1,723 @@
+package nodes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// guestMove is one planned guest migration. Reason is set instead of
+// Target when the guest cannot be moved.
+type guestMove struct {
+	VMID   int    `json:"vmid"`
+	Type   string `json:"type"`
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status"`
+	Memory uint64 `json:"memory_bytes"`
+	From   string `json:"from"`
+	Target string `json:"target,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// evacuationRecord remembers where guests were moved so 'nodes return' can
+// bring them back. It is kept next to the CLI configuration.
+type evacuationRecord struct {
+	Node   string      `json:"node"`
+	Time   time.Time   `json:"time"`
+	Guests []guestMove `json:"guests"`
+}
+
+// migrationOptions are the settings shared by evacuate and return.
+type migrationOptions struct {
+	parallel       int
+	withLocalDisks bool
+	timeout        time.Duration
+}
+
+func newEvacuateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evacuate",
+		Short: "Migrate every guest off a node",
+		Long: `Move all guests off a node, e.g. before rebooting it. Running VMs are
+live-migrated and running containers restart-migrated. With --target auto
+each guest goes to the online node with the most free memory, taking the
+guests already planned into account; VM preconditions (local resources,
+disallowed nodes) are checked first and blocked guests are skipped.
+
+--maintenance puts the node into HA maintenance first so the HA stack
+moves its own resources; it runs ha-manager in the node shell and needs a
+root@pam session. Bring guests back with 'proxmox-cli nodes return'.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			flags := cmd.Flags()
+			target, err := flags.GetString("target")
+			if err != nil {
+				return fmt.Errorf("get target flag: %w", err)
+			}
+			runningOnly, err := flags.GetBool("running-only")
+			if err != nil {
+				return fmt.Errorf("get running-only flag: %w", err)
+			}
+			maintenance, err := flags.GetBool("maintenance")
+			if err != nil {
+				return fmt.Errorf("get maintenance flag: %w", err)
+			}
+			dryRun, err := flags.GetBool("dry-run")
+			if err != nil {
+				return fmt.Errorf("get dry-run flag: %w", err)
+			}
+			options, err := migrationOptionsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			nodeName, err := nodeNameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			target = strings.TrimSpace(target)
+			if target == nodeName {
+				return fmt.Errorf("target must differ from the node being evacuated")
+			}
+			if maintenance {
+				if err := checkHAMaintenanceAuth(); err != nil {
+					return err
+				}
+			}
+
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			cluster, err := client.Cluster(ctx)
+			if err != nil {
+				return fmt.Errorf("get cluster: %w", err)
+			}
+			resources, err := cluster.Resources(ctx)
+			if err != nil {
+				return fmt.Errorf("list cluster resources: %w", err)
+			}
+			moves, err := planEvacuation(ctx, client, resources, nodeName, target, runningOnly, maintenance, options.withLocalDisks)
+			if err != nil {
+				return err
+			}
+
+			printMovePlan(out, moves)
+			pending := movable(moves)
+			if dryRun {
+				return nil
+			}
+			if len(pending) == 0 && !maintenance {
+				fmt.Fprintf(out, "Nothing to migrate off node %s\n", nodeName)
+				return nil
+			}
+			prompt := fmt.Sprintf("Migrate %d guests off node %s?", len(pending), nodeName)
+			if maintenance {
+				prompt = fmt.Sprintf("Enable HA maintenance on node %s and migrate %d guests off it?", nodeName, len(pending))
+			}
+			if err := utility.ConfirmAction(cmd, prompt); err != nil {
+				return err
+			}
+			if maintenance {
+				if err := setHAMaintenance(ctx, nodeName, true); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "HA maintenance enabled on node %s\n", nodeName)
+			}
+
+			done, failures := runMoves(ctx, client, out, pending, options)
+			if err := recordEvacuation(nodeName, done); err != nil {
+				return err
+			}
+			if failures > 0 {
+				return fmt.Errorf("%d of %d migrations failed", failures, len(pending))
+			}
+			fmt.Fprintf(out, "Node %s evacuated; %d guests migrated\n", nodeName, len(done))
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	cmd.Flags().String("target", "auto", "Destination node, or auto to pick by free memory")
+	cmd.Flags().Bool("running-only", false, "Leave stopped guests on the node")
+	cmd.Flags().Bool("maintenance", false, "Enable HA node maintenance before migrating")
+	addMigrationFlags(cmd)
+	utility.RegisterNodeFlagCompletion(cmd, "target")
+	return cmd
+}
+
+func newReturnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "return",
+		Short: "Migrate guests back to a node after an evacuation",
+		Long: `Move the guests a previous 'proxmox-cli nodes evacuate' migrated off the
+node back to it. Guests deleted or already moved back are skipped.
+--maintenance disables HA maintenance on the node first, which lets the HA
+stack return its own resources.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			maintenance, err := cmd.Flags().GetBool("maintenance")
+			if err != nil {
+				return fmt.Errorf("get maintenance flag: %w", err)
+			}
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return fmt.Errorf("get dry-run flag: %w", err)
+			}
+			options, err := migrationOptionsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			nodeName, err := nodeNameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			record, err := loadEvacuation(nodeName)
+			if err != nil {
+				return err
+			}
+			if record == nil && !maintenance {
+				return fmt.Errorf("no evacuation of node %q is recorded", nodeName)
+			}
+			if maintenance {
+				if err := checkHAMaintenanceAuth(); err != nil {
+					return err
+				}
+			}
+
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			cluster, err := client.Cluster(ctx)
+			if err != nil {
+				return fmt.Errorf("get cluster: %w", err)
+			}
+			resources, err := cluster.Resources(ctx, "vm")
+			if err != nil {
+				return fmt.Errorf("list cluster resources: %w", err)
+			}
+			var moves []guestMove
+			if record != nil {
+				moves = planReturn(record, resources)
+			}
+
+			printMovePlan(out, moves)
+			pending := movable(moves)
+			if dryRun {
+				return nil
+			}
+			prompt := fmt.Sprintf("Migrate %d guests back to node %s?", len(pending), nodeName)
+			if maintenance {
+				prompt = fmt.Sprintf("Disable HA maintenance on node %s and migrate %d guests back to it?", nodeName, len(pending))
+			}
+			if err := utility.ConfirmAction(cmd, prompt); err != nil {
+				return err
+			}
+			if maintenance {
+				if err := setHAMaintenance(ctx, nodeName, false); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "HA maintenance disabled on node %s\n", nodeName)
+			}
+
+			done, failures := runMoves(ctx, client, out, pending, options)
+			if err := forgetReturned(nodeName, record, moves, done); err != nil {
+				return err
+			}
+			if failures > 0 {
+				return fmt.Errorf("%d of %d migrations failed", failures, len(pending))
+			}
+			fmt.Fprintf(out, "%d guests returned to node %s\n", len(done), nodeName)
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	cmd.Flags().Bool("maintenance", false, "Disable HA node maintenance before migrating")
+	addMigrationFlags(cmd)
+	return cmd
+}
+
+func addMigrationFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("parallel", 2, "Number of migrations to run at once")
+	cmd.Flags().Bool("with-local-disks", false, "Also migrate VMs with local disks")
+	cmd.Flags().Bool("dry-run", false, "Show the migration plan without running it")
+	utility.AddYesFlag(cmd)
+}
+
+func migrationOptionsFromFlags(cmd *cobra.Command) (migrationOptions, error) {
+	parallel, err := cmd.Flags().GetInt("parallel")
+	if err != nil {
+		return migrationOptions{}, fmt.Errorf("get parallel flag: %w", err)
+	}
+	if parallel < 1 {
+		return migrationOptions{}, fmt.Errorf("--parallel must be at least 1")
+	}
+	withLocalDisks, err := cmd.Flags().GetBool("with-local-disks")
+	if err != nil {
+		return migrationOptions{}, fmt.Errorf("get with-local-disks flag: %w", err)
+	}
+	return migrationOptions{parallel: parallel, withLocalDisks: withLocalDisks, timeout: utility.TaskTimeout(cmd)}, nil
+}
+
+// planEvacuation assigns every guest on the node a destination. Guests are
+// placed largest first on the candidate with the most memory left.
+func planEvacuation(ctx context.Context, client interfaces.ProxmoxClientInterface, resources proxmox.ClusterResources,
+	nodeName, target string, runningOnly, skipHA, withLocalDisks bool) ([]guestMove, error) {
+	free := map[string]int64{}
+	sourceFound := false
+	for _, resource := range resources {
+		if resource == nil || resource.Type != "node" {
+			continue
+		}
+		if resource.Node == nodeName {
+			sourceFound = true
+			continue
+		}
+		if resource.Status == "online" {
+			free[resource.Node] = int64(resource.MaxMem) - int64(resource.Mem)
+		}
+	}
+	if !sourceFound {
+		return nil, fmt.Errorf("node %q not found in the cluster", nodeName)
+	}
+	if target != "auto" {
+		if _, ok := free[target]; !ok {
+			return nil, fmt.Errorf("target node %q is not an online cluster node", target)
+		}
+		free = map[string]int64{target: free[target]}
+	}
+	if len(free) == 0 {
+		return nil, fmt.Errorf("no other online node to migrate to")
+	}
+
+	var moves []guestMove
+	for _, resource := range resources {
+		if resource == nil || (resource.Type != "qemu" && resource.Type != "lxc") || resource.Node != nodeName {
+			continue
+		}
+		if runningOnly && resource.Status != "running" {
+			continue
+		}
+		moves = append(moves, guestMove{
+			VMID:   int(resource.VMID),
+			Type:   resource.Type,
+			Name:   resource.Name,
+			Status: resource.Status,
+			Memory: resource.MaxMem,
+			From:   nodeName,
+		})
+		if skipHA && resource.HAstate != "" {
+			moves[len(moves)-1].Reason = "managed by HA"
+		}
+	}
+	sort.SliceStable(moves, func(i, j int) bool { return moves[i].Memory > moves[j].Memory })
+
+	var node interfaces.NodeInterface
+	for i := range moves {
+		move := &moves[i]
+		if move.Reason != "" {
+			continue
+		}
+		if node == nil {
+			var err error
+			if node, err = client.Node(ctx, nodeName); err != nil {
+				return nil, fmt.Errorf("get node %q: %w", nodeName, err)
+			}
+		}
+		check := vmMigrationCheck
+		if move.Type == "lxc" {
+			check = containerMigrationCheck
+		}
+		reason, allowed, err := check(ctx, node, move.VMID, withLocalDisks)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			move.Reason = reason
+			continue
+		}
+
+		best := ""
+		for candidate, memory := range free {
+			if !allowed(candidate) {
+				continue
+			}
+			if best == "" || memory > free[best] || (memory == free[best] && candidate < best) {
+				best = candidate
+			}
+		}
+		if best == "" {
+			move.Reason = "no allowed target node"
+			continue
+		}
+		move.Target = best
+		free[best] -= int64(move.Memory)
+	}
+	return moves, nil
+}
+
+// vmMigrationCheck reads the migration preconditions of a VM. It returns a
+// reason when the VM cannot move at all, or a filter for allowed targets.
+func vmMigrationCheck(ctx context.Context, node interfaces.NodeInterface, vmid int, withLocalDisks bool) (string, func(string) bool, error) {
+	vm, err := node.VirtualMachine(ctx, vmid)
+	if err != nil {
+		return "", nil, fmt.Errorf("get VM %d: %w", vmid, err)
+	}
+	preconditions, err := vm.MigratePreconditions(ctx, "")
+	if err != nil {
+		return "", nil, fmt.Errorf("check migration preconditions for VM %d: %w", vmid, err)
+	}
+	if len(preconditions.LocalResources) > 0 {
+		return "local resources: " + strings.Join(preconditions.LocalResources, ", "), nil, nil
+	}
+	if len(preconditions.LocalDisks) > 0 && !withLocalDisks {
+		return "local disks (use --with-local-disks)", nil, nil
+	}
+	return "", allowedTargets(preconditions.AllowedNodes, preconditions.NotAllowedNodes), nil
+}
+
+// containerMigrationCheck is vmMigrationCheck for containers. Their local
+// volumes are copied during the restart migration, so only local resources
+// block them.
+func containerMigrationCheck(ctx context.Context, node interfaces.NodeInterface, vmid int, _ bool) (string, func(string) bool, error) {
+	container, err := node.Container(ctx, vmid)
+	if err != nil {
+		return "", nil, fmt.Errorf("get container %d: %w", vmid, err)
+	}
+	preconditions, err := container.MigratePreconditions(ctx, "")
+	if err != nil {
+		return "", nil, fmt.Errorf("check migration preconditions for container %d: %w", vmid, err)
+	}
+	if len(preconditions.LocalResources) > 0 {
+		return "local resources: " + strings.Join(preconditions.LocalResources, ", "), nil, nil
+	}
+	return "", allowedTargets(preconditions.AllowedNodes, preconditions.NotAllowedNodes), nil
+}
+
+// allowedTargets builds the target filter from migration preconditions.
+func allowedTargets(allowedNodes []string, notAllowed map[string]*proxmox.VirtualMachineMigratePreconditionsNotAllowedNodes) func(string) bool {
+	return func(candidate string) bool {
+		if blocked, exists := notAllowed[candidate]; exists && blocked != nil {
+			return false
+		}
+		if len(allowedNodes) == 0 {
+			return true
+		}
+		for _, name := range allowedNodes {
+			if name == candidate {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// planReturn maps the recorded guests to migrations from wherever they are
+// now back to the evacuated node.
+func planReturn(record *evacuationRecord, resources proxmox.ClusterResources) []guestMove {
+	current := map[int]*proxmox.ClusterResource{}
+	for _, resource := range resources {
+		if resource != nil && (resource.Type == "qemu" || resource.Type == "lxc") {
+			current[int(resource.VMID)] = resource
+		}
+	}
+	moves := make([]guestMove, 0, len(record.Guests))
+	for _, guest := range record.Guests {
+		move := guestMove{VMID: guest.VMID, Type: guest.Type, Name: guest.Name, Memory: guest.Memory, Target: record.Node}
+		resource, ok := current[guest.VMID]
+		switch {
+		case !ok || resource.Type != guest.Type:
+			move.Target, move.Reason = "", "no longer exists"
+		case resource.Node == record.Node:
+			move.From, move.Status = resource.Node, resource.Status
+			move.Target, move.Reason = "", "already on "+record.Node
+		default:
+			move.From, move.Status = resource.Node, resource.Status
+		}
+		moves = append(moves, move)
+	}
+	return moves
+}
+
+func movable(moves []guestMove) []guestMove {
+	var pending []guestMove
+	for _, move := range moves {
+		if move.Reason == "" {
+			pending = append(pending, move)
+		}
+	}
+	return pending
+}
+
+func guestLabel(move guestMove) string {
+	if move.Type == "lxc" {
+		return fmt.Sprintf("Container %d", move.VMID)
+	}
+	return fmt.Sprintf("VM %d", move.VMID)
+}
+
+func printMovePlan(out io.Writer, moves []guestMove) {
+	fmt.Fprintf(out, "%-8s %-5s %-20s %-9s %-10s %s\n", "VMID", "Type", "Name", "Status", "From", "Target")
+	fmt.Fprintf(out, "%-8s %-5s %-20s %-9s %-10s %s\n", "----", "----", "----", "------", "----", "------")
+	for _, move := range moves {
+		target := move.Target
+		if move.Reason != "" {
+			target = "skipped: " + move.Reason
+		}
+		fmt.Fprintf(out, "%-8d %-5s %-20s %-9s %-10s %s\n", move.VMID, move.Type, utility.DashIfEmpty(move.Name),
+			utility.DashIfEmpty(move.Status), utility.DashIfEmpty(move.From), target)
+	}
+	if len(moves) == 0 {
+		fmt.Fprintln(out, "No guests to migrate")
+	}
+}
+
+// runMoves runs the migrations with at most options.parallel at a time and
+// returns the ones that succeeded along with the number of failures. Task
+// logs are not streamed since they would interleave.
+func runMoves(ctx context.Context, client interfaces.ProxmoxClientInterface, out io.Writer, moves []guestMove, options migrationOptions) ([]guestMove, int) {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		done     []guestMove
+		failures int
+	)
+	slots := make(chan struct{}, options.parallel)
+	for _, move := range moves {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			err := migrateGuest(ctx, client, move, options)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures++
+				fmt.Fprintf(out, "%s: migration to %s failed: %v\n", guestLabel(move), move.Target, err)
+				return
+			}
+			done = append(done, move)
+			fmt.Fprintf(out, "%s migrated to %s\n", guestLabel(move), move.Target)
+		}()
+	}
+	wg.Wait()
+	sort.Slice(done, func(i, j int) bool { return done[i].VMID < done[j].VMID })
+	return done, failures
+}
+
+func migrateGuest(ctx context.Context, client interfaces.ProxmoxClientInterface, move guestMove, options migrationOptions) error {
+	node, err := client.Node(ctx, move.From)
+	if err != nil {
+		return fmt.Errorf("get node %q: %w", move.From, err)
+	}
+	running := move.Status == "running"
+	var task *proxmox.Task
+	if move.Type == "lxc" {
+		container, err := node.Container(ctx, move.VMID)
+		if err != nil {
+			return fmt.Errorf("get container: %w", err)
+		}
+		task, err = container.Migrate(ctx, &proxmox.ContainerMigrateOptions{
+			Target:  move.Target,
+			Restart: proxmox.IntOrBool(running),
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		vm, err := node.VirtualMachine(ctx, move.VMID)
+		if err != nil {
+			return fmt.Errorf("get VM: %w", err)
+		}
+		task, err = vm.Migrate(ctx, &proxmox.VirtualMachineMigrateOptions{
+			Target:         move.Target,
+			Online:         proxmox.IntOrBool(running),
+			WithLocalDisks: proxmox.IntOrBool(options.withLocalDisks),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return utility.WaitForTask(ctx, task, options.timeout, nil)
+}
+
+// checkHAMaintenanceAuth fails early when the stored credentials cannot run
+// setHAMaintenance: the node shell rejects API tokens and only root@pam may
+// run ha-manager. Proxmox tickets carry the user as "PVE:<user>:...".
+func checkHAMaintenanceAuth() error {
+	if !utility.HasSessionTicket() {
+		return errors.New("--maintenance runs ha-manager in the node shell, which API tokens cannot open; run 'proxmox-cli auth login -u root@pam' first")
+	}
+	parts := strings.Split(utility.ContextString("auth_ticket.ticket"), ":")
+	if len(parts) > 1 && parts[1] != "root@pam" {
+		return fmt.Errorf("--maintenance needs a root@pam session, but the stored session is for %s", parts[1])
+	}
+	return nil
+}
+
+// setHAMaintenance toggles HA node maintenance. Proxmox only offers this
+// through ha-manager, so the command is run in the node's shell.
+func setHAMaintenance(ctx context.Context, nodeName string, enable bool) error {
+	action := "disable"
+	if enable {
+		action = "enable"
+	}
+	client, err := utility.SessionClient()
+	if err != nil {
+		return err
+	}
+	node, err := client.Node(ctx, nodeName)
+	if err != nil {
+		return fmt.Errorf("get node %q: %w", nodeName, err)
+	}
+	term, err := node.TermProxy(ctx, "", "")
+	if err != nil {
+		return fmt.Errorf("open terminal proxy on node %q: %w", nodeName, err)
+	}
+	send, recv, errs, closer, err := node.TermWebSocket(term)
+	if err != nil {
+		return fmt.Errorf("connect shell websocket on node %q: %w", nodeName, err)
+	}
+	defer func() { _ = closer() }()
+
+	session := utility.NewConsoleSession(send, recv, errs)
+	noLogin := func() (string, error) {
+		return "", errors.New("the node shell asks for a login; HA maintenance needs a root@pam session")
+	}
+	command := utility.ShellQuote([]string{"ha-manager", "crm-command", "node-maintenance", action, nodeName})
+	output, exitCode, err := session.Run(ctx, "root", noLogin, command)
+	if err != nil {
+		return fmt.Errorf("%s HA maintenance on node %q: %w", action, nodeName, err)
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("%s HA maintenance on node %q: %s", action, nodeName, strings.TrimSpace(output))
+	}
+	return nil
+}
+
+func evacuationFile(nodeName string) (string, error) {
+	config, err := utility.ConfigFile()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(config), "evacuations", utility.ActiveContext()+"-"+nodeName+".json"), nil
+}
+
+// loadEvacuation returns the recorded evacuation of a node, or nil.
+func loadEvacuation(nodeName string) (*evacuationRecord, error) {
+	path, err := evacuationFile(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read evacuation record: %w", err)
+	}
+	var record evacuationRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("parse evacuation record %s: %w", path, err)
+	}
+	return &record, nil
+}
+
+// saveEvacuation writes the record, removing the file once no guests are
+// left to return.
+func saveEvacuation(record *evacuationRecord) error {
+	path, err := evacuationFile(record.Node)
+	if err != nil {
+		return err
+	}
+	if len(record.Guests) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("remove evacuation record: %w", err)
+		}
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("create evacuation record directory: %w", err)
+	}
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode evacuation record: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("write evacuation record: %w", err)
+	}
+	return nil
+}
+
+// recordEvacuation adds the migrated guests to the node's record, keeping
+// guests from earlier evacuations that have not returned yet.
+func recordEvacuation(nodeName string, done []guestMove) error {
+	if len(done) == 0 {
+		return nil
+	}
+	record, err := loadEvacuation(nodeName)
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		record = &evacuationRecord{Node: nodeName}
+	}
+	moved := map[int]bool{}
+	for _, move := range done {
+		moved[move.VMID] = true
+	}
+	guests := done
+	for _, guest := range record.Guests {
+		if !moved[guest.VMID] {
+			guests = append(guests, guest)
+		}
+	}
+	sort.Slice(guests, func(i, j int) bool { return guests[i].VMID < guests[j].VMID })
+	record.Guests, record.Time = guests, time.Now().UTC()
+	return saveEvacuation(record)
+}
+
+// forgetReturned drops guests that are back, or can no longer come back,
+// from the record.
+func forgetReturned(nodeName string, record *evacuationRecord, moves, done []guestMove) error {
+	if record == nil {
+		return nil
+	}
+	finished := map[int]bool{}
+	for _, move := range moves {
+		if move.Reason != "" {
+			finished[move.VMID] = true
+		}
+	}
+	for _, move := range done {
+		finished[move.VMID] = true
+	}
+	remaining := record.Guests[:0]
+	for _, guest := range record.Guests {
+		if !finished[guest.VMID] {
+			remaining = append(remaining, guest)
+		}
+	}
+	record.Guests = remaining
+	return saveEvacuation(record)
+}
@@ -0,0 +1,237 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// guestResult is the state of one guest before and after a bulk action.
+type guestResult struct {
+	VMID   int    `json:"vmid"`
+	Type   string `json:"type"`
+	Name   string `json:"name,omitempty"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+func newGuestsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "guests",
+		Short: "Start, stop, or suspend all guests of a node",
+		Long: `Run the node's bulk guest actions. Proxmox orders guests by their
+"startup" option (order and up/down delays), so start-all after stop-all
+brings the node back in the planned order. Each command waits for the bulk
+task and then prints the state of every affected guest.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newGuestsStartAllCmd(), newGuestsStopAllCmd(), newGuestsSuspendAllCmd())
+	return cmd
+}
+
+func newGuestsStartAllCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start-all",
+		Short: "Start the node's guests in startup order",
+		Long: `Start the guests of a node in startup order. Only guests with onboot set
+are started unless --force is given; HA-managed guests are left to the HA
+stack.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			force, err := cmd.Flags().GetBool("force")
+			if err != nil {
+				return fmt.Errorf("get force flag: %w", err)
+			}
+			return runBulkGuestAction(cmd, "start", true, func(ctx context.Context, node interfaces.NodeInterface, vms string) (*proxmox.Task, error) {
+				return node.StartAll(ctx, force, vms)
+			})
+		},
+	}
+	addBulkGuestFlags(cmd)
+	cmd.Flags().Bool("force", false, "Also start guests that do not have onboot set")
+	return cmd
+}
+
+func newGuestsStopAllCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop-all",
+		Short: "Shut down the node's guests in reverse startup order",
+		Long: `Shut down the guests of a node in reverse startup order. Guests that do
+not shut down within --shutdown-timeout seconds are stopped hard unless
+--force-stop=false is given.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			forceStop, err := cmd.Flags().GetBool("force-stop")
+			if err != nil {
+				return fmt.Errorf("get force-stop flag: %w", err)
+			}
+			timeout, err := cmd.Flags().GetInt("shutdown-timeout")
+			if err != nil {
+				return fmt.Errorf("get shutdown-timeout flag: %w", err)
+			}
+			if timeout < 0 {
+				return fmt.Errorf("--shutdown-timeout cannot be negative")
+			}
+			return runBulkGuestAction(cmd, "stop", true, func(ctx context.Context, node interfaces.NodeInterface, vms string) (*proxmox.Task, error) {
+				return node.StopAll(ctx, forceStop, timeout, vms)
+			})
+		},
+	}
+	addBulkGuestFlags(cmd)
+	utility.AddYesFlag(cmd)
+	cmd.Flags().Bool("force-stop", true, "Hard-stop guests that do not shut down in time")
+	cmd.Flags().Int("shutdown-timeout", 180, "Seconds to wait for each guest to shut down")
+	return cmd
+}
+
+func newGuestsSuspendAllCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suspend-all",
+		Short: "Suspend the node's running VMs",
+		Long:  `Pause all running VMs of a node. Containers are not affected.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBulkGuestAction(cmd, "suspend", false, func(ctx context.Context, node interfaces.NodeInterface, vms string) (*proxmox.Task, error) {
+				return node.SuspendAll(ctx, vms)
+			})
+		},
+	}
+	addBulkGuestFlags(cmd)
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func addBulkGuestFlags(cmd *cobra.Command) {
+	addNodeNameFlag(cmd)
+	cmd.Flags().IntSlice("vms", nil, "Only act on these VMIDs (comma-separated)")
+	utility.AddOutputFlag(cmd)
+}
+
+func runBulkGuestAction(cmd *cobra.Command, action string, includeContainers bool,
+	run func(context.Context, interfaces.NodeInterface, string) (*proxmox.Task, error)) error {
+	out := cmd.OutOrStdout()
+	ctx := cmd.Context()
+	format, err := utility.OutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	vmids, err := cmd.Flags().GetIntSlice("vms")
+	if err != nil {
+		return fmt.Errorf("get vms flag: %w", err)
+	}
+	node, nodeName, err := nodeFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	states, err := nodeGuestStates(ctx, node, includeContainers)
+	if err != nil {
+		return fmt.Errorf("list guests on node %q: %w", nodeName, err)
+	}
+	results := make([]guestResult, 0, len(states))
+	ids := make([]string, 0, len(vmids))
+	for _, vmid := range vmids {
+		result, ok := states[vmid]
+		if !ok {
+			return fmt.Errorf("guest %d not found on node %q", vmid, nodeName)
+		}
+		results = append(results, result)
+		ids = append(ids, strconv.Itoa(vmid))
+	}
+	if len(vmids) == 0 {
+		for _, result := range states {
+			results = append(results, result)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].VMID < results[j].VMID })
+	if len(results) == 0 {
+		fmt.Fprintf(out, "No guests on node %s\n", nodeName)
+		return nil
+	}
+	if action != "start" {
+		prompt := fmt.Sprintf("%s %d guests on node %s?", capitalize(action), len(results), nodeName)
+		if err := utility.ConfirmAction(cmd, prompt); err != nil {
+			return err
+		}
+	}
+
+	task, err := run(ctx, node, strings.Join(ids, ","))
+	if err != nil {
+		return fmt.Errorf("%s all guests on node %q: %w", action, nodeName, err)
+	}
+	if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+		return fmt.Errorf("%s all guests on node %q: %w", action, nodeName, err)
+	}
+
+	after, err := nodeGuestStates(ctx, node, includeContainers)
+	if err != nil {
+		return fmt.Errorf("list guests on node %q: %w", nodeName, err)
+	}
+	stillRunning := 0
+	for i := range results {
+		results[i].After = "missing"
+		if state, ok := after[results[i].VMID]; ok {
+			results[i].After = state.Before
+		}
+		if action == "stop" && results[i].After != "stopped" {
+			stillRunning++
+		}
+	}
+
+	if format == "json" {
+		if err := utility.PrintJSON(out, results); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintf(out, "%-8s %-5s %-20s %-10s %s\n", "VMID", "Type", "Name", "Before", "After")
+		fmt.Fprintf(out, "%-8s %-5s %-20s %-10s %s\n", "----", "----", "----", "------", "-----")
+		for _, result := range results {
+			fmt.Fprintf(out, "%-8d %-5s %-20s %-10s %s\n", result.VMID, result.Type, utility.DashIfEmpty(result.Name), result.Before, result.After)
+		}
+	}
+	if stillRunning > 0 {
+		return fmt.Errorf("%d guests on node %q did not stop", stillRunning, nodeName)
+	}
+	return nil
+}
+
+// nodeGuestStates returns the current state of the node's guests by VMID,
+// in the Before field. Paused VMs are reported as "paused".
+func nodeGuestStates(ctx context.Context, node interfaces.NodeInterface, includeContainers bool) (map[int]guestResult, error) {
+	vms, err := node.VirtualMachines(ctx)
+	if err != nil {
+		return nil, err
+	}
+	states := map[int]guestResult{}
+	for _, vm := range vms {
+		if vm == nil {
+			continue
+		}
+		state := vm.Status
+		if vm.QMPStatus == "paused" || vm.QMPStatus == "suspended" {
+			state = vm.QMPStatus
+		}
+		states[int(vm.VMID)] = guestResult{VMID: int(vm.VMID), Type: "qemu", Name: vm.Name, Before: state}
+	}
+	if !includeContainers {
+		return states, nil
+	}
+	containers, err := node.Containers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, container := range containers {
+		if container == nil {
+			continue
+		}
+		states[int(container.VMID)] = guestResult{VMID: int(container.VMID), Type: "lxc", Name: container.Name, Before: container.Status}
+	}
+	return states, nil
+}
@@ -0,0 +1,333 @@
+package nodes
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+type pciDeviceSummary struct {
+	ID         string                 `json:"id"`
+	IOMMUGroup int                    `json:"iommu_group"`
+	Class      string                 `json:"class"`
+	VendorID   string                 `json:"vendor_id"`
+	Vendor     string                 `json:"vendor"`
+	DeviceID   string                 `json:"device_id"`
+	Device     string                 `json:"device"`
+	Mdev       bool                   `json:"mdev"`
+	MdevTypes  []*proxmox.PCIMdevType `json:"mdev_types,omitempty"`
+}
+
+type usbDeviceSummary struct {
+	Port         string `json:"port"`
+	ID           string `json:"id"`
+	Speed        string `json:"speed"`
+	Manufacturer string `json:"manufacturer,omitempty"`
+	Product      string `json:"product,omitempty"`
+	Serial       string `json:"serial,omitempty"`
+}
+
+type hardwareMappingSummary struct {
+	Type        string   `json:"type"`
+	ID          string   `json:"id"`
+	Description string   `json:"description,omitempty"`
+	Nodes       []string `json:"nodes"`
+	Map         []string `json:"map"`
+	Issues      []string `json:"issues,omitempty"`
+}
+
+func newHardwareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hardware",
+		Short: "Inspect node hardware for passthrough",
+		Long: `List the PCI and USB devices of a node and the cluster-wide hardware
+mappings, e.g. to pick a device for 'vm passthrough add'.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newHardwarePCICmd(), newHardwareUSBCmd(), newHardwareMappingsCmd())
+	return cmd
+}
+
+func newHardwarePCICmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pci",
+		Short: "List PCI devices on a node",
+		Long: `List the PCI devices of a node with their IOMMU group and vendor/device
+IDs. Memory controllers, bridges, and processors are hidden unless --all
+is given; --mdev also lists the mediated device types of mdev-capable
+devices.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			nodeName, err := nodeNameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			all, err := cmd.Flags().GetBool("all")
+			if err != nil {
+				return fmt.Errorf("get all flag: %w", err)
+			}
+			withMdev, err := cmd.Flags().GetBool("mdev")
+			if err != nil {
+				return fmt.Errorf("get mdev flag: %w", err)
+			}
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			ctx := cmd.Context()
+
+			node, err := client.Node(ctx, nodeName)
+			if err != nil {
+				return fmt.Errorf("get node %q: %w", nodeName, err)
+			}
+			var options *proxmox.HardwarePCIOptions
+			if all {
+				// An empty blacklist overrides the server-side default.
+				options = &proxmox.HardwarePCIOptions{ClassBlacklist: []string{""}}
+			}
+			devices, err := node.ListPCIDevices(ctx, options)
+			if err != nil {
+				return fmt.Errorf("list PCI devices on node %q: %w", nodeName, err)
+			}
+
+			summaries := make([]pciDeviceSummary, 0, len(devices))
+			for _, device := range devices {
+				summary := pciDeviceSummary{
+					ID:         device.ID,
+					IOMMUGroup: device.IOMMUGroup,
+					Class:      device.Class,
+					VendorID:   device.Vendor,
+					Vendor:     device.VendorName,
+					DeviceID:   device.Device,
+					Device:     device.DeviceName,
+					Mdev:       device.MdevCapable,
+				}
+				if withMdev && device.MdevCapable {
+					if summary.MdevTypes, err = node.PCIMdevTypes(ctx, device.ID); err != nil {
+						return fmt.Errorf("list mdev types of %s: %w", device.ID, err)
+					}
+				}
+				summaries = append(summaries, summary)
+			}
+			sort.Slice(summaries, func(i, j int) bool { return summaries[i].ID < summaries[j].ID })
+
+			if format == "json" {
+				return utility.PrintJSON(out, summaries)
+			}
+			printPCIDevices(out, nodeName, summaries)
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	cmd.Flags().Bool("all", false, "Include memory controllers, bridges, and processors")
+	cmd.Flags().Bool("mdev", false, "List mediated device types of mdev-capable devices")
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func printPCIDevices(out io.Writer, nodeName string, devices []pciDeviceSummary) {
+	fmt.Fprintf(out, "PCI devices on node %s:\n", nodeName)
+	fmt.Fprintf(out, "%-14s %-6s %-11s %-5s %s\n", "ID", "IOMMU", "Vendor:Dev", "Mdev", "Device")
+	fmt.Fprintf(out, "%-14s %-6s %-11s %-5s %s\n", "--", "-----", "----------", "----", "------")
+	for _, device := range devices {
+		iommu := "-"
+		if device.IOMMUGroup >= 0 {
+			iommu = fmt.Sprintf("%d", device.IOMMUGroup)
+		}
+		mdev := "no"
+		if device.Mdev {
+			mdev = "yes"
+		}
+		ids := strings.TrimPrefix(device.VendorID, "0x") + ":" + strings.TrimPrefix(device.DeviceID, "0x")
+		fmt.Fprintf(out, "%-14s %-6s %-11s %-5s %s %s\n", device.ID, iommu, ids, mdev, device.Vendor, device.Device)
+		for _, mdevType := range device.MdevTypes {
+			fmt.Fprintf(out, "%-14s   mdev %s (%s, %d available)\n", "", mdevType.Type, mdevType.Name, mdevType.Available)
+		}
+	}
+	if len(devices) == 0 {
+		fmt.Fprintln(out, "No PCI devices found on this node")
+	}
+}
+
+func newHardwareUSBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usb",
+		Short: "List USB devices on a node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			nodeName, err := nodeNameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			ctx := cmd.Context()
+
+			node, err := client.Node(ctx, nodeName)
+			if err != nil {
+				return fmt.Errorf("get node %q: %w", nodeName, err)
+			}
+			devices, err := node.ListUSBDevices(ctx)
+			if err != nil {
+				return fmt.Errorf("list USB devices on node %q: %w", nodeName, err)
+			}
+
+			summaries := make([]usbDeviceSummary, 0, len(devices))
+			for _, device := range devices {
+				summaries = append(summaries, usbDeviceSummary{
+					Port:         fmt.Sprintf("%d-%s", device.BusNum, device.USBPath),
+					ID:           device.VendID + ":" + device.ProdID,
+					Speed:        device.Speed,
+					Manufacturer: device.Manufacturer,
+					Product:      device.Product,
+					Serial:       device.Serial,
+				})
+			}
+
+			if format == "json" {
+				return utility.PrintJSON(out, summaries)
+			}
+
+			fmt.Fprintf(out, "USB devices on node %s:\n", nodeName)
+			fmt.Fprintf(out, "%-10s %-10s %-6s %s\n", "Port", "ID", "Speed", "Product")
+			fmt.Fprintf(out, "%-10s %-10s %-6s %s\n", "----", "--", "-----", "-------")
+			for _, summary := range summaries {
+				product := strings.TrimSpace(summary.Manufacturer + " " + summary.Product)
+				fmt.Fprintf(out, "%-10s %-10s %-6s %s\n", summary.Port, summary.ID, summary.Speed, product)
+			}
+			if len(summaries) == 0 {
+				fmt.Fprintln(out, "No USB devices found on this node")
+			}
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newHardwareMappingsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mappings",
+		Short: "List cluster hardware mappings",
+		Long: `List the cluster-wide PCI and USB resource mappings. With --check-node,
+Proxmox validates each mapping against that node and reports problems.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			kind, err := cmd.Flags().GetString("type")
+			if err != nil {
+				return fmt.Errorf("get type flag: %w", err)
+			}
+			if kind != "all" && kind != "pci" && kind != "usb" {
+				return fmt.Errorf("invalid type %q; use all, pci, or usb", kind)
+			}
+			checkNode, err := cmd.Flags().GetString("check-node")
+			if err != nil {
+				return fmt.Errorf("get check-node flag: %w", err)
+			}
+			checkNode = strings.TrimSpace(checkNode)
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			ctx := cmd.Context()
+
+			cluster, err := client.Cluster(ctx)
+			if err != nil {
+				return fmt.Errorf("get cluster: %w", err)
+			}
+
+			summaries := []hardwareMappingSummary{}
+			if kind != "usb" {
+				mappings, err := cluster.PCIMappings(ctx, checkNode)
+				if err != nil {
+					return fmt.Errorf("list PCI mappings: %w", err)
+				}
+				for _, mapping := range mappings {
+					summaries = append(summaries, newMappingSummary("pci", mapping.ID, mapping.Description, mapping.Map, mapping.Checks))
+				}
+			}
+			if kind != "pci" {
+				mappings, err := cluster.USBMappings(ctx, checkNode)
+				if err != nil {
+					return fmt.Errorf("list USB mappings: %w", err)
+				}
+				for _, mapping := range mappings {
+					summaries = append(summaries, newMappingSummary("usb", mapping.ID, mapping.Description, mapping.Map, mapping.Error))
+				}
+			}
+
+			if format == "json" {
+				return utility.PrintJSON(out, summaries)
+			}
+
+			fmt.Fprintln(out, "Hardware mappings:")
+			fmt.Fprintf(out, "%-5s %-20s %-24s %s\n", "Type", "ID", "Nodes", "Description")
+			fmt.Fprintf(out, "%-5s %-20s %-24s %s\n", "----", "--", "-----", "-----------")
+			for _, summary := range summaries {
+				fmt.Fprintf(out, "%-5s %-20s %-24s %s\n", summary.Type, summary.ID, strings.Join(summary.Nodes, ","), summary.Description)
+				for _, issue := range summary.Issues {
+					fmt.Fprintf(out, "      ! %s\n", issue)
+				}
+			}
+			if len(summaries) == 0 {
+				fmt.Fprintln(out, "No hardware mappings defined")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String("type", "all", "Mapping type: all, pci, or usb")
+	cmd.Flags().String("check-node", "", "Validate mappings against this node")
+	utility.RegisterNodeFlagCompletion(cmd, "check-node")
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newMappingSummary(kind, id, description string, entries []string, checks []*proxmox.ClusterMappingCheck) hardwareMappingSummary {
+	summary := hardwareMappingSummary{
+		Type:        kind,
+		ID:          id,
+		Description: description,
+		Nodes:       []string{},
+		Map:         entries,
+	}
+	for _, entry := range entries {
+		for _, part := range strings.Split(entry, ",") {
+			if key, value, _ := strings.Cut(part, "="); key == "node" {
+				summary.Nodes = append(summary.Nodes, value)
+			}
+		}
+	}
+	for _, check := range checks {
+		summary.Issues = append(summary.Issues, fmt.Sprintf("%s: %s", check.Severity, check.Message))
+	}
+	return summary
+}
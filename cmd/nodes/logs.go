@@ -0,0 +1,350 @@
+package nodes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// syslogDefaultWindow bounds syslog reads without --since; Proxmox would
+// otherwise page through the whole journal.
+const syslogDefaultWindow = 24 * time.Hour
+
+type logLine struct {
+	Node    string `json:"node"`
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+// logPrinter writes log lines as text or as one JSON object per line,
+// dropping lines that do not match the optional filter.
+type logPrinter struct {
+	out     io.Writer
+	node    string
+	json    bool
+	pattern *regexp.Regexp
+}
+
+func (p *logPrinter) print(number int, message string) error {
+	if p.pattern != nil && !p.pattern.MatchString(message) {
+		return nil
+	}
+	if p.json {
+		return json.NewEncoder(p.out).Encode(logLine{Node: p.node, Line: number, Message: message})
+	}
+	_, err := fmt.Fprintln(p.out, message)
+	return err
+}
+
+type logOptions struct {
+	since    time.Time
+	until    time.Time
+	lines    int
+	follow   bool
+	interval time.Duration
+	printer  *logPrinter
+}
+
+// ranged reports whether --since or --until selected a time window.
+func (o *logOptions) ranged() bool {
+	return !o.since.IsZero() || !o.until.IsZero()
+}
+
+func addLogFlags(cmd *cobra.Command) {
+	addNodeNameFlag(cmd)
+	cmd.Flags().String("since", "", "Start time: a duration ago (15m, 2h) or a date/time (2006-01-02 15:04)")
+	cmd.Flags().String("until", "", "End time, in the same formats as --since")
+	cmd.Flags().Int("lines", 50, "Show at most the last N lines (default: all lines with --since/--until)")
+	cmd.Flags().BoolP("follow", "f", false, "Keep polling for new lines until interrupted")
+	cmd.Flags().Duration("interval", 2*time.Second, "Poll interval for --follow")
+	cmd.Flags().String("grep", "", "Only show lines matching this regular expression")
+	cmd.Flags().StringP("output", "o", "text", "Output format: text or json (one object per line)")
+	_ = cmd.RegisterFlagCompletionFunc("output", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return []string{"text", "json"}, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+func logOptionsFromFlags(cmd *cobra.Command, nodeName string, now time.Time) (*logOptions, error) {
+	flags := cmd.Flags()
+	options := &logOptions{printer: &logPrinter{out: cmd.OutOrStdout(), node: nodeName}}
+	for _, name := range []string{"since", "until"} {
+		value, err := flags.GetString(name)
+		if err != nil {
+			return nil, fmt.Errorf("get %s flag: %w", name, err)
+		}
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		parsed, err := utility.ParseLogTime(value, now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", name, err)
+		}
+		if name == "since" {
+			options.since = parsed
+		} else {
+			options.until = parsed
+		}
+	}
+	if !options.since.IsZero() && !options.until.IsZero() && options.until.Before(options.since) {
+		return nil, fmt.Errorf("--until is before --since")
+	}
+
+	lines, err := flags.GetInt("lines")
+	if err != nil {
+		return nil, fmt.Errorf("get lines flag: %w", err)
+	}
+	if lines < 0 {
+		return nil, fmt.Errorf("--lines cannot be negative")
+	}
+	if options.ranged() && !flags.Changed("lines") {
+		lines = 0
+	}
+	options.lines = lines
+
+	if options.follow, err = flags.GetBool("follow"); err != nil {
+		return nil, fmt.Errorf("get follow flag: %w", err)
+	}
+	if options.follow && !options.until.IsZero() {
+		return nil, fmt.Errorf("--follow cannot be combined with --until")
+	}
+	if options.interval, err = flags.GetDuration("interval"); err != nil {
+		return nil, fmt.Errorf("get interval flag: %w", err)
+	}
+	if options.interval <= 0 {
+		return nil, fmt.Errorf("--interval must be positive")
+	}
+
+	grep, err := flags.GetString("grep")
+	if err != nil {
+		return nil, fmt.Errorf("get grep flag: %w", err)
+	}
+	if grep != "" {
+		if options.printer.pattern, err = regexp.Compile(grep); err != nil {
+			return nil, fmt.Errorf("invalid --grep pattern: %w", err)
+		}
+	}
+	format, err := flags.GetString("output")
+	if err != nil {
+		return nil, fmt.Errorf("get output flag: %w", err)
+	}
+	switch format {
+	case "text":
+	case "json":
+		options.printer.json = true
+	default:
+		return nil, fmt.Errorf("unsupported output format %q; use text or json", format)
+	}
+	return options, nil
+}
+
+// waitPoll sleeps for the poll interval; it reports false once ctx is done.
+func waitPoll(ctx context.Context, interval time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(interval):
+		return true
+	}
+}
+
+func newJournalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "journal",
+		Short: "Show the systemd journal of a node",
+		Long: `Print the node's systemd journal, e.g.:
+
+  proxmox-cli nodes journal -n pve --since 1h --grep pveproxy
+  proxmox-cli nodes journal -n pve -f -o json | jq .message
+
+--follow continues from the journal cursor of the last read, so no line
+is printed twice.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			options, err := logOptionsFromFlags(cmd, nodeName, time.Now())
+			if err != nil {
+				return err
+			}
+			return streamJournal(cmd.Context(), node, nodeName, options)
+		},
+	}
+	addLogFlags(cmd)
+	return cmd
+}
+
+func streamJournal(ctx context.Context, node interfaces.NodeInterface, nodeName string, options *logOptions) error {
+	query := &proxmox.NodeJournalOptions{}
+	if options.ranged() {
+		if !options.since.IsZero() {
+			query.Since = options.since.Unix()
+		}
+		if !options.until.IsZero() {
+			query.Until = options.until.Unix()
+		}
+	} else {
+		if options.lines == 0 {
+			return fmt.Errorf("--lines must be positive without --since or --until")
+		}
+		query.LastEntries = options.lines
+	}
+	raw, err := node.Journal(ctx, query)
+	if err != nil {
+		return fmt.Errorf("read journal of node %q: %w", nodeName, err)
+	}
+	entries, cursor := splitJournal(raw)
+	if options.lines > 0 && len(entries) > options.lines {
+		entries = entries[len(entries)-options.lines:]
+	}
+	for _, entry := range entries {
+		if err := options.printer.print(0, entry); err != nil {
+			return err
+		}
+	}
+
+	for options.follow {
+		polled := time.Now()
+		if !waitPoll(ctx, options.interval) {
+			return nil
+		}
+		query = &proxmox.NodeJournalOptions{StartCursor: cursor}
+		if cursor == "" {
+			query = &proxmox.NodeJournalOptions{Since: polled.Unix()}
+		}
+		raw, err := node.Journal(ctx, query)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("read journal of node %q: %w", nodeName, err)
+		}
+		entries, next := splitJournal(raw)
+		for _, entry := range entries {
+			if err := options.printer.print(0, entry); err != nil {
+				return err
+			}
+		}
+		if next != "" {
+			cursor = next
+		}
+	}
+	return nil
+}
+
+// splitJournal separates the journal lines from the start and end cursors
+// Proxmox sends as the first and last line, returning the end cursor.
+func splitJournal(lines []string) ([]string, string) {
+	if len(lines) > 0 && isJournalCursor(lines[0]) {
+		lines = lines[1:]
+	}
+	cursor := ""
+	if len(lines) > 0 && isJournalCursor(lines[len(lines)-1]) {
+		cursor = lines[len(lines)-1]
+		lines = lines[:len(lines)-1]
+	}
+	return lines, cursor
+}
+
+func isJournalCursor(line string) bool {
+	return strings.HasPrefix(line, "s=") && strings.Contains(line, ";i=")
+}
+
+func newSyslogCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "syslog",
+		Short: "Show the syslog of a node",
+		Long: `Print the node's syslog, optionally for one service, e.g.:
+
+  proxmox-cli nodes syslog -n pve --service pvedaemon --since 30m
+  proxmox-cli nodes syslog -n pve -f --grep error
+
+Without --since the last 24 hours are read. --follow continues after the
+last line number read.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			options, err := logOptionsFromFlags(cmd, nodeName, now)
+			if err != nil {
+				return err
+			}
+			service, err := cmd.Flags().GetString("service")
+			if err != nil {
+				return fmt.Errorf("get service flag: %w", err)
+			}
+			if options.since.IsZero() {
+				options.since = now.Add(-syslogDefaultWindow)
+			}
+			query := proxmox.NodeSyslogOptions{Since: options.since.Format("2006-01-02 15:04:05"), Service: strings.TrimSpace(service)}
+			if !options.until.IsZero() {
+				query.Until = options.until.Format("2006-01-02 15:04:05")
+			}
+			return streamSyslog(cmd.Context(), node, nodeName, query, options)
+		},
+	}
+	addLogFlags(cmd)
+	cmd.Flags().String("service", "", "Only show lines of this service, e.g. pvedaemon")
+	return cmd
+}
+
+func streamSyslog(ctx context.Context, node interfaces.NodeInterface, nodeName string, query proxmox.NodeSyslogOptions, options *logOptions) error {
+	entries, err := readSyslog(ctx, node, query, 0)
+	if err != nil {
+		return fmt.Errorf("read syslog of node %q: %w", nodeName, err)
+	}
+	last := 0
+	if len(entries) > 0 {
+		last = entries[len(entries)-1].N
+	}
+	if options.lines > 0 && len(entries) > options.lines {
+		entries = entries[len(entries)-options.lines:]
+	}
+	for _, entry := range entries {
+		if err := options.printer.print(entry.N, entry.T); err != nil {
+			return err
+		}
+	}
+
+	for options.follow {
+		if !waitPoll(ctx, options.interval) {
+			return nil
+		}
+		entries, err := readSyslog(ctx, node, query, last)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("read syslog of node %q: %w", nodeName, err)
+		}
+		for _, entry := range entries {
+			if err := options.printer.print(entry.N, entry.T); err != nil {
+				return err
+			}
+			last = entry.N
+		}
+	}
+	return nil
+}
+
+// readSyslog returns the syslog lines after line number after.
+func readSyslog(ctx context.Context, node interfaces.NodeInterface, query proxmox.NodeSyslogOptions, after int) ([]*proxmox.LogEntry, error) {
+	return utility.ReadLogPages(ctx, after, func(ctx context.Context, start, limit int) ([]*proxmox.LogEntry, error) {
+		page := query
+		page.Start, page.Limit = start, limit
+		return node.Syslog(ctx, &page)
+	})
+}
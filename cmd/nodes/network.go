@@ -0,0 +1,653 @@
+package nodes
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// networkTypes are the interface types accepted by create and --type.
+// Physical (eth) interfaces can be updated but not created.
+var networkTypes = []string{"bridge", "bond", "vlan", "OVSBridge", "OVSBond", "OVSPort", "OVSIntPort"}
+
+// networkParam maps a typed CLI flag to its /nodes/{node}/network
+// parameter. List parameters accept commas and are sent space-separated.
+type networkParam struct {
+	flag  string
+	api   string
+	kind  string // string, list, int, or bool
+	usage string
+}
+
+var networkParams = []networkParam{
+	{"cidr", "cidr", "string", "IPv4 address in CIDR notation, e.g. 10.0.0.2/24"},
+	{"gateway", "gateway", "string", "IPv4 default gateway"},
+	{"cidr6", "cidr6", "string", "IPv6 address in CIDR notation"},
+	{"gateway6", "gateway6", "string", "IPv6 default gateway"},
+	{"mtu", "mtu", "int", "MTU"},
+	{"autostart", "autostart", "bool", "Bring the interface up at boot"},
+	{"comments", "comments", "string", "Comment"},
+	{"bridge-ports", "bridge_ports", "list", "Bridge ports, e.g. eno1 or bond0"},
+	{"vlan-aware", "bridge_vlan_aware", "bool", "Make the bridge VLAN aware"},
+	{"slaves", "slaves", "list", "Bond members, e.g. eno1,eno2"},
+	{"bond-mode", "bond_mode", "string", "Bond mode, e.g. active-backup or 802.3ad"},
+	{"bond-hash-policy", "bond_xmit_hash_policy", "string", "Bond transmit hash policy: layer2, layer2+3, or layer3+4"},
+	{"bond-primary", "bond-primary", "string", "Primary member for active-backup bonds"},
+	{"vlan-id", "vlan-id", "int", "VLAN tag of a vlan interface"},
+	{"vlan-raw-device", "vlan-raw-device", "string", "Parent device of a vlan interface"},
+	{"ovs-bridge", "ovs_bridge", "string", "OVS bridge an OVS port or bond belongs to"},
+	{"ovs-ports", "ovs_ports", "list", "Ports of an OVS bridge"},
+	{"ovs-bonds", "ovs_bonds", "list", "Members of an OVS bond"},
+	{"ovs-tag", "ovs_tag", "int", "VLAN tag of an OVS port"},
+	{"ovs-options", "ovs_options", "string", "Extra OVS options"},
+}
+
+type networkSummary struct {
+	Iface     string `json:"iface"`
+	Type      string `json:"type"`
+	Active    bool   `json:"active"`
+	Autostart bool   `json:"autostart"`
+	CIDR      string `json:"cidr,omitempty"`
+	Gateway   string `json:"gateway,omitempty"`
+	CIDR6     string `json:"cidr6,omitempty"`
+	Gateway6  string `json:"gateway6,omitempty"`
+	MTU       string `json:"mtu,omitempty"`
+	Ports     string `json:"ports,omitempty"`
+	VLANAware bool   `json:"vlan_aware,omitempty"`
+	VLANID    string `json:"vlan_id,omitempty"`
+	VLANRaw   string `json:"vlan_raw_device,omitempty"`
+	BondMode  string `json:"bond_mode,omitempty"`
+	OVSBridge string `json:"ovs_bridge,omitempty"`
+	Comments  string `json:"comments,omitempty"`
+}
+
+func newNetworkSummary(network *proxmox.NodeNetwork) networkSummary {
+	cidr := network.CIDR
+	if cidr == "" && network.Address != "" {
+		cidr = network.Address
+	}
+	ports := network.BridgePorts
+	for _, candidate := range []string{network.Slaves, network.OVSPorts, network.OVSBonds} {
+		if ports == "" {
+			ports = candidate
+		}
+	}
+	return networkSummary{
+		Iface:     network.Iface,
+		Type:      network.Type,
+		Active:    network.Active == 1,
+		Autostart: network.Autostart == 1,
+		CIDR:      cidr,
+		Gateway:   network.Gateway,
+		CIDR6:     network.CIDR6,
+		Gateway6:  network.Gateway6,
+		MTU:       network.MTU,
+		Ports:     ports,
+		VLANAware: network.BridgeVLANAware == 1,
+		VLANID:    network.VLANID,
+		VLANRaw:   network.VLANRawDevice,
+		BondMode:  network.BondMode,
+		OVSBridge: network.OVSBridge,
+		Comments:  strings.TrimSpace(network.Comments),
+	}
+}
+
+func newNetworkCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "network",
+		Short: "Manage node network configuration",
+		Long: `List and change the bridges, bonds, VLANs, and OVS objects of a node.
+
+create, update, and delete only stage changes in the pending interfaces
+file. Review them with 'nodes network diff', then activate them with
+'nodes network apply' or discard them with 'nodes network revert'.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(
+		newNetworkListCmd(),
+		newNetworkShowCmd(),
+		newNetworkCreateCmd(),
+		newNetworkUpdateCmd(),
+		newNetworkDeleteCmd(),
+		newNetworkDiffCmd(),
+		newNetworkApplyCmd(),
+		newNetworkRevertCmd(),
+	)
+	return cmd
+}
+
+func newNetworkListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List network interfaces of a node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			ifaceType, err := cmd.Flags().GetString("type")
+			if err != nil {
+				return fmt.Errorf("get type flag: %w", err)
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			var filter []string
+			if ifaceType = strings.TrimSpace(ifaceType); ifaceType != "" {
+				filter = append(filter, ifaceType)
+			}
+			networks, err := node.Networks(cmd.Context(), filter...)
+			if err != nil {
+				return fmt.Errorf("list networks on node %q: %w", nodeName, err)
+			}
+			summaries := make([]networkSummary, 0, len(networks))
+			for _, network := range networks {
+				summaries = append(summaries, newNetworkSummary(network))
+			}
+			sort.Slice(summaries, func(i, j int) bool { return summaries[i].Iface < summaries[j].Iface })
+
+			if format == "json" {
+				return utility.PrintJSON(out, summaries)
+			}
+			printNetworkTable(out, nodeName, summaries)
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	cmd.Flags().String("type", "", "Only list this type, e.g. bridge, bond, vlan, any_bridge")
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func printNetworkTable(out io.Writer, nodeName string, summaries []networkSummary) {
+	fmt.Fprintf(out, "Network interfaces on %s:\n", nodeName)
+	fmt.Fprintf(out, "%-14s %-11s %-7s %-10s %-20s %-16s %-18s %s\n", "Interface", "Type", "Active", "Autostart", "CIDR", "Gateway", "Ports/Members", "Comments")
+	fmt.Fprintf(out, "%-14s %-11s %-7s %-10s %-20s %-16s %-18s %s\n", "---------", "----", "------", "---------", "----", "-------", "-------------", "--------")
+	for _, summary := range summaries {
+		fmt.Fprintf(out, "%-14s %-11s %-7s %-10s %-20s %-16s %-18s %s\n",
+			summary.Iface, summary.Type, utility.YesNo(summary.Active), utility.YesNo(summary.Autostart),
+			utility.DashIfEmpty(summary.CIDR), utility.DashIfEmpty(summary.Gateway), utility.DashIfEmpty(summary.Ports), summary.Comments)
+	}
+	if len(summaries) == 0 {
+		fmt.Fprintln(out, "No network interfaces found")
+	}
+}
+
+func newNetworkShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show one network interface of a node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			iface, err := ifaceFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			network, err := node.Network(cmd.Context(), iface)
+			if err != nil {
+				return fmt.Errorf("get interface %q on node %q: %w", iface, nodeName, err)
+			}
+			summary := newNetworkSummary(network)
+
+			if format == "json" {
+				return utility.PrintJSON(out, summary)
+			}
+			rows := [][2]string{
+				{"Interface", summary.Iface},
+				{"Type", summary.Type},
+				{"Active", utility.YesNo(summary.Active)},
+				{"Autostart", utility.YesNo(summary.Autostart)},
+				{"CIDR", summary.CIDR},
+				{"Gateway", summary.Gateway},
+				{"CIDR6", summary.CIDR6},
+				{"Gateway6", summary.Gateway6},
+				{"MTU", summary.MTU},
+				{"Ports/Members", summary.Ports},
+				{"VLAN ID", summary.VLANID},
+				{"VLAN Raw Device", summary.VLANRaw},
+				{"Bond Mode", summary.BondMode},
+				{"OVS Bridge", summary.OVSBridge},
+				{"Comments", summary.Comments},
+			}
+			if summary.Type == "bridge" {
+				rows = append(rows, [2]string{"VLAN Aware", utility.YesNo(summary.VLANAware)})
+			}
+			for _, row := range rows {
+				if row[1] != "" {
+					fmt.Fprintf(out, "%-16s %s\n", row[0]+":", row[1])
+				}
+			}
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	addIfaceFlag(cmd)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func addIfaceFlag(cmd *cobra.Command) {
+	cmd.Flags().String("iface", "", "Interface name, e.g. vmbr1")
+	if err := cmd.MarkFlagRequired("iface"); err != nil {
+		panic(err)
+	}
+}
+
+func ifaceFromFlags(cmd *cobra.Command) (string, error) {
+	iface, err := cmd.Flags().GetString("iface")
+	if err != nil {
+		return "", fmt.Errorf("get iface flag: %w", err)
+	}
+	iface = strings.TrimSpace(iface)
+	if iface == "" {
+		return "", fmt.Errorf("interface name cannot be empty")
+	}
+	return iface, nil
+}
+
+func addNetworkParamFlags(cmd *cobra.Command) {
+	for _, param := range networkParams {
+		switch param.kind {
+		case "int":
+			cmd.Flags().Int(param.flag, 0, param.usage)
+		case "bool":
+			cmd.Flags().Bool(param.flag, false, param.usage)
+		default:
+			cmd.Flags().String(param.flag, "", param.usage)
+		}
+	}
+}
+
+// networkParamsFromFlags collects the explicitly set parameter flags into
+// API parameters.
+func networkParamsFromFlags(cmd *cobra.Command) (map[string]any, error) {
+	params := map[string]any{}
+	for _, param := range networkParams {
+		if !cmd.Flags().Changed(param.flag) {
+			continue
+		}
+		switch param.kind {
+		case "int":
+			value, err := cmd.Flags().GetInt(param.flag)
+			if err != nil {
+				return nil, fmt.Errorf("get %s flag: %w", param.flag, err)
+			}
+			params[param.api] = value
+		case "bool":
+			value, err := cmd.Flags().GetBool(param.flag)
+			if err != nil {
+				return nil, fmt.Errorf("get %s flag: %w", param.flag, err)
+			}
+			params[param.api] = 0
+			if value {
+				params[param.api] = 1
+			}
+		default:
+			value, err := cmd.Flags().GetString(param.flag)
+			if err != nil {
+				return nil, fmt.Errorf("get %s flag: %w", param.flag, err)
+			}
+			value = strings.TrimSpace(value)
+			if param.kind == "list" {
+				value = strings.Join(strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' }), " ")
+			}
+			params[param.api] = value
+		}
+	}
+	return params, nil
+}
+
+// validateNetworkParams checks the parameters a new interface of the given
+// type needs before Proxmox sees them.
+func validateNetworkParams(iface, ifaceType string, params map[string]any) error {
+	has := func(key string) bool {
+		value, ok := params[key]
+		return ok && value != "" && value != 0
+	}
+	switch ifaceType {
+	case "bond":
+		if !has("slaves") {
+			return fmt.Errorf("a bond needs --slaves")
+		}
+	case "vlan":
+		// eno1.20 style names carry the parent and tag themselves.
+		if _, tag, found := strings.Cut(iface, "."); found {
+			if _, err := strconv.Atoi(tag); err == nil {
+				return nil
+			}
+		}
+		if !has("vlan-id") || !has("vlan-raw-device") {
+			return fmt.Errorf("a vlan needs a name like eno1.20, or --vlan-id and --vlan-raw-device")
+		}
+	case "OVSPort", "OVSIntPort", "OVSBond":
+		if !has("ovs_bridge") {
+			return fmt.Errorf("an %s needs --ovs-bridge", ifaceType)
+		}
+	}
+	if mtu, ok := params["mtu"].(int); ok && mtu != 0 && (mtu < 1280 || mtu > 65520) {
+		return fmt.Errorf("MTU must be between 1280 and 65520")
+	}
+	return nil
+}
+
+func printStagedHint(out io.Writer, nodeName string) {
+	fmt.Fprintf(out, "Review with 'proxmox-cli nodes network diff -n %s' and activate with 'proxmox-cli nodes network apply -n %s'\n", nodeName, nodeName)
+}
+
+func newNetworkCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Stage a new network interface on a node",
+		Long: `Stage a new bridge, bond, VLAN, or OVS object, e.g.:
+
+  proxmox-cli nodes network create -n pve --iface vmbr1 --type bridge --bridge-ports eno2 --vlan-aware
+  proxmox-cli nodes network create -n pve --iface bond0 --type bond --slaves eno1,eno2 --bond-mode 802.3ad
+  proxmox-cli nodes network create -n pve --iface vmbr0.20 --type vlan --cidr 10.0.20.2/24`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			iface, err := ifaceFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			ifaceType, err := cmd.Flags().GetString("type")
+			if err != nil {
+				return fmt.Errorf("get type flag: %w", err)
+			}
+			if !isNetworkType(ifaceType) {
+				return fmt.Errorf("unsupported interface type %q; use %s", ifaceType, strings.Join(networkTypes, ", "))
+			}
+			params, err := networkParamsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := validateNetworkParams(iface, ifaceType, params); err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed("autostart") {
+				params["autostart"] = 1
+			}
+			params["iface"] = iface
+			params["type"] = ifaceType
+
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			networks, err := node.Networks(ctx)
+			if err != nil {
+				return fmt.Errorf("list networks on node %q: %w", nodeName, err)
+			}
+			for _, network := range networks {
+				if network.Iface == iface {
+					return fmt.Errorf("interface %q already exists on node %q; use 'nodes network update'", iface, nodeName)
+				}
+			}
+
+			if err := node.CreateNetwork(ctx, params); err != nil {
+				return fmt.Errorf("create interface %q on node %q: %w", iface, nodeName, err)
+			}
+			fmt.Fprintf(out, "Interface %s staged on node %s\n", iface, nodeName)
+			printStagedHint(out, nodeName)
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	addIfaceFlag(cmd)
+	cmd.Flags().String("type", "", "Interface type: "+strings.Join(networkTypes, ", "))
+	addNetworkParamFlags(cmd)
+	if err := cmd.MarkFlagRequired("type"); err != nil {
+		panic(err)
+	}
+	_ = cmd.RegisterFlagCompletionFunc("type", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return networkTypes, cobra.ShellCompDirectiveNoFileComp
+	})
+	return cmd
+}
+
+func isNetworkType(value string) bool {
+	for _, ifaceType := range networkTypes {
+		if value == ifaceType {
+			return true
+		}
+	}
+	return false
+}
+
+func newNetworkUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Stage changes to a network interface",
+		Long: `Stage changes to an existing interface; only the flags given are changed,
+and --delete clears options, e.g.:
+
+  proxmox-cli nodes network update -n pve --iface vmbr0 --mtu 9000
+  proxmox-cli nodes network update -n pve --iface vmbr1 --delete cidr,gateway`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			iface, err := ifaceFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			params, err := networkParamsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			deleted, err := cmd.Flags().GetStringSlice("delete")
+			if err != nil {
+				return fmt.Errorf("get delete flag: %w", err)
+			}
+			deleteKeys := make([]string, 0, len(deleted))
+			for _, name := range deleted {
+				param, ok := lookupNetworkParam(strings.TrimSpace(name))
+				if !ok {
+					return fmt.Errorf("unknown option %q in --delete", name)
+				}
+				if _, set := params[param.api]; set {
+					return fmt.Errorf("--%s cannot be both set and deleted", param.flag)
+				}
+				deleteKeys = append(deleteKeys, param.api)
+			}
+			if len(params) == 0 && len(deleteKeys) == 0 {
+				return fmt.Errorf("nothing to do; pass at least one option flag or --delete")
+			}
+
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			// Proxmox requires the type on every update.
+			current, err := node.Network(ctx, iface)
+			if err != nil {
+				return fmt.Errorf("get interface %q on node %q: %w", iface, nodeName, err)
+			}
+			params["type"] = current.Type
+			if len(deleteKeys) > 0 {
+				params["delete"] = strings.Join(deleteKeys, ",")
+			}
+
+			if err := node.UpdateNetwork(ctx, iface, params); err != nil {
+				return fmt.Errorf("update interface %q on node %q: %w", iface, nodeName, err)
+			}
+			fmt.Fprintf(out, "Changes to %s staged on node %s\n", iface, nodeName)
+			printStagedHint(out, nodeName)
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	addIfaceFlag(cmd)
+	addNetworkParamFlags(cmd)
+	cmd.Flags().StringSlice("delete", nil, "Options to clear, by flag name, e.g. cidr,gateway")
+	return cmd
+}
+
+func lookupNetworkParam(flag string) (networkParam, bool) {
+	for _, param := range networkParams {
+		if param.flag == flag {
+			return param, true
+		}
+	}
+	return networkParam{}, false
+}
+
+func newNetworkDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Stage the removal of a network interface",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			iface, err := ifaceFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Stage removal of interface %s on node %s?", iface, nodeName)); err != nil {
+				return err
+			}
+			if err := node.DeleteNetwork(cmd.Context(), iface); err != nil {
+				return fmt.Errorf("delete interface %q on node %q: %w", iface, nodeName, err)
+			}
+			fmt.Fprintf(out, "Removal of %s staged on node %s\n", iface, nodeName)
+			printStagedHint(out, nodeName)
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	addIfaceFlag(cmd)
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func newNetworkDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show pending network changes of a node",
+		Long:  `Print the diff between the active and the pending interfaces file.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			changes, err := node.NetworkChanges(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("get pending network changes on node %q: %w", nodeName, err)
+			}
+			if strings.TrimSpace(changes) == "" {
+				fmt.Fprintf(out, "No pending network changes on node %s\n", nodeName)
+				return nil
+			}
+			fmt.Fprint(out, changes)
+			if !strings.HasSuffix(changes, "\n") {
+				fmt.Fprintln(out)
+			}
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	return cmd
+}
+
+func newNetworkApplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Apply pending network changes of a node",
+		Long: `Reload the node's network with the pending interfaces file. A wrong
+change can cut the node off the network, so review it with
+'nodes network diff' first.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			changes, err := node.NetworkChanges(ctx)
+			if err != nil {
+				return fmt.Errorf("get pending network changes on node %q: %w", nodeName, err)
+			}
+			if strings.TrimSpace(changes) == "" {
+				fmt.Fprintf(out, "No pending network changes on node %s\n", nodeName)
+				return nil
+			}
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Apply pending network changes on node %s?", nodeName)); err != nil {
+				return err
+			}
+
+			task, err := node.ApplyNetwork(ctx)
+			if err != nil {
+				return fmt.Errorf("apply network changes on node %q: %w", nodeName, err)
+			}
+			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+				return fmt.Errorf("apply network changes on node %q: %w", nodeName, err)
+			}
+			fmt.Fprintf(out, "Network changes applied on node %s\n", nodeName)
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func newNetworkRevertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revert",
+		Short: "Discard pending network changes of a node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Discard all pending network changes on node %s?", nodeName)); err != nil {
+				return err
+			}
+			if err := node.RevertNetwork(cmd.Context()); err != nil {
+				return fmt.Errorf("revert network changes on node %q: %w", nodeName, err)
+			}
+			fmt.Fprintf(out, "Pending network changes discarded on node %s\n", nodeName)
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	utility.AddYesFlag(cmd)
+	return cmd
+}
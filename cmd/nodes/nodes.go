@@ -1,6 +1,11 @@
 package nodes
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
 	"github.com/spf13/cobra"
 )
 
@@ -17,6 +22,60 @@ func NewCmd() *cobra.Command {
 	cmd.AddCommand(newStorageCmd())
 	cmd.AddCommand(newTasksCmd())
 	cmd.AddCommand(newStatsCmd())
+	cmd.AddCommand(newHardwareCmd())
+	cmd.AddCommand(newNetworkCmd())
+	cmd.AddCommand(newServicesCmd())
+	cmd.AddCommand(newUpdatesCmd())
+	cmd.AddCommand(newVersionsCmd())
+	cmd.AddCommand(newJournalCmd())
+	cmd.AddCommand(newSyslogCmd())
+	cmd.AddCommand(newDisksCmd())
+	cmd.AddCommand(newCertificatesCmd())
+	cmd.AddCommand(newShellCmd())
+	cmd.AddCommand(newEvacuateCmd())
+	cmd.AddCommand(newReturnCmd())
+	cmd.AddCommand(newGuestsCmd())
+	cmd.AddCommand(newPowerCmd("reboot"))
+	cmd.AddCommand(newPowerCmd("shutdown"))
+	cmd.AddCommand(newWakeCmd())
 
 	return cmd
 }
+
+func nodeNameFromFlags(cmd *cobra.Command) (string, error) {
+	nodeName, err := cmd.Flags().GetString("node")
+	if err != nil {
+		return "", fmt.Errorf("get node flag: %w", err)
+	}
+	nodeName = strings.TrimSpace(nodeName)
+	if nodeName == "" {
+		return "", fmt.Errorf("node cannot be empty")
+	}
+	return nodeName, nil
+}
+
+func addNodeNameFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("node", "n", "", "Node name")
+	if err := cmd.MarkFlagRequired("node"); err != nil {
+		panic(err)
+	}
+	utility.RegisterNodeFlagCompletion(cmd, "node")
+}
+
+// nodeFromFlags resolves the node named by --node with an authenticated
+// client.
+func nodeFromFlags(cmd *cobra.Command) (interfaces.NodeInterface, string, error) {
+	nodeName, err := nodeNameFromFlags(cmd)
+	if err != nil {
+		return nil, "", err
+	}
+	client, err := utility.AuthenticatedClient()
+	if err != nil {
+		return nil, "", fmt.Errorf("authenticate Proxmox client: %w", err)
+	}
+	node, err := client.Node(cmd.Context(), nodeName)
+	if err != nil {
+		return nil, "", fmt.Errorf("get node %q: %w", nodeName, err)
+	}
+	return node, nodeName, nil
+}
@@ -2,9 +2,24 @@ package nodes
 
 import (
 	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/luthermonson/go-proxmox"
 	"github.com/spf13/viper"
@@ -92,3 +107,946 @@ func TestGetRejectsUnknownOutputFormat(t *testing.T) {
 		t.Fatalf("expected unsupported-format error, got %v", err)
 	}
 }
+
+func TestHardwarePCIListsMdevTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().ListPCIDevices(ctx, gomock.Nil()).Return([]*proxmox.PCIDevice{
+		{ID: "0000:01:00.0", IOMMUGroup: 14, Vendor: "0x10de", VendorName: "NVIDIA", Device: "0x1eb8", DeviceName: "Tesla T4", MdevCapable: true},
+		{ID: "0000:00:1f.3", IOMMUGroup: 9, Vendor: "0x8086", VendorName: "Intel", Device: "0xa348", DeviceName: "Audio"},
+	}, nil)
+	node.EXPECT().PCIMdevTypes(ctx, "0000:01:00.0").Return([]*proxmox.PCIMdevType{
+		{Type: "nvidia-222", Name: "GRID T4-1B", Available: 16},
+	}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"hardware", "pci", "-n", "pve", "--mdev"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	output := out.String()
+	for _, want := range []string{"0000:00:1f.3", "10de:1eb8", "NVIDIA Tesla T4", "mdev nvidia-222 (GRID T4-1B, 16 available)"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+	if strings.Index(output, "0000:00:1f.3") > strings.Index(output, "0000:01:00.0") {
+		t.Errorf("devices not sorted by ID:\n%s", output)
+	}
+}
+
+func TestHardwareMappingsJSON(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	cluster := mocks.NewMockClusterInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Cluster(ctx).Return(cluster, nil)
+	cluster.EXPECT().PCIMappings(ctx, "pve1").Return(proxmox.ClusterPCIMappings{{
+		ID:     "gpu0",
+		Map:    []string{"id=10de:1eb8,iommugroup=14,node=pve1,path=0000:01:00.0", "id=10de:1eb8,node=pve2,path=0000:02:00.0"},
+		Checks: []*proxmox.ClusterMappingCheck{{Severity: "warning", Message: "IOMMU group changed"}},
+	}}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"hardware", "mappings", "--type", "pci", "--check-node", "pve1", "-o", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	var mappings []hardwareMappingSummary
+	if err := json.Unmarshal(out.Bytes(), &mappings); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out.String())
+	}
+	if len(mappings) != 1 || strings.Join(mappings[0].Nodes, ",") != "pve1,pve2" || len(mappings[0].Issues) != 1 {
+		t.Fatalf("unexpected mappings: %+v", mappings)
+	}
+}
+
+func TestNetworkCreateStagesBond(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Networks(ctx).Return(proxmox.NodeNetworks{{Iface: "eno1", Type: "eth"}, {Iface: "eno2", Type: "eth"}}, nil)
+	node.EXPECT().CreateNetwork(ctx, map[string]any{
+		"iface":     "bond0",
+		"type":      "bond",
+		"slaves":    "eno1 eno2",
+		"bond_mode": "802.3ad",
+		"mtu":       9000,
+		"autostart": 1,
+	}).Return(nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"network", "create", "-n", "pve", "--iface", "bond0", "--type", "bond", "--slaves", "eno1,eno2", "--bond-mode", "802.3ad", "--mtu", "9000"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "nodes network apply -n pve") {
+		t.Errorf("output missing apply hint:\n%s", out.String())
+	}
+}
+
+func TestNetworkCreateRejectsBondWithoutSlaves(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	setupAuthenticatedMocks(t, ctrl)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"network", "create", "-n", "pve", "--iface", "bond0", "--type", "bond"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "--slaves") {
+		t.Fatalf("expected missing slaves error, got %v", err)
+	}
+}
+
+func TestNetworkUpdateSendsTypeAndDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Network(ctx, "vmbr1").Return(&proxmox.NodeNetwork{Iface: "vmbr1", Type: "bridge"}, nil)
+	node.EXPECT().UpdateNetwork(ctx, "vmbr1", map[string]any{
+		"type":              "bridge",
+		"bridge_vlan_aware": 1,
+		"delete":            "cidr,gateway",
+	}).Return(nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"network", "update", "-n", "pve", "--iface", "vmbr1", "--vlan-aware", "--delete", "cidr,gateway"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNetworkDiffPrintsPendingChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil).Times(2)
+	node.EXPECT().NetworkChanges(ctx).Return("+auto vmbr1\n+iface vmbr1 inet manual\n", nil)
+	node.EXPECT().NetworkChanges(ctx).Return("", nil)
+
+	for _, want := range []string{"+iface vmbr1 inet manual", "No pending network changes on node pve"} {
+		cmd := NewCmd()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs([]string{"network", "diff", "-n", "pve"})
+		if err := cmd.Execute(); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestServicesListShowsState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Services(ctx).Return([]*proxmox.NodeService{
+		{Service: "pveproxy", Status: "running", ActiveState: "active", UnitState: "enabled", Desc: "PVE API Proxy Server"},
+		{Service: "corosync", Status: "stopped", ActiveState: "failed", UnitState: "enabled", Desc: "Corosync Cluster Engine"},
+	}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"services", "list", "-n", "pve"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	output := out.String()
+	for _, want := range []string{"PVE API Proxy Server", "failed"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+	if strings.Index(output, "corosync") > strings.Index(output, "pveproxy") {
+		t.Errorf("services not sorted:\n%s", output)
+	}
+}
+
+func TestServiceRestartConfirmsDisruptiveServices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil).Times(2)
+	node.EXPECT().Services(ctx).Return([]*proxmox.NodeService{{Service: "corosync"}, {Service: "pvestatd"}}, nil).Times(2)
+	node.EXPECT().ServiceAction(ctx, "pvestatd", "restart").Return(&proxmox.Task{IsSuccessful: true}, nil)
+
+	// Declining the prompt must not touch corosync.
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetIn(strings.NewReader("n\n"))
+	cmd.SetArgs([]string{"services", "restart", "corosync", "-n", "pve"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "aborted") {
+		t.Fatalf("expected aborted restart, got %v", err)
+	}
+
+	cmd = NewCmd()
+	out.Reset()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"services", "restart", "pvestatd", "-n", "pve"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "[y/N]") {
+		t.Errorf("pvestatd restart should not prompt:\n%s", out.String())
+	}
+}
+
+func TestUpdatesListShowsVersionChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().APTUpdates(ctx).Return([]*proxmox.APTUpdate{
+		{Package: "pve-manager", OldVersion: "8.2.2", Version: "8.2.4", Origin: "Proxmox", Priority: "optional"},
+	}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"updates", "list", "-n", "pve"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"8.2.2 -> 8.2.4", "Proxmox", "1 pending update(s)"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestVersionsAllNodesMarksSkew(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	pve1 := mocks.NewMockNodeInterface(ctrl)
+	pve2 := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Nodes(ctx).Return(proxmox.NodeStatuses{
+		{Node: "pve2", Status: "online"},
+		{Node: "pve1", Status: "online"},
+		{Node: "pve3", Status: "offline"},
+	}, nil)
+	client.EXPECT().Node(ctx, "pve1").Return(pve1, nil)
+	client.EXPECT().Node(ctx, "pve2").Return(pve2, nil)
+	pve1.EXPECT().APTVersions(ctx).Return([]*proxmox.APTPackageVersion{
+		{Package: "qemu-server", OldVersion: "8.2.1", CurrentState: "Installed"},
+		{Package: "pve-manager", OldVersion: "8.2.2", ManagerVersion: "8.2.2/9355359cd7afbae4", CurrentState: "Installed"},
+	}, nil)
+	pve2.EXPECT().APTVersions(ctx).Return([]*proxmox.APTPackageVersion{
+		{Package: "qemu-server", OldVersion: "8.2.3", CurrentState: "Installed"},
+		{Package: "pve-manager", OldVersion: "8.2.2", ManagerVersion: "8.2.2/9355359cd7afbae4", CurrentState: "Installed"},
+	}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"versions", "--all-nodes", "-o", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	var result clusterVersions
+	if err := json.Unmarshal(out.Bytes(), &result); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out.String())
+	}
+	if strings.Join(result.Nodes, ",") != "pve1,pve2" {
+		t.Fatalf("nodes = %v, want online nodes sorted", result.Nodes)
+	}
+	if len(result.Packages) != 2 || result.Packages[0].Package != "pve-manager" || result.Packages[0].Skew || !result.Packages[1].Skew {
+		t.Fatalf("unexpected packages: %+v", result.Packages)
+	}
+}
+
+func TestVersionsRequiresNodeOrAllNodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	setupAuthenticatedMocks(t, ctrl)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"versions"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "--all-nodes") {
+		t.Fatalf("expected missing target error, got %v", err)
+	}
+}
+
+func TestJournalFollowContinuesFromCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	anyArg := gomock.Any()
+	client.EXPECT().Node(anyArg, "pve").Return(node, nil)
+	gomock.InOrder(
+		node.EXPECT().Journal(anyArg, &proxmox.NodeJournalOptions{LastEntries: 2}).Return([]string{
+			"s=abc;i=1", "Oct 19 10:00:00 pve pveproxy[1]: worker started", "Oct 19 10:00:01 pve cron[2]: job ran", "s=abc;i=3",
+		}, nil),
+		node.EXPECT().Journal(anyArg, &proxmox.NodeJournalOptions{StartCursor: "s=abc;i=3"}).DoAndReturn(
+			func(context.Context, *proxmox.NodeJournalOptions) ([]string, error) {
+				cancel()
+				return []string{"s=abc;i=3", "Oct 19 10:00:05 pve pveproxy[1]: worker finished", "s=abc;i=4"}, nil
+			}),
+	)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"journal", "-n", "pve", "--lines", "2", "-f", "--interval", "1ms", "--grep", "pveproxy", "-o", "json"})
+	if err := cmd.ExecuteContext(ctx); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 matching lines, got:\n%s", out.String())
+	}
+	var last logLine
+	if err := json.Unmarshal([]byte(lines[1]), &last); err != nil {
+		t.Fatalf("invalid JSON line %q: %v", lines[1], err)
+	}
+	if last.Node != "pve" || !strings.Contains(last.Message, "worker finished") {
+		t.Fatalf("unexpected last line: %+v", last)
+	}
+}
+
+func TestSyslogPagesThroughWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	full := make([]*proxmox.LogEntry, utility.LogPageSize)
+	for i := range full {
+		full[i] = &proxmox.LogEntry{N: i + 1, T: "line"}
+	}
+	anyArg := gomock.Any()
+	client.EXPECT().Node(anyArg, "pve").Return(node, nil)
+	gomock.InOrder(
+		node.EXPECT().Syslog(anyArg, gomock.Any()).DoAndReturn(func(_ context.Context, options *proxmox.NodeSyslogOptions) ([]*proxmox.LogEntry, error) {
+			if options.Start != 0 || options.Service != "pvedaemon" || options.Since != "2026-10-19 09:30:00" {
+				t.Errorf("unexpected first query: %+v", options)
+			}
+			return full, nil
+		}),
+		node.EXPECT().Syslog(anyArg, gomock.Any()).DoAndReturn(func(_ context.Context, options *proxmox.NodeSyslogOptions) ([]*proxmox.LogEntry, error) {
+			if options.Start != utility.LogPageSize {
+				t.Errorf("second page starts at %d, want %d", options.Start, utility.LogPageSize)
+			}
+			return []*proxmox.LogEntry{{N: utility.LogPageSize + 1, T: "newest line"}}, nil
+		}),
+	)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"syslog", "-n", "pve", "--service", "pvedaemon", "--since", "2026-10-19 09:30", "--lines", "2"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "line\nnewest line\n" {
+		t.Fatalf("expected the last 2 lines, got:\n%s", out.String())
+	}
+}
+
+func TestDisksListShowsInventory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Disks(ctx, false, false, "").Return([]*proxmox.Disk{
+		{DevPath: "/dev/sdb", Type: "ssd", Size: 480 << 30, Model: "Samsung_SSD_870", Serial: "S6PNNX0T", Wearout: "97", Health: "PASSED"},
+		{DevPath: "/dev/nvme0n1", Type: "nvme", Size: 1 << 40, Model: "WD_BLACK", Health: "PASSED", Used: "LVM", GPT: true},
+	}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"disks", "list", "-n", "pve"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	output := out.String()
+	for _, want := range []string{"Samsung_SSD_870", "97%", "480.0 GiB", "unused", "LVM"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+}
+
+func TestDisksCreateZFSRequiresTypedDevices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil).Times(2)
+	node.EXPECT().Disks(ctx, false, true, "").Return([]*proxmox.Disk{{DevPath: "/dev/sdb"}, {DevPath: "/dev/sdc"}}, nil).Times(2)
+	node.EXPECT().NewZFSPool(ctx, &proxmox.NodeZFSPoolOptions{
+		Name: "tank", Devices: "/dev/sdb /dev/sdc", RaidLevel: "mirror", Ashift: 12, Compression: "on", AddStorage: true,
+	}).Return(&proxmox.Task{IsSuccessful: true}, nil)
+
+	args := []string{"disks", "create-zfs", "-n", "pve", "--name", "tank", "--raid", "mirror", "--devices", "sdb,/dev/sdc"}
+	for _, input := range []string{"y\n", "/dev/sdb,/dev/sdc\n"} {
+		cmd := NewCmd()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetIn(strings.NewReader(input))
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		if input == "y\n" {
+			if err == nil || !strings.Contains(err.Error(), "aborted") {
+				t.Fatalf("expected a plain yes to abort, got %v", err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out.String(), "ZFS pool tank created on node pve") {
+			t.Errorf("unexpected output:\n%s", out.String())
+		}
+	}
+}
+
+func TestDisksInitGPTRefusesDiskInUse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Disks(ctx, false, true, "").Return([]*proxmox.Disk{{DevPath: "/dev/sda", Used: "BIOS boot"}}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"disks", "init-gpt", "--device", "sda", "-n", "pve", "--confirm", "/dev/sda"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "in use (BIOS boot)") {
+		t.Fatalf("expected in-use error, got %v", err)
+	}
+}
+
+func TestValidateZFSLayout(t *testing.T) {
+	for _, tc := range []struct {
+		raid    string
+		devices int
+		ok      bool
+	}{
+		{"single", 1, true}, {"single", 2, false}, {"mirror", 1, false}, {"mirror", 3, true},
+		{"raid10", 4, true}, {"raid10", 5, false}, {"raidz2", 3, false}, {"raid5", 3, false},
+	} {
+		if err := validateZFSLayout(tc.raid, tc.devices); (err == nil) != tc.ok {
+			t.Errorf("validateZFSLayout(%q, %d) = %v, want ok=%v", tc.raid, tc.devices, err, tc.ok)
+		}
+	}
+}
+
+func selfSignedPEM(t *testing.T, name string, notAfter time.Time) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    notAfter.Add(-90 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestCertificatesListWarnDaysFailsOnExpiringCertificate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Certificates(ctx).Return(proxmox.NodeCertificates{
+		{Filename: "pve-root-ca.pem", Subject: "CN=Proxmox Virtual Environment", Pem: selfSignedPEM(t, "ca", time.Now().Add(3650*24*time.Hour))},
+		{Filename: "pveproxy-ssl.pem", Subject: "CN=pve.example.com", Pem: selfSignedPEM(t, "pve.example.com", time.Now().Add(5*24*time.Hour+time.Hour))},
+	}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"certificates", "list", "-n", "pve", "--warn-days", "14"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "pveproxy-ssl.pem (5 days remaining)") || strings.Contains(err.Error(), "pve-root-ca.pem") {
+		t.Fatalf("expected expiry error for pveproxy-ssl.pem only, got %v", err)
+	}
+	if !strings.Contains(out.String(), "CN=pve.example.com") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestACMEDomainAddUsesFreeSlot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Config(ctx).Return(&proxmox.NodeConfig{AcmeDomain0: "domain=a.example.com", AcmeDomain2: "domain=c.example.com", Digest: "abc"}, nil)
+	node.EXPECT().UpdateConfig(ctx, &proxmox.NodeConfigOptions{AcmeDomain1: "domain=b.example.com,plugin=cf", Digest: "abc"}).Return(nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"certificates", "acme", "domain", "add", "-n", "pve", "--domain", "b.example.com", "--plugin", "cf"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+}
+
+func TestACMEOrderKeepsDomainsWhenSettingAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().Config(ctx).Return(&proxmox.NodeConfig{Acme: "account=old,domains=a.example.com;b.example.com", Digest: "abc"}, nil)
+	node.EXPECT().UpdateConfig(ctx, &proxmox.NodeConfigOptions{Acme: "account=new,domains=a.example.com;b.example.com", Digest: "abc"}).Return(nil)
+	node.EXPECT().OrderACMECertificate(ctx, false).Return(nil, errors.New("order failed"))
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"certificates", "acme", "order", "-n", "pve", "--account", "new"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "order failed") {
+		t.Fatalf("expected order error, got %v", err)
+	}
+}
+
+func TestShellRequiresSessionTicket(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set("server_url", "https://pve.example.com:8006")
+	viper.Set("api_token.token_id", "root@pam!ci")
+	viper.Set("api_token.secret", "secret")
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"shell", "-n", "pve"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "API tokens cannot open websockets") {
+		t.Fatalf("expected session-ticket requirement error, got %v", err)
+	}
+}
+
+func TestShellPassesCommandToTermProxy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	term := &proxmox.Term{Port: 5900, Ticket: "ticket", User: "root@pam"}
+	closed := false
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().TermProxy(ctx, proxmox.NodeConsoleUpgrade, "").Return(term, nil)
+	node.EXPECT().TermWebSocket(term).Return(nil, nil, nil, func() error { closed = true; return nil }, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetIn(strings.NewReader(""))
+	cmd.SetArgs([]string{"shell", "-n", "pve", "--cmd", "upgrade"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "interactive terminal") {
+		t.Fatalf("expected interactive terminal error, got %v", err)
+	}
+	if !closed {
+		t.Error("expected websocket to be closed")
+	}
+}
+
+func TestShellRejectsUnknownCommand(t *testing.T) {
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"shell", "-n", "pve", "--cmd", "bash"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "invalid --cmd") {
+		t.Fatalf("expected invalid --cmd error, got %v", err)
+	}
+}
+
+func TestEvacuateAndReturnGuests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	t.Setenv("PROXMOX_CLI_CONFIG", filepath.Join(t.TempDir(), "config.json"))
+	cluster := mocks.NewMockClusterInterface(ctrl)
+	source := mocks.NewMockNodeInterface(ctrl)
+	vm := mocks.NewMockVirtualMachineInterface(ctrl)
+	blocked := mocks.NewMockVirtualMachineInterface(ctrl)
+	container := mocks.NewMockContainerInterface(ctrl)
+	pinned := mocks.NewMockContainerInterface(ctrl)
+
+	const gib = 1 << 30
+	ctx := gomock.Any()
+	client.EXPECT().Cluster(ctx).Return(cluster, nil)
+	cluster.EXPECT().Resources(ctx).Return(proxmox.ClusterResources{
+		{Type: "node", Node: "pve1", Status: "online", MaxMem: 64 * gib, Mem: 32 * gib},
+		{Type: "node", Node: "pve2", Status: "online", MaxMem: 16 * gib, Mem: 8 * gib},
+		{Type: "node", Node: "pve3", Status: "online", MaxMem: 16 * gib, Mem: 12 * gib},
+		{Type: "node", Node: "pve4", Status: "offline", MaxMem: 64 * gib},
+		{Type: "qemu", VMID: 100, Name: "web", Node: "pve1", Status: "running", MaxMem: 6 * gib},
+		{Type: "qemu", VMID: 101, Name: "gpu", Node: "pve1", Status: "stopped", MaxMem: 1 * gib},
+		{Type: "lxc", VMID: 200, Name: "dns", Node: "pve1", Status: "running", MaxMem: 2 * gib},
+		{Type: "lxc", VMID: 201, Name: "usb", Node: "pve1", Status: "running", MaxMem: 1 * gib},
+		{Type: "qemu", VMID: 300, Node: "pve2", Status: "running", MaxMem: 2 * gib},
+	}, nil)
+	client.EXPECT().Node(ctx, "pve1").Return(source, nil).AnyTimes()
+	source.EXPECT().VirtualMachine(ctx, 100).Return(vm, nil).Times(2)
+	source.EXPECT().VirtualMachine(ctx, 101).Return(blocked, nil)
+	source.EXPECT().Container(ctx, 200).Return(container, nil).Times(2)
+	source.EXPECT().Container(ctx, 201).Return(pinned, nil)
+	container.EXPECT().MigratePreconditions(ctx, "").Return(&proxmox.ContainerMigratePreconditions{Running: true, NotAllowedNodes: map[string]*proxmox.VirtualMachineMigratePreconditionsNotAllowedNodes{"pve2": {}}}, nil)
+	pinned.EXPECT().MigratePreconditions(ctx, "").Return(&proxmox.ContainerMigratePreconditions{LocalResources: []string{"dev0"}}, nil)
+	vm.EXPECT().MigratePreconditions(ctx, "").Return(&proxmox.VirtualMachineMigratePreconditions{Running: true}, nil)
+	blocked.EXPECT().MigratePreconditions(ctx, "").Return(&proxmox.VirtualMachineMigratePreconditions{LocalResources: []string{"hostpci0"}}, nil)
+	vm.EXPECT().Migrate(ctx, &proxmox.VirtualMachineMigrateOptions{Target: "pve2", Online: true}).Return(&proxmox.Task{IsSuccessful: true}, nil)
+	container.EXPECT().Migrate(ctx, &proxmox.ContainerMigrateOptions{Target: "pve3", Restart: true}).Return(&proxmox.Task{IsSuccessful: true}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"evacuate", "-n", "pve1", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("evacuate: %v\n%s", err, out.String())
+	}
+	for _, want := range []string{"skipped: local resources: hostpci0", "skipped: local resources: dev0", "VM 100 migrated to pve2", "Container 200 migrated to pve3", "2 guests migrated"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in output:\n%s", want, out.String())
+		}
+	}
+
+	target2 := mocks.NewMockNodeInterface(ctrl)
+	target3 := mocks.NewMockNodeInterface(ctrl)
+	client.EXPECT().Cluster(ctx).Return(cluster, nil)
+	cluster.EXPECT().Resources(ctx, "vm").Return(proxmox.ClusterResources{
+		{Type: "qemu", VMID: 100, Node: "pve2", Status: "running"},
+		{Type: "lxc", VMID: 200, Node: "pve3", Status: "stopped"},
+	}, nil)
+	client.EXPECT().Node(ctx, "pve2").Return(target2, nil)
+	client.EXPECT().Node(ctx, "pve3").Return(target3, nil)
+	target2.EXPECT().VirtualMachine(ctx, 100).Return(vm, nil)
+	target3.EXPECT().Container(ctx, 200).Return(container, nil)
+	vm.EXPECT().Migrate(ctx, &proxmox.VirtualMachineMigrateOptions{Target: "pve1", Online: true}).Return(&proxmox.Task{IsSuccessful: true}, nil)
+	container.EXPECT().Migrate(ctx, &proxmox.ContainerMigrateOptions{Target: "pve1"}).Return(&proxmox.Task{IsSuccessful: true}, nil)
+
+	cmd = NewCmd()
+	out.Reset()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"return", "-n", "pve1", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("return: %v\n%s", err, out.String())
+	}
+	if !strings.Contains(out.String(), "2 guests returned to node pve1") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+	record, err := evacuationFile("pve1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(record); !os.IsNotExist(err) {
+		t.Errorf("expected evacuation record to be removed, got %v", err)
+	}
+}
+
+func TestEvacuateMaintenanceRequiresRootSession(t *testing.T) {
+	t.Setenv("PROXMOX_CLI_CONFIG", filepath.Join(t.TempDir(), "config.json"))
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set("server_url", "https://pve.example.com:8006")
+	viper.Set("api_token.token_id", "root@pam!ci")
+	viper.Set("api_token.secret", "secret")
+
+	for _, args := range [][]string{
+		{"evacuate", "-n", "pve1", "--maintenance", "--yes"},
+		{"return", "-n", "pve1", "--maintenance", "--yes"},
+	} {
+		cmd := NewCmd()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		if err == nil || !strings.Contains(err.Error(), "API tokens cannot open") {
+			t.Fatalf("%s: expected API token error, got %v", args[0], err)
+		}
+	}
+
+	viper.Set("auth_ticket.ticket", "PVE:admin@pve:6530A1B2::signature")
+	viper.Set("auth_ticket.CSRFPreventionToken", "csrf")
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"evacuate", "-n", "pve1", "--maintenance", "--yes"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "stored session is for admin@pve") {
+		t.Fatalf("expected root@pam error, got %v", err)
+	}
+}
+
+func TestEvacuateRejectsUnknownTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	cluster := mocks.NewMockClusterInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Cluster(ctx).Return(cluster, nil)
+	cluster.EXPECT().Resources(ctx).Return(proxmox.ClusterResources{
+		{Type: "node", Node: "pve1", Status: "online"},
+		{Type: "node", Node: "pve2", Status: "offline"},
+	}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"evacuate", "-n", "pve1", "--target", "pve2", "--yes"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "not an online cluster node") {
+		t.Fatalf("expected target error, got %v", err)
+	}
+}
+
+func TestGuestsStopAllReportsPerGuestResults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	gomock.InOrder(
+		node.EXPECT().VirtualMachines(ctx).Return(proxmox.VirtualMachines{
+			{VMID: 100, Name: "web", Status: "running"},
+			{VMID: 101, Name: "db", Status: "running"},
+		}, nil),
+		node.EXPECT().Containers(ctx).Return(proxmox.Containers{{VMID: 200, Name: "dns", Status: "running"}}, nil),
+		node.EXPECT().StopAll(ctx, false, 60, "100,200").Return(&proxmox.Task{IsSuccessful: true}, nil),
+		node.EXPECT().VirtualMachines(ctx).Return(proxmox.VirtualMachines{
+			{VMID: 100, Name: "web", Status: "stopped"},
+			{VMID: 101, Name: "db", Status: "running"},
+		}, nil),
+		node.EXPECT().Containers(ctx).Return(proxmox.Containers{{VMID: 200, Name: "dns", Status: "running"}}, nil),
+	)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"guests", "stop-all", "-n", "pve", "--vms", "100,200", "--force-stop=false", "--shutdown-timeout", "60", "--yes"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), `1 guests on node "pve" did not stop`) {
+		t.Fatalf("expected did-not-stop error, got %v", err)
+	}
+	if strings.Contains(out.String(), "db") {
+		t.Errorf("unselected guest reported:\n%s", out.String())
+	}
+	for _, want := range []string{"running    stopped", "running    running"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in output:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestGuestsStartAllRejectsUnknownVMID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().VirtualMachines(ctx).Return(proxmox.VirtualMachines{{VMID: 100, Status: "stopped"}}, nil)
+	node.EXPECT().Containers(ctx).Return(nil, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"guests", "start-all", "-n", "pve", "--vms", "999"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "guest 999 not found") {
+		t.Fatalf("expected unknown guest error, got %v", err)
+	}
+}
+
+func TestRebootPreflightRefusesUnprotectedGuests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	cluster := mocks.NewMockClusterInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Cluster(ctx).Return(cluster, nil)
+	cluster.EXPECT().Resources(ctx, "vm").Return(proxmox.ClusterResources{
+		{Type: "qemu", VMID: 100, Name: "web", Node: "pve1", Status: "running", HAstate: "started"},
+		{Type: "qemu", VMID: 101, Name: "db", Node: "pve1", Status: "running"},
+		{Type: "lxc", VMID: 200, Node: "pve1", Status: "stopped"},
+		{Type: "lxc", VMID: 201, Node: "pve2", Status: "running"},
+	}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"reboot", "-n", "pve1", "--preflight", "--yes"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "still running on node \"pve1\": 101 (db)") {
+		t.Fatalf("expected preflight error, got %v", err)
+	}
+}
+
+func TestRebootWaitsForNodeToReturn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+	cluster := mocks.NewMockClusterInterface(ctrl)
+
+	ctx := gomock.Any()
+	online := proxmox.NodeStatuses{{Node: "pve1", Status: "online"}}
+	offline := proxmox.NodeStatuses{{Node: "pve1", Status: "offline"}}
+	client.EXPECT().Node(ctx, "pve1").Return(node, nil)
+	node.EXPECT().Reboot(ctx).Return(nil)
+	gomock.InOrder(
+		client.EXPECT().Nodes(ctx).Return(online, nil),
+		client.EXPECT().Nodes(ctx).Return(nil, context.DeadlineExceeded),
+		client.EXPECT().Nodes(ctx).Return(offline, nil),
+		client.EXPECT().Nodes(ctx).Return(online, nil),
+		client.EXPECT().Nodes(ctx).Return(online, nil),
+	)
+	client.EXPECT().Cluster(ctx).Return(cluster, nil).Times(2)
+	gomock.InOrder(
+		cluster.EXPECT().Quorate(ctx).Return(false, nil),
+		cluster.EXPECT().Quorate(ctx).Return(true, nil),
+	)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"reboot", "-n", "pve1", "--wait", "--interval", "1ms", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v\n%s", err, out.String())
+	}
+	for _, want := range []string{"Reboot of node pve1 requested", "Node pve1 is offline", "Node pve1 is back online and the cluster is quorate"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in output:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestShutdownWaitKeepsPollingThroughTransientErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	refused := &url.Error{Op: "Get", URL: "https://pve1:8006/api2/json/nodes", Err: &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}}
+	client.EXPECT().Node(ctx, "pve1").Return(node, nil)
+	node.EXPECT().Shutdown(ctx).Return(nil)
+	gomock.InOrder(
+		client.EXPECT().Nodes(ctx).Return(nil, errors.New("bad request: 500 Internal Server Error")),
+		client.EXPECT().Nodes(ctx).Return(proxmox.NodeStatuses{{Node: "pve1", Status: "unknown"}}, nil),
+		client.EXPECT().Nodes(ctx).Return(nil, refused),
+	)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"shutdown", "-n", "pve1", "--wait", "--interval", "1ms", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v\n%s", err, out.String())
+	}
+	if !strings.Contains(out.String(), "Node pve1 is offline") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestWakeSendsWakeOnLAN(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupAuthenticatedMocks(t, ctrl)
+
+	// Looking up a powered-off node fails because it reads the node's status.
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve2").Return(nil, errors.New("node pve2 is offline")).AnyTimes()
+	client.EXPECT().WakeNode(ctx, "pve2").Return("aa:bb:cc:dd:ee:ff", nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"wake", "-n", "pve2"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "aa:bb:cc:dd:ee:ff") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
@@ -0,0 +1,237 @@
+package nodes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/spf13/cobra"
+)
+
+func newPowerCmd(action string) *cobra.Command {
+	short := "Reboot a node"
+	waitUsage := "Wait until the node is back online and the cluster is quorate"
+	waitHelp := ` and come back online with quorum restored,
+which makes rolling reboots scriptable:
+
+  proxmox-cli nodes reboot -n pve1 --preflight --wait --timeout 30m --yes`
+	if action == "shutdown" {
+		short = "Power off a node"
+		waitUsage = "Wait until the node has gone offline"
+		waitHelp = "."
+	}
+	cmd := &cobra.Command{
+		Use:   action,
+		Short: short,
+		Long: short + `. --preflight refuses to continue while guests that are
+not managed by HA are still running on the node; move them first with
+'proxmox-cli nodes evacuate' or stop them with 'proxmox-cli nodes guests
+stop-all'. --wait polls the cluster's node list every --interval until the
+node has dropped out` + waitHelp,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			flags := cmd.Flags()
+			preflight, err := flags.GetBool("preflight")
+			if err != nil {
+				return fmt.Errorf("get preflight flag: %w", err)
+			}
+			wait, err := flags.GetBool("wait")
+			if err != nil {
+				return fmt.Errorf("get wait flag: %w", err)
+			}
+			interval, err := flags.GetDuration("interval")
+			if err != nil {
+				return fmt.Errorf("get interval flag: %w", err)
+			}
+			if interval <= 0 {
+				return fmt.Errorf("--interval must be positive")
+			}
+			nodeName, err := nodeNameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+
+			if preflight {
+				if err := checkNoUnprotectedGuests(ctx, client, nodeName); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "Pre-flight check passed: no guests without HA are running on %s\n", nodeName)
+			}
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("%s node %s?", capitalize(action), nodeName)); err != nil {
+				return err
+			}
+
+			node, err := client.Node(ctx, nodeName)
+			if err != nil {
+				return fmt.Errorf("get node %q: %w", nodeName, err)
+			}
+			if action == "reboot" {
+				err = node.Reboot(ctx)
+			} else {
+				err = node.Shutdown(ctx)
+			}
+			if err != nil {
+				return fmt.Errorf("%s node %q: %w", action, nodeName, err)
+			}
+			fmt.Fprintf(out, "%s of node %s requested\n", capitalize(action), nodeName)
+			if !wait {
+				return nil
+			}
+
+			waitCtx, cancel := context.WithTimeout(ctx, utility.TaskTimeout(cmd))
+			defer cancel()
+			started := time.Now()
+			if err := waitForNodeOffline(waitCtx, client, nodeName, interval); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Node %s is offline\n", nodeName)
+			if action == "shutdown" {
+				return nil
+			}
+			if err := waitForNodeReturn(waitCtx, client, nodeName, interval); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Node %s is back online and the cluster is quorate (%s)\n", nodeName, time.Since(started).Round(time.Second))
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	utility.AddYesFlag(cmd)
+	cmd.Flags().Bool("preflight", false, "Refuse if guests without HA are still running on the node")
+	cmd.Flags().Bool("wait", false, waitUsage)
+	cmd.Flags().Duration("interval", 5*time.Second, "Poll interval for --wait")
+	return cmd
+}
+
+func newWakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wake",
+		Short: "Wake a node with a Wake-on-LAN packet",
+		Long: `Send a Wake-on-LAN magic packet to a powered-off node. The packet is sent
+by the node the CLI is connected to, using the MAC address from the target
+node's wakeonlan setting.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nodeName, err := nodeNameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			mac, err := client.WakeNode(cmd.Context(), nodeName)
+			if err != nil {
+				return fmt.Errorf("wake node %q: %w", nodeName, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Wake-on-LAN packet sent to node %s (%s)\n", nodeName, mac)
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	return cmd
+}
+
+// checkNoUnprotectedGuests fails when guests that HA would not recover are
+// running on the node.
+func checkNoUnprotectedGuests(ctx context.Context, client interfaces.ProxmoxClientInterface, nodeName string) error {
+	cluster, err := client.Cluster(ctx)
+	if err != nil {
+		return fmt.Errorf("get cluster: %w", err)
+	}
+	resources, err := cluster.Resources(ctx, "vm")
+	if err != nil {
+		return fmt.Errorf("list cluster resources: %w", err)
+	}
+	var running []string
+	for _, resource := range resources {
+		if resource == nil || resource.Node != nodeName || resource.Status != "running" || resource.HAstate != "" {
+			continue
+		}
+		label := fmt.Sprintf("%d", resource.VMID)
+		if resource.Name != "" {
+			label += " (" + resource.Name + ")"
+		}
+		running = append(running, label)
+	}
+	if len(running) > 0 {
+		sort.Strings(running)
+		return fmt.Errorf("guests without HA are still running on node %q: %s", nodeName, strings.Join(running, ", "))
+	}
+	return nil
+}
+
+// nodeOnline reports whether the node is listed as online. Request errors
+// count as not online, so waitForNodeReturn keeps polling through them.
+func nodeOnline(ctx context.Context, client interfaces.ProxmoxClientInterface, nodeName string) bool {
+	statuses, err := client.Nodes(ctx)
+	if err != nil {
+		return false
+	}
+	for _, status := range statuses {
+		if status != nil && status.Node == nodeName {
+			return status.Status == "online"
+		}
+	}
+	return false
+}
+
+// nodeDown reports whether the node is listed as offline, or whether the API
+// cannot be reached at all because it was served by the node going down.
+// Other request errors, such as timeouts while the cluster reconverges, are
+// not proof that the node is down.
+func nodeDown(ctx context.Context, client interfaces.ProxmoxClientInterface, nodeName string) bool {
+	statuses, err := client.Nodes(ctx)
+	if err != nil {
+		return errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EHOSTUNREACH) || errors.Is(err, syscall.ENETUNREACH)
+	}
+	for _, status := range statuses {
+		if status != nil && status.Node == nodeName {
+			return status.Status == "offline"
+		}
+	}
+	return false
+}
+
+func waitForNodeOffline(ctx context.Context, client interfaces.ProxmoxClientInterface, nodeName string, interval time.Duration) error {
+	for !nodeDown(ctx, client, nodeName) {
+		if !waitPoll(ctx, interval) {
+			return fmt.Errorf("timed out waiting for node %q to go offline", nodeName)
+		}
+	}
+	return nil
+}
+
+func waitForNodeReturn(ctx context.Context, client interfaces.ProxmoxClientInterface, nodeName string, interval time.Duration) error {
+	for {
+		if nodeOnline(ctx, client, nodeName) && clusterQuorate(ctx, client) {
+			return nil
+		}
+		if !waitPoll(ctx, interval) {
+			return fmt.Errorf("timed out waiting for node %q to come back online", nodeName)
+		}
+	}
+}
+
+func clusterQuorate(ctx context.Context, client interfaces.ProxmoxClientInterface) bool {
+	cluster, err := client.Cluster(ctx)
+	if err != nil {
+		return false
+	}
+	quorate, err := cluster.Quorate(ctx)
+	return err == nil && quorate
+}
@@ -0,0 +1,174 @@
+package nodes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/spf13/cobra"
+)
+
+// knownServices are the services Proxmox allows to be controlled through
+// the API; they feed shell completion.
+var knownServices = []string{
+	"chrony", "corosync", "cron", "ksmtuned", "postfix", "pve-cluster",
+	"pve-firewall", "pve-ha-crm", "pve-ha-lrm", "pvedaemon", "pvefw-logger",
+	"pveproxy", "pvestatd", "spiceproxy", "sshd", "syslog", "systemd-timesyncd",
+}
+
+// disruptiveServices affect cluster membership, HA, or API access when they
+// are stopped or restarted, so acting on them needs confirmation.
+var disruptiveServices = map[string]bool{
+	"corosync":    true,
+	"pve-cluster": true,
+	"pve-ha-crm":  true,
+	"pve-ha-lrm":  true,
+	"pvedaemon":   true,
+	"pveproxy":    true,
+}
+
+type serviceSummary struct {
+	Service     string `json:"service"`
+	State       string `json:"state"`
+	ActiveState string `json:"active_state,omitempty"`
+	UnitState   string `json:"unit_state,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func newServicesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "services",
+		Short: "List and control node services",
+		Long: `List the Proxmox and system services of a node and start, stop, restart,
+or reload them. Actions other than start on corosync, pve-cluster, the HA
+services, pveproxy, and pvedaemon ask for confirmation.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newServicesListCmd())
+	for _, action := range []string{"start", "stop", "restart", "reload"} {
+		cmd.AddCommand(newServiceActionCmd(action))
+	}
+	return cmd
+}
+
+func newServicesListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List services of a node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			services, err := node.Services(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list services on node %q: %w", nodeName, err)
+			}
+			summaries := make([]serviceSummary, 0, len(services))
+			for _, service := range services {
+				if service == nil {
+					continue
+				}
+				name := service.Service
+				if name == "" {
+					name = service.Name
+				}
+				summaries = append(summaries, serviceSummary{
+					Service:     name,
+					State:       service.Status,
+					ActiveState: service.ActiveState,
+					UnitState:   service.UnitState,
+					Description: service.Desc,
+				})
+			}
+			sort.Slice(summaries, func(i, j int) bool { return summaries[i].Service < summaries[j].Service })
+
+			if format == "json" {
+				return utility.PrintJSON(out, summaries)
+			}
+			fmt.Fprintf(out, "Services on %s:\n", nodeName)
+			fmt.Fprintf(out, "%-20s %-10s %-10s %-10s %s\n", "Service", "State", "Active", "Unit", "Description")
+			fmt.Fprintf(out, "%-20s %-10s %-10s %-10s %s\n", "-------", "-----", "------", "----", "-----------")
+			for _, summary := range summaries {
+				fmt.Fprintf(out, "%-20s %-10s %-10s %-10s %s\n", summary.Service, utility.DashIfEmpty(summary.State),
+					utility.DashIfEmpty(summary.ActiveState), utility.DashIfEmpty(summary.UnitState), summary.Description)
+			}
+			if len(summaries) == 0 {
+				fmt.Fprintln(out, "No services found")
+			}
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newServiceActionCmd(action string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:       action + " <service>",
+		Short:     fmt.Sprintf("%s a node service", capitalize(action)),
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: knownServices,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			service := args[0]
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			services, err := node.Services(ctx)
+			if err != nil {
+				return fmt.Errorf("list services on node %q: %w", nodeName, err)
+			}
+			found := false
+			for _, candidate := range services {
+				if candidate != nil && (candidate.Service == service || candidate.Name == service) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("service %q not found on node %q; see 'proxmox-cli nodes services list -n %s'", service, nodeName, nodeName)
+			}
+
+			if action != "start" && disruptiveServices[service] {
+				prompt := fmt.Sprintf("%s %s on node %s? This can disrupt the cluster or API access.", capitalize(action), service, nodeName)
+				if err := utility.ConfirmAction(cmd, prompt); err != nil {
+					return err
+				}
+			}
+
+			task, err := node.ServiceAction(ctx, service, action)
+			if err != nil {
+				return fmt.Errorf("%s service %q on node %q: %w", action, service, nodeName, err)
+			}
+			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+				return fmt.Errorf("%s service %q on node %q: %w", action, service, nodeName, err)
+			}
+			fmt.Fprintf(out, "Service %s on node %s: %s completed\n", service, nodeName, action)
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func capitalize(value string) string {
+	if value == "" {
+		return value
+	}
+	return strings.ToUpper(value[:1]) + value[1:]
+}
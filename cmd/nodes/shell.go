@@ -0,0 +1,78 @@
+package nodes
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// shellCommands are the commands Proxmox lets a node terminal proxy run in
+// place of the default login shell.
+var shellCommands = []string{
+	string(proxmox.NodeConsoleLogin),
+	string(proxmox.NodeConsoleUpgrade),
+	string(proxmox.NodeConsoleCephInstall),
+}
+
+func newShellCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shell",
+		Short: "Open an interactive shell on a node",
+		Long: `Open a root shell on the node through the Proxmox terminal proxy, the same
+way the web UI's Shell button does, without SSH access to the host.
+
+--cmd runs one of the commands the web UI offers instead of the default
+shell: "upgrade" runs the interactive package upgrade, "ceph_install" the
+Ceph installer, and "login" the login shell explicitly.
+
+Requires password (session) authentication; Proxmox does not allow API
+tokens to open console websockets. Press Ctrl+] to disconnect.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			command, err := cmd.Flags().GetString("cmd")
+			if err != nil {
+				return fmt.Errorf("get cmd flag: %w", err)
+			}
+			if command != "" && !slices.Contains(shellCommands, command) {
+				return fmt.Errorf("invalid --cmd %q: must be one of %s", command, strings.Join(shellCommands, ", "))
+			}
+			nodeName, err := nodeNameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			// The regular client prefers API-token auth, which Proxmox
+			// rejects for console websockets; use the session-only client.
+			client, err := utility.SessionClient()
+			if err != nil {
+				return err
+			}
+			node, err := client.Node(ctx, nodeName)
+			if err != nil {
+				return fmt.Errorf("get node %q: %w", nodeName, err)
+			}
+
+			term, err := node.TermProxy(ctx, proxmox.NodeConsoleCmd(command), "")
+			if err != nil {
+				return fmt.Errorf("open terminal proxy on node %q: %w", nodeName, err)
+			}
+			send, recv, errs, closer, err := node.TermWebSocket(term)
+			if err != nil {
+				return fmt.Errorf("connect shell websocket on node %q: %w", nodeName, err)
+			}
+			return utility.RunConsole(cmd, send, recv, errs, closer)
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	cmd.Flags().String("cmd", "", "Run a command instead of the shell (login, upgrade, ceph_install)")
+	_ = cmd.RegisterFlagCompletionFunc("cmd", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return shellCommands, cobra.ShellCompDirectiveNoFileComp
+	})
+	return cmd
+}
@@ -0,0 +1,388 @@
+package nodes
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+type namedNode struct {
+	name string
+	node interfaces.NodeInterface
+}
+
+type packageUpdate struct {
+	Node           string `json:"node"`
+	Package        string `json:"package"`
+	CurrentVersion string `json:"current_version,omitempty"`
+	NewVersion     string `json:"new_version"`
+	Origin         string `json:"origin,omitempty"`
+	Priority       string `json:"priority,omitempty"`
+}
+
+type packageVersions struct {
+	Package  string            `json:"package"`
+	Versions map[string]string `json:"versions"`
+	Skew     bool              `json:"skew"`
+}
+
+type clusterVersions struct {
+	Nodes    []string          `json:"nodes"`
+	Packages []packageVersions `json:"packages"`
+}
+
+// addNodeOrAllNodesFlags adds --node and --all-nodes; exactly one of them
+// must be given.
+func addNodeOrAllNodesFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("node", "n", "", "Node name")
+	cmd.Flags().Bool("all-nodes", false, "Query every online node in the cluster")
+	utility.RegisterNodeFlagCompletion(cmd, "node")
+}
+
+// nodesFromFlags resolves --node, or every online cluster node with
+// --all-nodes, sorted by name.
+func nodesFromFlags(cmd *cobra.Command) ([]namedNode, error) {
+	ctx := cmd.Context()
+	allNodes, err := cmd.Flags().GetBool("all-nodes")
+	if err != nil {
+		return nil, fmt.Errorf("get all-nodes flag: %w", err)
+	}
+	nodeName, err := cmd.Flags().GetString("node")
+	if err != nil {
+		return nil, fmt.Errorf("get node flag: %w", err)
+	}
+	nodeName = strings.TrimSpace(nodeName)
+	switch {
+	case allNodes && nodeName != "":
+		return nil, fmt.Errorf("use either --node or --all-nodes, not both")
+	case !allNodes && nodeName == "":
+		return nil, fmt.Errorf("--node or --all-nodes is required")
+	}
+
+	client, err := utility.AuthenticatedClient()
+	if err != nil {
+		return nil, fmt.Errorf("authenticate Proxmox client: %w", err)
+	}
+	names := []string{nodeName}
+	if allNodes {
+		statuses, err := client.Nodes(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fetch cluster nodes: %w", err)
+		}
+		names = names[:0]
+		for _, status := range statuses {
+			if status.Status == "online" {
+				names = append(names, status.Node)
+			}
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("no online nodes found")
+		}
+		sort.Strings(names)
+	}
+
+	nodes := make([]namedNode, 0, len(names))
+	for _, name := range names {
+		node, err := client.Node(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("get node %q: %w", name, err)
+		}
+		nodes = append(nodes, namedNode{name: name, node: node})
+	}
+	return nodes, nil
+}
+
+func newUpdatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "updates",
+		Short: "Show and refresh pending package updates",
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(newUpdatesListCmd(), newUpdatesRefreshCmd(), newUpdatesChangelogCmd())
+	return cmd
+}
+
+func newUpdatesListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List pending package updates",
+		Long: `List the packages with upgrades available as of the node's last package
+index refresh; run 'nodes updates refresh' first for current results.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			nodes, err := nodesFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			updates := []packageUpdate{}
+			for _, target := range nodes {
+				pending, err := target.node.APTUpdates(cmd.Context())
+				if err != nil {
+					return fmt.Errorf("list updates on node %q: %w", target.name, err)
+				}
+				for _, update := range pending {
+					if update == nil {
+						continue
+					}
+					updates = append(updates, packageUpdate{
+						Node:           target.name,
+						Package:        update.Package,
+						CurrentVersion: update.OldVersion,
+						NewVersion:     update.Version,
+						Origin:         update.Origin,
+						Priority:       update.Priority,
+					})
+				}
+			}
+			sort.SliceStable(updates, func(i, j int) bool {
+				if updates[i].Node != updates[j].Node {
+					return updates[i].Node < updates[j].Node
+				}
+				return updates[i].Package < updates[j].Package
+			})
+
+			if format == "json" {
+				return utility.PrintJSON(out, updates)
+			}
+			printUpdatesTable(out, updates, len(nodes) > 1)
+			return nil
+		},
+	}
+
+	addNodeOrAllNodesFlags(cmd)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func printUpdatesTable(out io.Writer, updates []packageUpdate, showNode bool) {
+	if len(updates) == 0 {
+		fmt.Fprintln(out, "No pending updates")
+		return
+	}
+	nodeColumn := func(node string) string {
+		if showNode {
+			return fmt.Sprintf("%-12s ", node)
+		}
+		return ""
+	}
+	fmt.Fprintf(out, "%s%-32s %-40s %-12s %s\n", nodeColumn("Node"), "Package", "Version", "Origin", "Priority")
+	fmt.Fprintf(out, "%s%-32s %-40s %-12s %s\n", nodeColumn("----"), "-------", "-------", "------", "--------")
+	for _, update := range updates {
+		version := utility.DashIfEmpty(update.CurrentVersion) + " -> " + update.NewVersion
+		fmt.Fprintf(out, "%s%-32s %-40s %-12s %s\n", nodeColumn(update.Node), update.Package, version,
+			utility.DashIfEmpty(update.Origin), utility.DashIfEmpty(update.Priority))
+	}
+	fmt.Fprintf(out, "\n%d pending update(s)\n", len(updates))
+}
+
+func newUpdatesRefreshCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refresh",
+		Short: "Refresh the package index (apt-get update)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			nodes, err := nodesFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			for _, target := range nodes {
+				fmt.Fprintf(out, "Refreshing package index on node %s\n", target.name)
+				task, err := target.node.APTRefresh(ctx)
+				if err != nil {
+					return fmt.Errorf("refresh package index on node %q: %w", target.name, err)
+				}
+				if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+					return fmt.Errorf("refresh package index on node %q: %w", target.name, err)
+				}
+			}
+			fmt.Fprintln(out, "Package index refreshed; see 'proxmox-cli nodes updates list'")
+			return nil
+		},
+	}
+
+	addNodeOrAllNodesFlags(cmd)
+	return cmd
+}
+
+func newUpdatesChangelogCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "changelog <package>",
+		Short: "Show the changelog of a package update",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			version, err := cmd.Flags().GetString("version")
+			if err != nil {
+				return fmt.Errorf("get version flag: %w", err)
+			}
+			node, nodeName, err := nodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			changelog, err := node.APTChangelog(cmd.Context(), args[0], strings.TrimSpace(version))
+			if err != nil {
+				return fmt.Errorf("get changelog of %q on node %q: %w", args[0], nodeName, err)
+			}
+			fmt.Fprint(out, changelog)
+			if changelog != "" && !strings.HasSuffix(changelog, "\n") {
+				fmt.Fprintln(out)
+			}
+			return nil
+		},
+	}
+
+	addNodeNameFlag(cmd)
+	cmd.Flags().String("version", "", "Package version (default: the candidate version)")
+	return cmd
+}
+
+func newVersionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "versions",
+		Short: "Show installed Proxmox package versions",
+		Long: `Show the installed versions of the Proxmox packages, like 'pveversion -v'.
+With --all-nodes the versions are compared across the cluster and
+packages that differ between nodes are marked with '*'.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			nodes, err := nodesFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			result := clusterVersions{}
+			byPackage := map[string]*packageVersions{}
+			for _, target := range nodes {
+				result.Nodes = append(result.Nodes, target.name)
+				installed, err := target.node.APTVersions(cmd.Context())
+				if err != nil {
+					return fmt.Errorf("list package versions on node %q: %w", target.name, err)
+				}
+				for _, pkg := range installed {
+					if pkg == nil {
+						continue
+					}
+					entry, ok := byPackage[pkg.Package]
+					if !ok {
+						entry = &packageVersions{Package: pkg.Package, Versions: map[string]string{}}
+						byPackage[pkg.Package] = entry
+					}
+					entry.Versions[target.name] = packageVersionText(pkg)
+				}
+			}
+			for _, entry := range byPackage {
+				for _, name := range result.Nodes {
+					if entry.Versions[name] != entry.Versions[result.Nodes[0]] {
+						entry.Skew = true
+					}
+				}
+				result.Packages = append(result.Packages, *entry)
+			}
+			// Keep the pveversion order: proxmox-ve and pve-manager first.
+			sort.Slice(result.Packages, func(i, j int) bool {
+				rankI, rankJ := packageRank(result.Packages[i].Package), packageRank(result.Packages[j].Package)
+				if rankI != rankJ {
+					return rankI < rankJ
+				}
+				return result.Packages[i].Package < result.Packages[j].Package
+			})
+
+			if format == "json" {
+				if len(nodes) == 1 {
+					return utility.PrintJSON(out, result.Packages)
+				}
+				return utility.PrintJSON(out, result)
+			}
+			if len(nodes) == 1 {
+				for _, entry := range result.Packages {
+					fmt.Fprintf(out, "%s: %s\n", entry.Package, entry.Versions[result.Nodes[0]])
+				}
+				return nil
+			}
+			printVersionMatrix(out, result)
+			return nil
+		},
+	}
+
+	addNodeOrAllNodesFlags(cmd)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+// packageVersionText renders a package version the way pveversion -v does.
+func packageVersionText(pkg *proxmox.APTPackageVersion) string {
+	if pkg.CurrentState != "" && pkg.CurrentState != "Installed" {
+		return "not correctly installed"
+	}
+	version := pkg.OldVersion
+	if version == "" {
+		version = pkg.Version
+	}
+	switch {
+	case pkg.RunningKernel != "":
+		version += " (running kernel: " + pkg.RunningKernel + ")"
+	case pkg.ManagerVersion != "":
+		version += " (running version: " + pkg.ManagerVersion + ")"
+	}
+	return version
+}
+
+func packageRank(name string) int {
+	switch name {
+	case "proxmox-ve":
+		return 0
+	case "pve-manager":
+		return 1
+	default:
+		return 2
+	}
+}
+
+func printVersionMatrix(out io.Writer, result clusterVersions) {
+	fmt.Fprintf(out, "  %-28s", "Package")
+	for _, name := range result.Nodes {
+		fmt.Fprintf(out, " %-24s", name)
+	}
+	fmt.Fprintln(out)
+	skewed := 0
+	for _, entry := range result.Packages {
+		marker := " "
+		if entry.Skew {
+			marker = "*"
+			skewed++
+		}
+		fmt.Fprintf(out, "%s %-28s", marker, entry.Package)
+		for _, name := range result.Nodes {
+			version := entry.Versions[name]
+			if version == "" {
+				version = "-"
+			}
+			fmt.Fprintf(out, " %-24s", version)
+		}
+		fmt.Fprintln(out)
+	}
+	if skewed == 0 {
+		fmt.Fprintf(out, "\nAll %d nodes run the same package versions\n", len(result.Nodes))
+		return
+	}
+	fmt.Fprintf(out, "\n* %d package(s) differ between nodes\n", skewed)
+}
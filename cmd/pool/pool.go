@@ -0,0 +1,301 @@
+// Package pool implements resource pool listing and membership management.
+package pool
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+type poolMember struct {
+	Type   string `json:"type"`
+	ID     string `json:"id"`
+	Name   string `json:"name,omitempty"`
+	Node   string `json:"node,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+type poolDetails struct {
+	Pool    string       `json:"pool"`
+	Comment string       `json:"comment,omitempty"`
+	Members []poolMember `json:"members"`
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pool",
+		Aliases: []string{"pools"},
+		Short:   "Manage resource pools",
+		Long: `List and manage Proxmox resource pools and their guest and storage
+members. Guest listings accept --pool to show one pool only:
+
+  proxmox-cli get --pool web-team
+  proxmox-cli vm get --pool web-team`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newListCmd())
+	cmd.AddCommand(newShowCmd())
+	cmd.AddCommand(newCreateCmd())
+	cmd.AddCommand(newDeleteCmd())
+	cmd.AddCommand(newMembersCmd("add"))
+	cmd.AddCommand(newMembersCmd("remove"))
+	return cmd
+}
+
+func newListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List resource pools",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			pools, err := client.Pools(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list pools: %w", err)
+			}
+			sort.Slice(pools, func(i, j int) bool { return pools[i].PoolID < pools[j].PoolID })
+
+			if format == "json" {
+				summaries := make([]poolDetails, 0, len(pools))
+				for _, pool := range pools {
+					summaries = append(summaries, poolDetails{Pool: pool.PoolID, Comment: pool.Comment, Members: []poolMember{}})
+				}
+				return utility.PrintJSON(out, summaries)
+			}
+			if len(pools) == 0 {
+				fmt.Fprintln(out, "No resource pools found")
+				return nil
+			}
+			fmt.Fprintf(out, "%-20s %s\n", "Pool", "Comment")
+			fmt.Fprintf(out, "%-20s %s\n", "----", "-------")
+			for _, pool := range pools {
+				fmt.Fprintf(out, "%-20s %s\n", pool.PoolID, pool.Comment)
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "show <pool>",
+		Short:             "Show a pool's guests and storages",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completePoolArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			pool, err := client.Pool(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("get pool %q: %w", args[0], err)
+			}
+
+			details := poolDetails{Pool: pool.PoolID, Comment: pool.Comment, Members: []poolMember{}}
+			for _, member := range pool.Members {
+				entry := poolMember{Type: member.Type, Name: member.Name, Node: member.Node, Status: member.Status}
+				switch member.Type {
+				case "qemu":
+					entry.Type = "vm"
+					entry.ID = strconv.FormatUint(member.VMID, 10)
+				case "lxc":
+					entry.ID = strconv.FormatUint(member.VMID, 10)
+				case "storage":
+					entry.ID = member.Storage
+				default:
+					entry.ID = member.ID
+				}
+				details.Members = append(details.Members, entry)
+			}
+			sort.Slice(details.Members, func(i, j int) bool {
+				if details.Members[i].Type != details.Members[j].Type {
+					return details.Members[i].Type > details.Members[j].Type
+				}
+				return details.Members[i].ID < details.Members[j].ID
+			})
+
+			if format == "json" {
+				return utility.PrintJSON(out, details)
+			}
+			fmt.Fprintf(out, "Pool: %s\n", details.Pool)
+			if details.Comment != "" {
+				fmt.Fprintf(out, "Comment: %s\n", details.Comment)
+			}
+			fmt.Fprintln(out)
+			if len(details.Members) == 0 {
+				fmt.Fprintln(out, "No members")
+				return nil
+			}
+			fmt.Fprintf(out, "%-8s %-12s %-24s %-15s %s\n", "Type", "ID", "Name", "Node", "Status")
+			fmt.Fprintf(out, "%-8s %-12s %-24s %-15s %s\n", "----", "--", "----", "----", "------")
+			for _, member := range details.Members {
+				fmt.Fprintf(out, "%-8s %-12s %-24s %-15s %s\n", member.Type, member.ID,
+					utility.DashIfEmpty(member.Name), utility.DashIfEmpty(member.Node), utility.DashIfEmpty(member.Status))
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <pool>",
+		Short: "Create a resource pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			comment, err := cmd.Flags().GetString("comment")
+			if err != nil {
+				return fmt.Errorf("get comment flag: %w", err)
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			if err := client.NewPool(cmd.Context(), args[0], comment); err != nil {
+				return fmt.Errorf("create pool %q: %w", args[0], err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Pool %s created\n", args[0])
+			return nil
+		},
+	}
+	cmd.Flags().String("comment", "", "Pool description")
+	return cmd
+}
+
+func newDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <pool>",
+		Short: "Delete an empty resource pool",
+		Long: `Delete a resource pool. Proxmox refuses to delete pools that still have
+members; remove them first with 'proxmox-cli pool remove'.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completePoolArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Delete pool %s?", args[0])); err != nil {
+				return err
+			}
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			if err := client.DeletePool(cmd.Context(), args[0]); err != nil {
+				return fmt.Errorf("delete pool %q: %w", args[0], err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Pool %s deleted\n", args[0])
+			return nil
+		},
+	}
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func newMembersCmd(action string) *cobra.Command {
+	short := "Add guests or storages to a pool"
+	if action == "remove" {
+		short = "Remove guests or storages from a pool"
+	}
+	cmd := &cobra.Command{
+		Use:               action + " <pool>",
+		Short:             short,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completePoolArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			vmids, err := flags.GetIntSlice("vmid")
+			if err != nil {
+				return fmt.Errorf("get vmid flag: %w", err)
+			}
+			storages, err := flags.GetStringSlice("storage")
+			if err != nil {
+				return fmt.Errorf("get storage flag: %w", err)
+			}
+			if len(vmids) == 0 && len(storages) == 0 {
+				return fmt.Errorf("pass --vmid and/or --storage")
+			}
+			ids := make([]string, 0, len(vmids))
+			for _, vmid := range vmids {
+				if vmid <= 0 {
+					return fmt.Errorf("invalid VMID %d", vmid)
+				}
+				ids = append(ids, strconv.Itoa(vmid))
+			}
+			option := &proxmox.PoolUpdateOption{
+				VirtualMachines: strings.Join(ids, ","),
+				Storage:         strings.Join(storages, ","),
+				Delete:          proxmox.IntOrBool(action == "remove"),
+			}
+			if action == "add" {
+				allowMove, err := flags.GetBool("allow-move")
+				if err != nil {
+					return fmt.Errorf("get allow-move flag: %w", err)
+				}
+				option.AllowMove = proxmox.IntOrBool(allowMove)
+			}
+
+			client, err := utility.AuthenticatedClient()
+			if err != nil {
+				return fmt.Errorf("authenticate Proxmox client: %w", err)
+			}
+			if err := client.UpdatePool(cmd.Context(), args[0], option); err != nil {
+				return fmt.Errorf("%s pool members of %q: %w", action, args[0], err)
+			}
+			out := cmd.OutOrStdout()
+			preposition := "to"
+			if action == "remove" {
+				preposition = "from"
+			}
+			for _, id := range ids {
+				fmt.Fprintf(out, "Guest %s %s %s pool %s\n", id, pastTense(action), preposition, args[0])
+			}
+			for _, storage := range storages {
+				fmt.Fprintf(out, "Storage %s %s %s pool %s\n", storage, pastTense(action), preposition, args[0])
+			}
+			return nil
+		},
+	}
+	cmd.Flags().IntSlice("vmid", nil, "Guest IDs (comma-separated)")
+	cmd.Flags().StringSlice("storage", nil, "Storage IDs (comma-separated)")
+	if action == "add" {
+		cmd.Flags().Bool("allow-move", false, "Move guests that already belong to another pool")
+	}
+	return cmd
+}
+
+// completePoolArg completes the single pool argument.
+func completePoolArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return utility.CompletePools(cmd, args, toComplete)
+}
+
+func pastTense(action string) string {
+	if action == "remove" {
+		return "removed"
+	}
+	return "added"
+}
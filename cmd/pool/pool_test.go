@@ -0,0 +1,112 @@
+package pool
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/viper"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/Adz-ai/proxmox-cli/test/mocks"
+)
+
+func setupPoolMocks(t *testing.T, ctrl *gomock.Controller) *mocks.MockProxmoxClientInterface {
+	t.Helper()
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set("server_url", "https://pve.example.com:8006")
+	viper.Set("auth_ticket.ticket", "ticket")
+	viper.Set("auth_ticket.CSRFPreventionToken", "token")
+
+	client := mocks.NewMockProxmoxClientInterface(ctrl)
+	utility.SetClientFactory(func() interfaces.ProxmoxClientInterface { return client })
+	t.Cleanup(utility.ResetClientFactory)
+	return client
+}
+
+func runPool(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestShowListsMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupPoolMocks(t, ctrl)
+
+	client.EXPECT().Pool(gomock.Any(), "web-team").Return(&proxmox.Pool{
+		PoolID:  "web-team",
+		Comment: "Web servers",
+		Members: []proxmox.ClusterResource{
+			{Type: "qemu", VMID: 100, Name: "web1", Node: "pve1", Status: "running"},
+			{Type: "storage", Storage: "web-data", Node: "pve1", Status: "available"},
+		},
+	}, nil)
+
+	out, err := runPool(t, "show", "web-team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"Comment: Web servers", "vm       100", "web1", "storage  web-data"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestAddSendsGuestsAndStorages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupPoolMocks(t, ctrl)
+
+	client.EXPECT().UpdatePool(gomock.Any(), "web-team", gomock.Any()).DoAndReturn(
+		func(_ any, _ string, option *proxmox.PoolUpdateOption) error {
+			if option.VirtualMachines != "100,101" || option.Storage != "web-data" || option.Delete || !option.AllowMove {
+				t.Fatalf("unexpected update: %+v", option)
+			}
+			return nil
+		})
+
+	out, err := runPool(t, "add", "web-team", "--vmid", "100,101", "--storage", "web-data", "--allow-move")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "Guest 101 added to pool web-team") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestRemoveSetsDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := setupPoolMocks(t, ctrl)
+
+	client.EXPECT().UpdatePool(gomock.Any(), "web-team", gomock.Any()).DoAndReturn(
+		func(_ any, _ string, option *proxmox.PoolUpdateOption) error {
+			if option.VirtualMachines != "100" || !option.Delete {
+				t.Fatalf("unexpected update: %+v", option)
+			}
+			return nil
+		})
+
+	if _, err := runPool(t, "remove", "web-team", "--vmid", "100"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMembersRequireTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	setupPoolMocks(t, ctrl)
+
+	_, err := runPool(t, "add", "web-team")
+	if err == nil || !strings.Contains(err.Error(), "--vmid") {
+		t.Fatalf("expected missing-member error, got %v", err)
+	}
+}
@@ -1,11 +1,16 @@
 package cmd
 
 import (
+	"github.com/Adz-ai/proxmox-cli/cmd/access"
 	"github.com/Adz-ai/proxmox-cli/cmd/auth"
 	"github.com/Adz-ai/proxmox-cli/cmd/backup"
+	"github.com/Adz-ai/proxmox-cli/cmd/firewall"
+	"github.com/Adz-ai/proxmox-cli/cmd/ha"
 	"github.com/Adz-ai/proxmox-cli/cmd/images"
 	"github.com/Adz-ai/proxmox-cli/cmd/lxc"
 	"github.com/Adz-ai/proxmox-cli/cmd/nodes"
+	"github.com/Adz-ai/proxmox-cli/cmd/pool"
+	"github.com/Adz-ai/proxmox-cli/cmd/sdn"
 	"github.com/Adz-ai/proxmox-cli/cmd/utility"
 	"github.com/Adz-ai/proxmox-cli/cmd/vm"
 
@@ -79,6 +84,11 @@ Get started:
 	cmd.AddCommand(backup.NewCmd())
 	cmd.AddCommand(images.NewTemplateCmd())
 	cmd.AddCommand(images.NewISOCmd())
+	cmd.AddCommand(ha.NewCmd())
+	cmd.AddCommand(pool.NewCmd())
+	cmd.AddCommand(access.NewCmd())
+	cmd.AddCommand(firewall.NewCmd())
+	cmd.AddCommand(sdn.NewCmd())
 
 	return cmd
 }
@@ -0,0 +1,287 @@
+package sdn
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/spf13/cobra"
+)
+
+// sdnChange is one SDN object whose configuration differs from what is
+// deployed on the nodes.
+type sdnChange struct {
+	Kind    string                `json:"kind"`
+	ID      string                `json:"id"`
+	State   string                `json:"state"`
+	Changes map[string]fieldValue `json:"changes,omitempty"`
+}
+
+type fieldValue struct {
+	Old string `json:"old,omitempty"`
+	New string `json:"new,omitempty"`
+}
+
+// pendingSections lists the SDN objects in the order they are shown, with
+// the key holding each object's name.
+var pendingSections = []struct{ kind, path, key string }{
+	{"controller", "controllers", "controller"},
+	{"zone", "zones", "zone"},
+	{"vnet", "vnets", "vnet"},
+}
+
+// metaKeys are listing fields that are not part of an object's config.
+var metaKeys = map[string]bool{"digest": true, "pending": true, "state": true}
+
+// pendingChanges collects the undeployed changes of all SDN objects.
+func pendingChanges(ctx context.Context, cluster interfaces.ClusterInterface) ([]sdnChange, error) {
+	changes := []sdnChange{}
+	var vnets []string
+	for _, section := range pendingSections {
+		entries, err := cluster.SDNPending(ctx, section.path)
+		if err != nil {
+			return nil, fmt.Errorf("list pending SDN %ss: %w", section.kind, err)
+		}
+		found := diffEntries(section.kind, section.key, entries)
+		changes = append(changes, found...)
+		if section.kind != "vnet" {
+			continue
+		}
+		for _, entry := range entries {
+			if entry["state"] != "deleted" {
+				vnets = append(vnets, fmt.Sprint(entry["vnet"]))
+			}
+		}
+	}
+	sort.Strings(vnets)
+	for _, vnet := range vnets {
+		entries, err := cluster.SDNPending(ctx, "vnets/"+url.PathEscape(vnet)+"/subnets")
+		if err != nil {
+			return nil, fmt.Errorf("list pending subnets of vnet %q: %w", vnet, err)
+		}
+		changes = append(changes, diffEntries("subnet", "subnet", entries)...)
+	}
+	return changes, nil
+}
+
+// diffEntries turns a pending listing into changes. Proxmox marks new,
+// changed, and deleted objects with state and keeps undeployed values in a
+// pending object next to the running ones.
+func diffEntries(kind, key string, entries []map[string]any) []sdnChange {
+	changes := []sdnChange{}
+	for _, entry := range entries {
+		state, _ := entry["state"].(string)
+		if state == "" {
+			continue
+		}
+		change := sdnChange{Kind: kind, ID: fmt.Sprint(entry[key]), State: state, Changes: map[string]fieldValue{}}
+		pending, _ := entry["pending"].(map[string]any)
+		switch state {
+		case "new":
+			values := pending
+			if values == nil {
+				values = entry
+			}
+			for field, value := range values {
+				if field != key && !metaKeys[field] {
+					change.Changes[field] = fieldValue{New: formatValue(value)}
+				}
+			}
+		case "changed":
+			for field, value := range pending {
+				if field == key || metaKeys[field] {
+					continue
+				}
+				old := formatValue(entry[field])
+				if current := formatValue(value); current != old {
+					change.Changes[field] = fieldValue{Old: old, New: current}
+				}
+			}
+		}
+		changes = append(changes, change)
+	}
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].ID < changes[j].ID })
+	return changes
+}
+
+// formatValue renders a config value as Proxmox writes it, e.g. DHCP ranges
+// as start-address=...,end-address=... items.
+func formatValue(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case []any:
+		parts := make([]string, 0, len(value))
+		for _, item := range value {
+			parts = append(parts, formatValue(item))
+		}
+		return strings.Join(parts, " ")
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		parts := make([]string, 0, len(keys))
+		for _, key := range keys {
+			parts = append(parts, key+"="+formatValue(value[key]))
+		}
+		return strings.Join(parts, ",")
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+func printChanges(out io.Writer, changes []sdnChange) {
+	for _, change := range changes {
+		fields := make([]string, 0, len(change.Changes))
+		for field := range change.Changes {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		switch change.State {
+		case "new":
+			values := make([]string, 0, len(fields))
+			for _, field := range fields {
+				values = append(values, field+"="+change.Changes[field].New)
+			}
+			fmt.Fprintf(out, "+ %s %s", change.Kind, change.ID)
+			if len(values) > 0 {
+				fmt.Fprintf(out, " (%s)", strings.Join(values, " "))
+			}
+			fmt.Fprintln(out)
+		case "deleted":
+			fmt.Fprintf(out, "- %s %s\n", change.Kind, change.ID)
+		default:
+			fmt.Fprintf(out, "~ %s %s\n", change.Kind, change.ID)
+			for _, field := range fields {
+				value := change.Changes[field]
+				fmt.Fprintf(out, "    %s: %s -> %s\n", field, utility.DashIfEmpty(value.Old), utility.DashIfEmpty(value.New))
+			}
+		}
+	}
+}
+
+func newPendingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending",
+		Short: "Show SDN changes that are not deployed yet",
+		Long: `List SDN controllers, zones, vnets, and subnets that were added (+),
+changed (~), or deleted (-) since the last 'sdn apply'. With --node, also
+show the diff of the generated network and FRR configuration of that node.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			nodeName, err := cmd.Flags().GetString("node")
+			if err != nil {
+				return fmt.Errorf("get node flag: %w", err)
+			}
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			changes, err := pendingChanges(ctx, cluster)
+			if err != nil {
+				return err
+			}
+
+			if format == "json" {
+				return utility.PrintJSON(out, changes)
+			}
+			if len(changes) == 0 {
+				fmt.Fprintln(out, "No pending SDN changes")
+			} else {
+				printChanges(out, changes)
+			}
+			if nodeName == "" {
+				return nil
+			}
+			diff, err := cluster.SDNDryRun(ctx, nodeName)
+			if err != nil {
+				return fmt.Errorf("get SDN dry run for node %q: %w", nodeName, err)
+			}
+			for _, section := range []struct{ title, diff string }{
+				{"network interfaces", diff.InterfacesDiff},
+				{"FRR", diff.FRRDiff},
+			} {
+				if strings.TrimSpace(section.diff) == "" {
+					continue
+				}
+				fmt.Fprintf(out, "\n%s on node %s:\n%s", section.title, nodeName, section.diff)
+				if !strings.HasSuffix(section.diff, "\n") {
+					fmt.Fprintln(out)
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP("node", "n", "", "Also show the generated config diff of this node")
+	utility.RegisterNodeFlagCompletion(cmd, "node")
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newApplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Deploy pending SDN changes to all nodes",
+		Long: `Reload the SDN configuration on every node and wait for the task. Guests on
+changed vnets can lose connectivity briefly, so the pending changes are
+listed for confirmation first. With nothing pending the command stops
+unless --force is set, e.g. to redeploy to a node that missed the last
+apply. If the pending changes cannot be listed, a warning is printed and
+the apply still goes ahead after confirmation.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			force, err := cmd.Flags().GetBool("force")
+			if err != nil {
+				return fmt.Errorf("get force flag: %w", err)
+			}
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+
+			prompt := "Reload the SDN configuration on all nodes?"
+			changes, err := pendingChanges(ctx, cluster)
+			switch {
+			case err != nil:
+				fmt.Fprintf(cmd.ErrOrStderr(), "warning: %v; applying without a change list\n", err)
+			case len(changes) > 0:
+				printChanges(out, changes)
+				prompt = fmt.Sprintf("Apply %d pending SDN changes on all nodes?", len(changes))
+			case !force:
+				fmt.Fprintln(out, "No pending SDN changes; use --force to reload anyway")
+				return nil
+			}
+			if err := utility.ConfirmAction(cmd, prompt); err != nil {
+				return err
+			}
+
+			task, err := cluster.SDNApply(ctx)
+			if err != nil {
+				return fmt.Errorf("apply SDN configuration: %w", err)
+			}
+			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+				return fmt.Errorf("apply SDN configuration: %w", err)
+			}
+			fmt.Fprintln(out, "SDN configuration applied")
+			return nil
+		},
+	}
+	cmd.Flags().Bool("force", false, "Reload the SDN configuration even when nothing is pending")
+	utility.AddYesFlag(cmd)
+	return cmd
+}
@@ -0,0 +1,394 @@
+// Package sdn implements listing of software-defined networking zones,
+// vnets, subnets, controllers, and IPAM, and deployment of pending changes.
+package sdn
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sdn",
+		Short: "Inspect and deploy software-defined networking",
+		Long: `List the cluster's SDN configuration and deploy pending changes. Edits made
+in the web UI or API stay pending until 'proxmox-cli sdn apply' reloads the
+network on every node; 'sdn pending' shows what that would change.
+
+VNets can be used wherever a bridge is expected, e.g. 'vm nic add --bridge
+vnet20'.`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newZonesCmd())
+	cmd.AddCommand(newVNetsCmd())
+	cmd.AddCommand(newSubnetsCmd())
+	cmd.AddCommand(newControllersCmd())
+	cmd.AddCommand(newIPAMCmd())
+	cmd.AddCommand(newPendingCmd())
+	cmd.AddCommand(newApplyCmd())
+	return cmd
+}
+
+func clusterFromContext(ctx context.Context) (interfaces.ClusterInterface, error) {
+	client, err := utility.AuthenticatedClient()
+	if err != nil {
+		return nil, fmt.Errorf("authenticate Proxmox client: %w", err)
+	}
+	cluster, err := client.Cluster(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get cluster: %w", err)
+	}
+	return cluster, nil
+}
+
+func dashIfZero(value int) string {
+	if value == 0 {
+		return "-"
+	}
+	return strconv.Itoa(value)
+}
+
+func newZonesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "zones",
+		Aliases: []string{"zone"},
+		Short:   "List SDN zones",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			cluster, err := clusterFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+			zones, err := cluster.SDNZones(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list SDN zones: %w", err)
+			}
+			sort.Slice(zones, func(i, j int) bool { return zones[i].Name < zones[j].Name })
+
+			if format == "json" {
+				return utility.PrintJSON(out, zones)
+			}
+			if len(zones) == 0 {
+				fmt.Fprintln(out, "No SDN zones configured")
+				return nil
+			}
+			fmt.Fprintf(out, "%-16s %-8s %-6s %-12s %s\n", "Zone", "Type", "MTU", "IPAM", "Nodes")
+			fmt.Fprintf(out, "%-16s %-8s %-6s %-12s %s\n", "----", "----", "---", "----", "-----")
+			for _, zone := range zones {
+				nodes := "all"
+				if len(zone.Nodes) > 0 {
+					nodes = strings.Join(zone.Nodes, ",")
+				}
+				fmt.Fprintf(out, "%-16s %-8s %-6s %-12s %s\n", zone.Name, zone.Type, dashIfZero(zone.MTU), utility.DashIfEmpty(zone.IPAM), nodes)
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newVNetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "vnets",
+		Aliases: []string{"vnet"},
+		Short:   "List SDN vnets",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			zone, err := cmd.Flags().GetString("zone")
+			if err != nil {
+				return fmt.Errorf("get zone flag: %w", err)
+			}
+			cluster, err := clusterFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+			vnets, err := listVNets(cmd.Context(), cluster, zone)
+			if err != nil {
+				return err
+			}
+
+			if format == "json" {
+				return utility.PrintJSON(out, vnets)
+			}
+			if len(vnets) == 0 {
+				fmt.Fprintln(out, "No SDN vnets found")
+				return nil
+			}
+			fmt.Fprintf(out, "%-16s %-16s %-8s %-10s %s\n", "VNet", "Zone", "Tag", "VLAN-aware", "Alias")
+			fmt.Fprintf(out, "%-16s %-16s %-8s %-10s %s\n", "----", "----", "---", "----------", "-----")
+			for _, vnet := range vnets {
+				aware := "no"
+				if vnet.VlanAware == 1 {
+					aware = "yes"
+				}
+				fmt.Fprintf(out, "%-16s %-16s %-8s %-10s %s\n", vnet.Name, vnet.Zone, dashIfZero(int(vnet.Tag)), aware, utility.DashIfEmpty(vnet.Alias))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP("zone", "z", "", "Only list vnets of this zone")
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+// listVNets returns the vnets sorted by name, optionally limited to a zone.
+func listVNets(ctx context.Context, cluster interfaces.ClusterInterface, zone string) ([]*proxmox.VNet, error) {
+	all, err := cluster.SDNVNets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list SDN vnets: %w", err)
+	}
+	vnets := make([]*proxmox.VNet, 0, len(all))
+	for _, vnet := range all {
+		if vnet != nil && (zone == "" || vnet.Zone == zone) {
+			vnets = append(vnets, vnet)
+		}
+	}
+	sort.Slice(vnets, func(i, j int) bool { return vnets[i].Name < vnets[j].Name })
+	return vnets, nil
+}
+
+func newSubnetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "subnets",
+		Aliases: []string{"subnet"},
+		Short:   "List SDN subnets with gateway, SNAT, and DHCP ranges",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			vnetName, err := cmd.Flags().GetString("vnet")
+			if err != nil {
+				return fmt.Errorf("get vnet flag: %w", err)
+			}
+			cluster, err := clusterFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			names := []string{vnetName}
+			if vnetName == "" {
+				vnets, err := listVNets(ctx, cluster, "")
+				if err != nil {
+					return err
+				}
+				names = names[:0]
+				for _, vnet := range vnets {
+					names = append(names, vnet.Name)
+				}
+			}
+			subnets := []*proxmox.VNetSubnet{}
+			for _, name := range names {
+				found, err := cluster.SDNSubnets(ctx, name)
+				if err != nil {
+					return fmt.Errorf("list subnets of vnet %q: %w", name, err)
+				}
+				for _, subnet := range found {
+					if subnet == nil {
+						continue
+					}
+					if subnet.VNet == "" {
+						subnet.VNet = name
+					}
+					subnets = append(subnets, subnet)
+				}
+			}
+
+			if format == "json" {
+				return utility.PrintJSON(out, subnets)
+			}
+			if len(subnets) == 0 {
+				fmt.Fprintln(out, "No SDN subnets found")
+				return nil
+			}
+			fmt.Fprintf(out, "%-20s %-16s %-16s %-5s %s\n", "Subnet", "VNet", "Gateway", "SNAT", "DHCP ranges")
+			fmt.Fprintf(out, "%-20s %-16s %-16s %-5s %s\n", "------", "----", "-------", "----", "-----------")
+			for _, subnet := range subnets {
+				snat := "no"
+				if subnet.SNAT == 1 {
+					snat = "yes"
+				}
+				fmt.Fprintf(out, "%-20s %-16s %-16s %-5s %s\n", subnetCIDR(subnet), subnet.VNet, utility.DashIfEmpty(subnet.Gateway), snat, utility.DashIfEmpty(dhcpRanges(subnet.DhcpRange)))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String("vnet", "", "Only list subnets of this vnet")
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+// subnetCIDR returns the subnet's CIDR, which older releases only encode in
+// the ID, e.g. zone1-10.0.0.0-24.
+func subnetCIDR(subnet *proxmox.VNetSubnet) string {
+	if subnet.CIDR != "" {
+		return subnet.CIDR
+	}
+	id := subnet.ID
+	if _, rest, ok := strings.Cut(id, "-"); ok {
+		if cut := strings.LastIndex(rest, "-"); cut > 0 {
+			return rest[:cut] + "/" + rest[cut+1:]
+		}
+	}
+	return utility.DashIfEmpty(id)
+}
+
+func dhcpRanges(ranges []proxmox.NetRange) string {
+	parts := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		parts = append(parts, r.StartAddress+"-"+r.EndAddress)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func newControllersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "controllers",
+		Aliases: []string{"controller"},
+		Short:   "List SDN controllers",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			cluster, err := clusterFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+			controllers, err := cluster.SDNControllers(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list SDN controllers: %w", err)
+			}
+			sort.Slice(controllers, func(i, j int) bool { return controllers[i].Controller < controllers[j].Controller })
+
+			if format == "json" {
+				return utility.PrintJSON(out, controllers)
+			}
+			if len(controllers) == 0 {
+				fmt.Fprintln(out, "No SDN controllers configured")
+				return nil
+			}
+			fmt.Fprintf(out, "%-16s %-6s %-10s %s\n", "Controller", "Type", "ASN", "Peers/Node")
+			fmt.Fprintf(out, "%-16s %-6s %-10s %s\n", "----------", "----", "---", "----------")
+			for _, controller := range controllers {
+				target := controller.Peers
+				if target == "" {
+					target = controller.Node
+				}
+				fmt.Fprintf(out, "%-16s %-6s %-10s %s\n", controller.Controller, controller.Type, dashIfZero(int(controller.ASN)), utility.DashIfEmpty(target))
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func newIPAMCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ipam [ipam]",
+		Short: "List IPAM backends or the addresses one has allocated",
+		Long: `Without arguments, list the IPAM backends. With a name, list the addresses
+that backend has handed out, e.g. 'proxmox-cli sdn ipam pve'.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			cluster, err := clusterFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if len(args) == 1 {
+				return printIPAMStatus(cmd, cluster, args[0], format)
+			}
+			ipams, err := cluster.SDNIPAMs(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list IPAM backends: %w", err)
+			}
+			sort.Slice(ipams, func(i, j int) bool { return ipams[i].IPAM < ipams[j].IPAM })
+
+			if format == "json" {
+				return utility.PrintJSON(out, ipams)
+			}
+			if len(ipams) == 0 {
+				fmt.Fprintln(out, "No IPAM backends configured")
+				return nil
+			}
+			fmt.Fprintf(out, "%-16s %-8s %s\n", "IPAM", "Type", "URL")
+			fmt.Fprintf(out, "%-16s %-8s %s\n", "----", "----", "---")
+			for _, ipam := range ipams {
+				fmt.Fprintf(out, "%-16s %-8s %s\n", ipam.IPAM, ipam.Type, utility.DashIfEmpty(ipam.URL))
+			}
+			return nil
+		},
+	}
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func printIPAMStatus(cmd *cobra.Command, cluster interfaces.ClusterInterface, name, format string) error {
+	out := cmd.OutOrStdout()
+	entries, err := cluster.SDNIPAMStatus(cmd.Context(), name)
+	if err != nil {
+		return fmt.Errorf("get allocations of IPAM %q: %w", name, err)
+	}
+	field := func(entry map[string]any, key string) string {
+		if value, ok := entry[key]; ok && value != nil {
+			return fmt.Sprint(value)
+		}
+		return ""
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if a, b := field(entries[i], "vnet"), field(entries[j], "vnet"); a != b {
+			return a < b
+		}
+		return field(entries[i], "ip") < field(entries[j], "ip")
+	})
+
+	if format == "json" {
+		return utility.PrintJSON(out, entries)
+	}
+	if len(entries) == 0 {
+		fmt.Fprintf(out, "No addresses allocated in IPAM %s\n", name)
+		return nil
+	}
+	fmt.Fprintf(out, "%-16s %-40s %-18s %-8s %s\n", "VNet", "IP", "MAC", "VMID", "Hostname")
+	fmt.Fprintf(out, "%-16s %-40s %-18s %-8s %s\n", "----", "--", "---", "----", "--------")
+	for _, entry := range entries {
+		hostname := field(entry, "hostname")
+		if field(entry, "gateway") == "1" {
+			hostname = "(gateway)"
+		}
+		fmt.Fprintf(out, "%-16s %-40s %-18s %-8s %s\n",
+			utility.DashIfEmpty(field(entry, "vnet")), field(entry, "ip"), utility.DashIfEmpty(field(entry, "mac")),
+			utility.DashIfEmpty(field(entry, "vmid")), utility.DashIfEmpty(hostname))
+	}
+	return nil
+}
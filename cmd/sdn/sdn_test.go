@@ -0,0 +1,198 @@
+package sdn
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/viper"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/Adz-ai/proxmox-cli/test/mocks"
+)
+
+func setupSDNMocks(t *testing.T) *mocks.MockClusterInterface {
+	t.Helper()
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set("server_url", "https://pve.example.com:8006")
+	viper.Set("auth_ticket.ticket", "ticket")
+	viper.Set("auth_ticket.CSRFPreventionToken", "token")
+
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockProxmoxClientInterface(ctrl)
+	cluster := mocks.NewMockClusterInterface(ctrl)
+	client.EXPECT().Cluster(gomock.Any()).Return(cluster, nil).AnyTimes()
+	utility.SetClientFactory(func() interfaces.ProxmoxClientInterface { return client })
+	t.Cleanup(utility.ResetClientFactory)
+	return cluster
+}
+
+func runSDN(t *testing.T, input string, args ...string) (string, error) {
+	t.Helper()
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetIn(strings.NewReader(input))
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestSubnetsListsAllVNets(t *testing.T) {
+	cluster := setupSDNMocks(t)
+	cluster.EXPECT().SDNVNets(gomock.Any()).Return([]*proxmox.VNet{
+		{Name: "vnet30", Zone: "vlans"},
+		{Name: "vnet20", Zone: "vlans"},
+	}, nil)
+	cluster.EXPECT().SDNSubnets(gomock.Any(), "vnet20").Return([]*proxmox.VNetSubnet{{
+		ID: "vlans-10.20.0.0-24", Gateway: "10.20.0.1", SNAT: 1,
+		DhcpRange: []proxmox.NetRange{{StartAddress: "10.20.0.100", EndAddress: "10.20.0.199"}},
+	}}, nil)
+	cluster.EXPECT().SDNSubnets(gomock.Any(), "vnet30").Return(nil, nil)
+
+	out, err := runSDN(t, "", "subnets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"10.20.0.0/24", "vnet20", "10.20.0.1", "yes", "10.20.0.100-10.20.0.199"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestVNetsFiltersByZone(t *testing.T) {
+	cluster := setupSDNMocks(t)
+	cluster.EXPECT().SDNVNets(gomock.Any()).Return([]*proxmox.VNet{
+		{Name: "vnet20", Zone: "vlans", Tag: 20},
+		{Name: "overlay1", Zone: "vx", Tag: 10001},
+	}, nil)
+
+	out, err := runSDN(t, "", "vnets", "--zone", "vlans")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "vnet20") || strings.Contains(out, "overlay1") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func expectPending(cluster *mocks.MockClusterInterface) {
+	cluster.EXPECT().SDNPending(gomock.Any(), "controllers").Return(nil, nil)
+	cluster.EXPECT().SDNPending(gomock.Any(), "zones").Return([]map[string]any{
+		{"zone": "vlans", "type": "vlan", "bridge": "vmbr0"},
+		{"zone": "vx", "state": "new", "pending": map[string]any{"zone": "vx", "type": "vxlan", "peers": "10.0.0.1,10.0.0.2"}},
+	}, nil)
+	cluster.EXPECT().SDNPending(gomock.Any(), "vnets").Return([]map[string]any{
+		{"vnet": "vnet20", "zone": "vlans", "tag": float64(20), "state": "changed", "pending": map[string]any{"tag": float64(30), "zone": "vlans"}},
+		{"vnet": "old", "zone": "vlans", "state": "deleted"},
+	}, nil)
+	cluster.EXPECT().SDNPending(gomock.Any(), "vnets/vnet20/subnets").Return(nil, nil)
+}
+
+func TestPendingShowsChanges(t *testing.T) {
+	cluster := setupSDNMocks(t)
+	expectPending(cluster)
+	cluster.EXPECT().SDNDryRun(gomock.Any(), "pve1").Return(&proxmox.SDNDryRun{InterfacesDiff: "+auto vx1"}, nil)
+
+	out, err := runSDN(t, "", "pending", "-n", "pve1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"+ zone vx (peers=10.0.0.1,10.0.0.2 type=vxlan)\n",
+		"- vnet old\n",
+		"~ vnet vnet20\n    tag: 20 -> 30\n",
+		"network interfaces on node pve1:\n+auto vx1\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "vlans") {
+		t.Fatalf("unchanged objects should not be listed:\n%s", out)
+	}
+}
+
+func TestApplyWaitsForTask(t *testing.T) {
+	cluster := setupSDNMocks(t)
+	expectPending(cluster)
+	cluster.EXPECT().SDNApply(gomock.Any()).Return(&proxmox.Task{IsSuccessful: true}, nil)
+
+	out, err := runSDN(t, "", "apply", "--yes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "SDN configuration applied") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestApplyWithoutChangesDoesNothing(t *testing.T) {
+	cluster := setupSDNMocks(t)
+	for _, path := range []string{"controllers", "zones", "vnets"} {
+		cluster.EXPECT().SDNPending(gomock.Any(), path).Return(nil, nil)
+	}
+
+	out, err := runSDN(t, "", "apply")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "No pending SDN changes") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestApplyForceReloadsWithoutChanges(t *testing.T) {
+	cluster := setupSDNMocks(t)
+	for _, path := range []string{"controllers", "zones", "vnets"} {
+		cluster.EXPECT().SDNPending(gomock.Any(), path).Return(nil, nil)
+	}
+	cluster.EXPECT().SDNApply(gomock.Any()).Return(&proxmox.Task{IsSuccessful: true}, nil)
+
+	out, err := runSDN(t, "", "apply", "--force", "--yes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "SDN configuration applied") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestApplyContinuesWhenPendingListingFails(t *testing.T) {
+	cluster := setupSDNMocks(t)
+	cluster.EXPECT().SDNPending(gomock.Any(), "controllers").Return(nil, errors.New("500 Internal Server Error"))
+	cluster.EXPECT().SDNApply(gomock.Any()).Return(&proxmox.Task{IsSuccessful: true}, nil)
+
+	out, err := runSDN(t, "", "apply", "--yes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "warning: list pending SDN controllers") || !strings.Contains(out, "SDN configuration applied") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestIPAMStatusMarksGateway(t *testing.T) {
+	cluster := setupSDNMocks(t)
+	cluster.EXPECT().SDNIPAMStatus(gomock.Any(), "pve").Return([]map[string]any{
+		{"vnet": "vnet20", "ip": "10.20.0.100", "mac": "BC:24:11:00:00:01", "vmid": "100", "hostname": "web1"},
+		{"vnet": "vnet20", "ip": "10.20.0.1", "gateway": float64(1)},
+	}, nil)
+
+	out, err := runSDN(t, "", "ipam", "pve")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gateway := strings.Index(out, "(gateway)")
+	web := strings.Index(out, "web1")
+	if gateway < 0 || web < gateway {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 // AddYesFlag registers the shared --yes flag on a destructive command.
@@ -42,3 +44,74 @@ func ConfirmAction(cmd *cobra.Command, warning string) error {
 		return errors.New("aborted; pass --yes to skip this prompt")
 	}
 }
+
+// AddConfirmFlag registers --confirm for commands guarded by ConfirmTyped.
+func AddConfirmFlag(cmd *cobra.Command, usage string) {
+	cmd.Flags().String("confirm", "", usage)
+}
+
+// ConfirmTyped makes the user type expected, e.g. a device path, before an
+// irreversible action. --confirm with the same value answers it
+// non-interactively; --yes is deliberately not enough.
+func ConfirmTyped(cmd *cobra.Command, warning, expected string) error {
+	confirmed, err := cmd.Flags().GetString("confirm")
+	if err != nil {
+		return fmt.Errorf("read confirm flag: %w", err)
+	}
+	if confirmed != "" {
+		if confirmed != expected {
+			return fmt.Errorf("--confirm %q does not match %q", confirmed, expected)
+		}
+		return nil
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "%s\nType %s to confirm: ", warning, expected)
+
+	reader := bufio.NewReader(cmd.InOrStdin())
+	line, err := reader.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("read confirmation: %w", err)
+	}
+	if strings.TrimSpace(line) != expected {
+		return fmt.Errorf("aborted; type %s or pass --confirm %s", expected, expected)
+	}
+	return nil
+}
+
+// PromptSecret reads a secret without echoing when stdin is a terminal, and
+// falls back to a plain line read for piped input. The line is read without
+// buffering so several prompts can share one piped stdin.
+func PromptSecret(cmd *cobra.Command, prompt string) (string, error) {
+	out := cmd.OutOrStdout()
+	in := cmd.InOrStdin()
+
+	if file, ok := in.(*os.File); ok && term.IsTerminal(int(file.Fd())) {
+		fmt.Fprint(out, prompt)
+		byteSecret, err := term.ReadPassword(int(file.Fd()))
+		if err != nil {
+			return "", fmt.Errorf("read secret: %w", err)
+		}
+		fmt.Fprintln(out)
+		return strings.TrimSpace(string(byteSecret)), nil
+	}
+
+	var secret []byte
+	buf := make([]byte, 1)
+	for {
+		n, err := in.Read(buf)
+		if n > 0 {
+			if buf[0] == '\n' {
+				break
+			}
+			secret = append(secret, buf[0])
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("read secret: %w", err)
+		}
+	}
+	return strings.TrimSpace(string(secret)), nil
+}
@@ -51,3 +51,50 @@ func TestConfirmActionSkippedWithYesFlag(t *testing.T) {
 		t.Fatalf("no prompt expected with --yes, got:\n%s", out.String())
 	}
 }
+
+func TestConfirmTypedRequiresExactValue(t *testing.T) {
+	for input, ok := range map[string]bool{"/dev/sdb\n": true, " /dev/sdb \n": true, "y\n": false, "/dev/sdc\n": false, "": false} {
+		cmd, out := newConfirmTestCmd(input)
+		AddConfirmFlag(cmd, "Device to confirm")
+		err := ConfirmTyped(cmd, "This erases /dev/sdb.", "/dev/sdb")
+		if (err == nil) != ok {
+			t.Errorf("input %q: got error %v, want ok=%v", input, err, ok)
+		}
+		if !strings.Contains(out.String(), "Type /dev/sdb to confirm:") {
+			t.Errorf("input %q: prompt not shown:\n%s", input, out.String())
+		}
+	}
+
+	cmd, out := newConfirmTestCmd("")
+	AddConfirmFlag(cmd, "Device to confirm")
+	if err := cmd.Flags().Set("yes", "true"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ConfirmTyped(cmd, "This erases /dev/sdb.", "/dev/sdb"); err == nil {
+		t.Fatal("--yes alone must not confirm")
+	}
+	if err := cmd.Flags().Set("confirm", "/dev/sdb"); err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	if err := ConfirmTyped(cmd, "This erases /dev/sdb.", "/dev/sdb"); err != nil || out.Len() != 0 {
+		t.Fatalf("--confirm should answer without a prompt, got %v:\n%s", err, out.String())
+	}
+}
+
+func TestPromptSecretReadsSuccessiveLines(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.SetIn(strings.NewReader("  s3cret \nnext\n"))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	for _, want := range []string{"s3cret", "next"} {
+		secret, err := PromptSecret(cmd, "Secret: ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if secret != want {
+			t.Fatalf("PromptSecret = %q, want %q", secret, want)
+		}
+	}
+}
@@ -2,15 +2,25 @@ package utility
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 )
 
+// consoleQuietPeriod is how long the console must stay silent before a
+// prompt is assumed to be complete.
+const consoleQuietPeriod = time.Second
+
 // RunConsole pumps a termproxy websocket to and from the local terminal
 // until the user presses Ctrl+] or the connection closes. The caller's
 // closer is always invoked on return.
@@ -83,3 +93,168 @@ func RunConsoleStreams(ctx context.Context, stdin io.Reader, out io.Writer, send
 		}
 	}
 }
+
+// ShellQuote joins args into a POSIX shell command line, single-quoting
+// each argument.
+func ShellQuote(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
+
+// ConsoleSession drives a termproxy websocket like a user at the keyboard.
+// Everything received is appended to output with CRLF normalised to LF.
+type ConsoleSession struct {
+	send   chan []byte
+	recv   chan []byte
+	errs   chan error
+	output strings.Builder
+}
+
+// NewConsoleSession wraps the channels returned by a TermWebSocket call.
+func NewConsoleSession(send, recv chan []byte, errs chan error) *ConsoleSession {
+	return &ConsoleSession{send: send, recv: recv, errs: errs}
+}
+
+// Run logs in if needed, runs command between sentinel markers, and returns
+// what it printed along with its exit status.
+func (s *ConsoleSession) Run(ctx context.Context, user string, password func() (string, error), command string) (string, int, error) {
+	// Wake the console so it shows either a login or a shell prompt.
+	if err := s.write(ctx, "\r"); err != nil {
+		return "", 0, err
+	}
+	if err := s.waitQuiet(ctx); err != nil {
+		return "", 0, err
+	}
+
+	loggedIn := false
+	if strings.HasSuffix(strings.TrimSpace(s.output.String()), "login:") {
+		secret, err := password()
+		if err != nil {
+			return "", 0, err
+		}
+		if err := s.write(ctx, user+"\r"); err != nil {
+			return "", 0, err
+		}
+		if _, err := s.waitFor(ctx, regexp.MustCompile(`(?i)password:\s*$`)); err != nil {
+			return "", 0, fmt.Errorf("wait for password prompt: %w", err)
+		}
+		if err := s.write(ctx, secret+"\r"); err != nil {
+			return "", 0, err
+		}
+		if err := s.waitQuiet(ctx); err != nil {
+			return "", 0, err
+		}
+		tail := s.output.String()
+		if strings.Contains(tail, "Login incorrect") || strings.HasSuffix(strings.TrimSpace(tail), "login:") {
+			return "", 0, fmt.Errorf("console login as %s failed", user)
+		}
+		loggedIn = true
+	}
+
+	token, err := sentinelToken()
+	if err != nil {
+		return "", 0, err
+	}
+	// The markers are printed as TOKEN_BEGIN/TOKEN_END but typed as
+	// "TOKEN BEGIN", so the terminal echo of this line never matches.
+	start := s.output.Len()
+	line := fmt.Sprintf("printf '%%s_%%s\\n' %s BEGIN; %s </dev/null; printf '\\n%%s_%%s %%d\\n' %s END \"$?\"\r", token, command, token)
+	if err := s.write(ctx, line); err != nil {
+		return "", 0, err
+	}
+	end := regexp.MustCompile(`(?s)` + token + `_BEGIN\n(.*?)\n?` + token + `_END (\d+)\n`)
+	match, err := s.waitFor(ctx, end, start)
+	if err != nil {
+		return "", 0, fmt.Errorf("wait for command to finish: %w", err)
+	}
+	exitCode, err := strconv.Atoi(match[2])
+	if err != nil {
+		return "", 0, fmt.Errorf("parse exit status %q: %w", match[2], err)
+	}
+
+	if loggedIn {
+		// Do not leave a logged-in root console behind.
+		if err := s.write(ctx, "exit\r"); err != nil {
+			return "", 0, err
+		}
+	}
+	return match[1], exitCode, nil
+}
+
+func sentinelToken() (string, error) {
+	buffer := make([]byte, 8)
+	if _, err := rand.Read(buffer); err != nil {
+		return "", fmt.Errorf("generate sentinel: %w", err)
+	}
+	return "PVECLI" + strings.ToUpper(hex.EncodeToString(buffer)), nil
+}
+
+func (s *ConsoleSession) write(ctx context.Context, text string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case s.send <- []byte(text):
+		return nil
+	}
+}
+
+// receive appends the next message to the output, waiting at most timeout
+// (forever when zero). It reports false when the timeout elapsed.
+func (s *ConsoleSession) receive(ctx context.Context, timeout time.Duration) (bool, error) {
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case <-expired:
+		return false, nil
+	case err, open := <-s.errs:
+		if !open || err == nil {
+			return false, errors.New("console connection closed")
+		}
+		return false, fmt.Errorf("console connection error: %w", err)
+	case msg, open := <-s.recv:
+		if !open {
+			return false, errors.New("console connection closed")
+		}
+		s.output.WriteString(strings.ReplaceAll(string(msg), "\r\n", "\n"))
+		return true, nil
+	}
+}
+
+// waitQuiet reads until the console has been silent for consoleQuietPeriod.
+func (s *ConsoleSession) waitQuiet(ctx context.Context) error {
+	for {
+		received, err := s.receive(ctx, consoleQuietPeriod)
+		if err != nil {
+			return err
+		}
+		if !received {
+			return nil
+		}
+	}
+}
+
+// waitFor reads until pattern matches the output after the optional start
+// offset and returns the submatches.
+func (s *ConsoleSession) waitFor(ctx context.Context, pattern *regexp.Regexp, start ...int) ([]string, error) {
+	offset := 0
+	if len(start) > 0 {
+		offset = start[0]
+	}
+	for {
+		if match := pattern.FindStringSubmatch(s.output.String()[offset:]); match != nil {
+			return match, nil
+		}
+		if _, err := s.receive(ctx, 0); err != nil {
+			return nil, err
+		}
+	}
+}
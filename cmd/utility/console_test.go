@@ -0,0 +1,57 @@
+package utility
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestConsoleSessionLogsInAndCapturesExitStatus(t *testing.T) {
+	send := make(chan []byte)
+	recv := make(chan []byte, 4)
+	errs := make(chan error)
+	typed := make(chan string, 8)
+
+	// A fake console: login prompt, then a shell that runs the sentinel line.
+	go func() {
+		for msg := range send {
+			input := string(msg)
+			typed <- input
+			switch {
+			case input == "\r":
+				recv <- []byte("\r\nct login: ")
+			case input == "root\r":
+				recv <- []byte("root\r\nPassword: ")
+			case input == "secret\r":
+				recv <- []byte("\r\nroot@ct:~# ")
+			case strings.HasPrefix(input, "printf"):
+				token := strings.Fields(input)[2]
+				recv <- []byte(input + "\n\x1b[?2004l\r" + token + "_BEGIN\r\nhello world\r\n\r\n" + token + "_END 3\r\nroot@ct:~# ")
+			}
+		}
+	}()
+	t.Cleanup(func() { close(send) })
+
+	session := NewConsoleSession(send, recv, errs)
+	output, exitCode, err := session.Run(context.Background(), "root", func() (string, error) { return "secret", nil }, ShellQuote([]string{"echo", "hello world"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output != "hello world\n" || exitCode != 3 {
+		t.Errorf("got output %q exit %d, want %q exit 3", output, exitCode, "hello world\n")
+	}
+
+	lines := make([]string, 5)
+	for i := range lines {
+		lines[i] = <-typed
+	}
+	if !strings.Contains(lines[3], "'echo' 'hello world' </dev/null") || lines[4] != "exit\r" {
+		t.Errorf("unexpected console input %q", lines)
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	if got := ShellQuote([]string{"sh", "-c", "echo 'hi' | wc -c"}); got != `'sh' '-c' 'echo '\''hi'\'' | wc -c'` {
+		t.Errorf("unexpected quoting %s", got)
+	}
+}
@@ -0,0 +1,59 @@
+package utility
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/luthermonson/go-proxmox"
+)
+
+// LogPageSize is how many lines are requested per call while paging through
+// a line-numbered log such as the node syslog or the firewall log.
+const LogPageSize = 1000
+
+var logTimeLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+// ParseLogTime accepts a duration before now (15m, 2h) or an absolute time
+// in local time unless it carries a zone.
+func ParseLogTime(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if duration, err := time.ParseDuration(value); err == nil {
+		if duration < 0 {
+			return time.Time{}, fmt.Errorf("duration %q cannot be negative", value)
+		}
+		return now.Add(-duration), nil
+	}
+	for _, layout := range logTimeLayouts {
+		if parsed, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("cannot parse %q; use a duration like 2h or a time like 2006-01-02 15:04", value)
+}
+
+// ReadLogPages pages through a line-numbered log window and returns the
+// lines after line number after. fetch requests limit lines from start.
+func ReadLogPages(ctx context.Context, after int, fetch func(ctx context.Context, start, limit int) ([]*proxmox.LogEntry, error)) ([]*proxmox.LogEntry, error) {
+	var entries []*proxmox.LogEntry
+	for {
+		batch, err := fetch(ctx, after, LogPageSize)
+		if err != nil {
+			return nil, err
+		}
+		added := 0
+		for _, entry := range batch {
+			// An empty window is reported as a single "no content" line.
+			if entry == nil || entry.N <= after || (entry.T == "no content" && len(batch) == 1) {
+				continue
+			}
+			entries = append(entries, entry)
+			after = entry.N
+			added++
+		}
+		if added == 0 || len(batch) < LogPageSize {
+			return entries, nil
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package utility
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/luthermonson/go-proxmox"
+)
+
+func TestReadLogPagesSkipsEmptyWindowMarker(t *testing.T) {
+	var starts []int
+	fetch := func(_ context.Context, start, limit int) ([]*proxmox.LogEntry, error) {
+		starts = append(starts, start)
+		if limit != LogPageSize {
+			t.Errorf("limit = %d, want %d", limit, LogPageSize)
+		}
+		return []*proxmox.LogEntry{{N: 0, T: "no content"}}, nil
+	}
+	entries, err := ReadLogPages(context.Background(), 7, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 || len(starts) != 1 || starts[0] != 7 {
+		t.Fatalf("entries = %v, starts = %v", entries, starts)
+	}
+}
+
+func TestParseLogTime(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	for value, want := range map[string]time.Time{
+		"90m":                  now.Add(-90 * time.Minute),
+		"2026-10-18 08:15":     time.Date(2026, 10, 18, 8, 15, 0, 0, time.UTC),
+		"2026-10-18":           time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		"2026-10-18T08:15:00Z": time.Date(2026, 10, 18, 8, 15, 0, 0, time.UTC),
+	} {
+		got, err := ParseLogTime(value, now)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ParseLogTime(%q) = %v, %v; want %v", value, got, err, want)
+		}
+	}
+	if _, err := ParseLogTime("yesterday", now); err == nil {
+		t.Error("expected error for unparseable time")
+	}
+}
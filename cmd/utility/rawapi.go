@@ -0,0 +1,53 @@
+package utility
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// rawAPI issues requests that need more of the response than go-proxmox
+// returns, such as attributes Proxmox sends next to the data key. It uses
+// the same HTTP client and credentials as the regular client.
+type rawAPI struct {
+	httpClient *http.Client
+	baseURL    string
+	header     http.Header
+}
+
+func (r *rawAPI) useAPIToken(tokenID, secret string) {
+	r.header.Set("Authorization", "PVEAPIToken="+tokenID+"="+secret)
+}
+
+func (r *rawAPI) useSession(ticket string) {
+	r.header.Set("Cookie", "PVEAuthCookie="+ticket)
+}
+
+// get decodes the full JSON body of a GET request into v.
+func (r *rawAPI) get(ctx context.Context, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	for key, values := range r.header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	res, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", path, res.Status)
+	}
+	return json.Unmarshal(body, v)
+}
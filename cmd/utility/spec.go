@@ -0,0 +1,255 @@
+package utility
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// specVariablePattern matches ${name}, ${name:-default}, and the $$ escape.
+var specVariablePattern = regexp.MustCompile(`\$\$|\$\{([A-Za-z_][A-Za-z0-9_.-]*)(:-([^}]*))?\}`)
+
+// AddSpecValueFlags registers --set and --values on commands that load YAML
+// specs through LoadSpecs.
+func AddSpecValueFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray("set", nil, "Set a spec variable, e.g. --set hostname=web1 (repeatable)")
+	cmd.Flags().StringArray("values", nil, "YAML file of spec variables (repeatable; later files win)")
+}
+
+// SpecValuesFromFlags merges the --values files in order and then the --set
+// pairs on top, so the command line always wins.
+func SpecValuesFromFlags(cmd *cobra.Command) (map[string]any, error) {
+	files, err := cmd.Flags().GetStringArray("values")
+	if err != nil {
+		return nil, fmt.Errorf("read values flag: %w", err)
+	}
+	pairs, err := cmd.Flags().GetStringArray("set")
+	if err != nil {
+		return nil, fmt.Errorf("read set flag: %w", err)
+	}
+
+	values := map[string]any{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read values file %q: %w", file, err)
+		}
+		var fileValues map[string]any
+		if err := yaml.Unmarshal(data, &fileValues); err != nil {
+			return nil, fmt.Errorf("parse values file %q: %w", file, err)
+		}
+		values = mergeSpec(values, fileValues)
+	}
+	for _, pair := range pairs {
+		key, value, found := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid --set %q; use key=value", pair)
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+// LoadSpecs reads a YAML spec file and returns one map per document.
+//
+// Before parsing, the file is rendered as a Go template with values as its
+// data (plus an env function). After parsing, ${name} or ${name:-default}
+// references in scalars are replaced from values, falling back to the
+// environment; $$ yields a literal $. A document with an "extends: base.yaml" key is merged over
+// that file (resolved relative to the including file), recursively.
+func LoadSpecs(path string, values map[string]any) ([]map[string]any, error) {
+	return loadSpecFile(path, values, nil)
+}
+
+func loadSpecFile(path string, values map[string]any, seen []string) ([]map[string]any, error) {
+	absolute, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("resolve %q: %w", path, err)
+	}
+	for _, parent := range seen {
+		if parent == absolute {
+			return nil, fmt.Errorf("extends cycle: %s", strings.Join(append(seen, absolute), " -> "))
+		}
+	}
+	seen = append(seen, absolute)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+	rendered, err := renderSpec(filepath.Base(path), string(data), values)
+	if err != nil {
+		return nil, err
+	}
+
+	// Variables are substituted into the parsed scalars rather than the
+	// text, so comments are skipped and a value containing ':', '#', or a
+	// newline cannot change the document structure.
+	var documents []*yaml.Node
+	decoder := yaml.NewDecoder(strings.NewReader(rendered))
+	for document := 1; ; document++ {
+		var node yaml.Node
+		if err := decoder.Decode(&node); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("parse YAML document %d: %w", document, err)
+		}
+		documents = append(documents, &node)
+	}
+	var missing []string
+	for _, node := range documents {
+		substituteSpecVariables(node, values, &missing)
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("undefined spec variables: %s (pass them with --set, --values, or the environment)", strings.Join(missing, ", "))
+	}
+
+	specs := []map[string]any{}
+	for i, node := range documents {
+		document := i + 1
+		var spec map[string]any
+		if err := node.Decode(&spec); err != nil {
+			return nil, fmt.Errorf("parse YAML document %d: %w", document, err)
+		}
+		if spec == nil {
+			continue
+		}
+		if spec, err = resolveExtends(path, spec, values, seen); err != nil {
+			return nil, fmt.Errorf("document %d: %w", document, err)
+		}
+		specs = append(specs, spec)
+	}
+	if len(specs) == 0 {
+		return nil, errors.New("spec file has no documents")
+	}
+	return specs, nil
+}
+
+func resolveExtends(path string, spec map[string]any, values map[string]any, seen []string) (map[string]any, error) {
+	raw, ok := spec["extends"]
+	if !ok {
+		return spec, nil
+	}
+	delete(spec, "extends")
+	base, ok := raw.(string)
+	if !ok || strings.TrimSpace(base) == "" {
+		return nil, errors.New("extends must be a file path")
+	}
+	if !filepath.IsAbs(base) {
+		base = filepath.Join(filepath.Dir(path), base)
+	}
+
+	parents, err := loadSpecFile(base, values, seen)
+	if err != nil {
+		return nil, fmt.Errorf("extends %q: %w", base, err)
+	}
+	if len(parents) != 1 {
+		return nil, fmt.Errorf("extends %q: base spec must contain exactly one document", base)
+	}
+	return mergeSpec(parents[0], spec), nil
+}
+
+// mergeSpec returns base overlaid with override. Nested maps are merged
+// recursively and a null in override removes the key.
+func mergeSpec(base, override map[string]any) map[string]any {
+	merged := make(map[string]any, len(base)+len(override))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range override {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		overrideMap, overrideIsMap := value.(map[string]any)
+		baseMap, baseIsMap := merged[key].(map[string]any)
+		if overrideIsMap && baseIsMap {
+			merged[key] = mergeSpec(baseMap, overrideMap)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
+
+func renderSpec(name, text string, values map[string]any) (string, error) {
+	tmpl, err := template.New(name).
+		Option("missingkey=error").
+		Funcs(template.FuncMap{"env": os.Getenv}).
+		Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("parse template: %w", err)
+	}
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, values); err != nil {
+		return "", fmt.Errorf("render template: %w", err)
+	}
+
+	return rendered.String(), nil
+}
+
+// substituteSpecVariables replaces ${name} references in the scalars under
+// node. Plain scalars have their tag cleared so a substituted value is
+// typed as if it had been written there, e.g. ${cores} set to "4" decodes
+// as a number; quoted scalars stay strings.
+func substituteSpecVariables(node *yaml.Node, values map[string]any, missing *[]string) {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return
+	case yaml.ScalarNode:
+		if !strings.Contains(node.Value, "$") {
+			return
+		}
+		node.Value = specVariablePattern.ReplaceAllStringFunc(node.Value, func(match string) string {
+			if match == "$$" {
+				return "$"
+			}
+			groups := specVariablePattern.FindStringSubmatch(match)
+			if value, ok := lookupSpecValue(values, groups[1]); ok {
+				return fmt.Sprint(value)
+			}
+			if value, ok := os.LookupEnv(groups[1]); ok {
+				return value
+			}
+			if groups[2] != "" {
+				return groups[3]
+			}
+			*missing = append(*missing, groups[1])
+			return match
+		})
+		if node.Style == 0 {
+			node.Tag = ""
+		}
+		return
+	}
+	for _, child := range node.Content {
+		substituteSpecVariables(child, values, missing)
+	}
+}
+
+// lookupSpecValue resolves a dotted name such as net.ip in nested values.
+func lookupSpecValue(values map[string]any, name string) (any, bool) {
+	if value, ok := values[name]; ok {
+		return value, true
+	}
+	head, rest, found := strings.Cut(name, ".")
+	if !found {
+		return nil, false
+	}
+	nested, ok := values[head].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	return lookupSpecValue(nested, rest)
+}
@@ -0,0 +1,146 @@
+package utility
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func writeSpecFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadSpecsSubstitutesVariables(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("SPEC_TEST_BRIDGE", "vmbr1")
+	path := writeSpecFile(t, dir, "web.yaml", `name: ${name}
+memory: {{ .memory }}
+net0: virtio,bridge=${SPEC_TEST_BRIDGE}
+cores: ${cores:-2}
+ip: ${net.ip}
+password: "p$$ss"
+`)
+
+	specs, err := LoadSpecs(path, map[string]any{
+		"name":   "web1",
+		"memory": 4096,
+		"net":    map[string]any{"ip": "10.0.0.11/24"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := specs[0]
+	want := map[string]any{
+		"name": "web1", "memory": 4096, "net0": "virtio,bridge=vmbr1",
+		"cores": 2, "ip": "10.0.0.11/24", "password": "p$ss",
+	}
+	for key, value := range want {
+		if spec[key] != value {
+			t.Errorf("%s = %#v, want %#v", key, spec[key], value)
+		}
+	}
+}
+
+func TestLoadSpecsKeepsStructureOfSubstitutedValues(t *testing.T) {
+	path := writeSpecFile(t, t.TempDir(), "web.yaml", `# uses ${UNSET_IN_COMMENT}
+description: ${note}
+name: web1 # ${ALSO_UNSET}
+tags: "${tag}"
+`)
+
+	specs, err := LoadSpecs(path, map[string]any{"note": "a: b # not a comment\nsecond line", "tag": "10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := specs[0]
+	if spec["description"] != "a: b # not a comment\nsecond line" || spec["name"] != "web1" || spec["tags"] != "10" {
+		t.Errorf("unexpected spec: %#v", spec)
+	}
+	if len(spec) != 3 {
+		t.Errorf("substitution added keys: %#v", spec)
+	}
+}
+
+func TestLoadSpecsReportsUndefinedVariables(t *testing.T) {
+	path := writeSpecFile(t, t.TempDir(), "web.yaml", "name: ${name}\nhostname: ${SPEC_TEST_UNSET_HOST}\n")
+	_, err := LoadSpecs(path, nil)
+	if err == nil || !strings.Contains(err.Error(), "undefined spec variables: name, SPEC_TEST_UNSET_HOST") {
+		t.Fatalf("expected undefined variable error, got %v", err)
+	}
+
+	path = writeSpecFile(t, t.TempDir(), "tmpl.yaml", "name: {{ .name }}\n")
+	if _, err := LoadSpecs(path, map[string]any{}); err == nil {
+		t.Fatal("expected error for missing template value")
+	}
+}
+
+func TestLoadSpecsExtendsAndMultipleDocuments(t *testing.T) {
+	dir := t.TempDir()
+	writeSpecFile(t, dir, "base.yaml", "memory: 2048\ncores: 2\nostype: l26\nballoon: 1024\n")
+	path := writeSpecFile(t, dir, "fleet.yaml", `extends: base.yaml
+name: web1
+cores: 4
+---
+extends: base.yaml
+name: web2
+balloon: null
+`)
+
+	specs, err := LoadSpecs(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(specs) != 2 {
+		t.Fatalf("got %d specs, want 2", len(specs))
+	}
+	if specs[0]["name"] != "web1" || specs[0]["cores"] != 4 || specs[0]["memory"] != 2048 || specs[0]["extends"] != nil {
+		t.Errorf("unexpected first spec: %v", specs[0])
+	}
+	if _, ok := specs[1]["balloon"]; ok || specs[1]["cores"] != 2 {
+		t.Errorf("unexpected second spec: %v", specs[1])
+	}
+}
+
+func TestLoadSpecsDetectsExtendsCycle(t *testing.T) {
+	dir := t.TempDir()
+	writeSpecFile(t, dir, "a.yaml", "extends: b.yaml\nname: a\n")
+	writeSpecFile(t, dir, "b.yaml", "extends: a.yaml\nname: b\n")
+	_, err := LoadSpecs(filepath.Join(dir, "a.yaml"), nil)
+	if err == nil || !strings.Contains(err.Error(), "extends cycle") {
+		t.Fatalf("expected cycle error, got %v", err)
+	}
+}
+
+func TestSpecValuesFromFlagsPrecedence(t *testing.T) {
+	dir := t.TempDir()
+	first := writeSpecFile(t, dir, "first.yaml", "hostname: a\nsize: 8G\n")
+	second := writeSpecFile(t, dir, "second.yaml", "hostname: b\n")
+
+	cmd := &cobra.Command{Use: "test"}
+	AddSpecValueFlags(cmd)
+	if err := cmd.ParseFlags([]string{"--values", first, "--values", second, "--set", "hostname=c", "--set", "ip=10.0.0.5/24"}); err != nil {
+		t.Fatal(err)
+	}
+	values, err := SpecValuesFromFlags(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values["hostname"] != "c" || values["size"] != "8G" || values["ip"] != "10.0.0.5/24" {
+		t.Fatalf("unexpected values: %v", values)
+	}
+
+	cmd = &cobra.Command{Use: "test"}
+	AddSpecValueFlags(cmd)
+	_ = cmd.ParseFlags([]string{"--set", "novalue"})
+	if _, err := SpecValuesFromFlags(cmd); err == nil {
+		t.Fatal("expected error for --set without =")
+	}
+}
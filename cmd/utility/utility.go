@@ -13,6 +13,9 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -28,16 +31,20 @@ import (
 // RealProxmoxClient wraps the actual go-proxmox client
 type RealProxmoxClient struct {
 	client *proxmox.Client
+	raw    *rawAPI
 }
 
 // RealNode wraps the actual go-proxmox node
 type RealNode struct {
-	node *proxmox.Node
+	node   *proxmox.Node
+	client *proxmox.Client
+	raw    *rawAPI
 }
 
 // RealContainer wraps the actual go-proxmox container
 type RealContainer struct {
 	container *proxmox.Container
+	client    *proxmox.Client
 }
 
 // RealVirtualMachine wraps the actual go-proxmox VM
@@ -55,16 +62,269 @@ func (r *RealProxmoxClient) Node(ctx context.Context, nodeName string) (interfac
 	if err != nil {
 		return nil, err
 	}
-	return &RealNode{node: node}, nil
+	return &RealNode{node: node, client: r.client, raw: r.raw}, nil
 }
 
 func (r *RealProxmoxClient) Version(ctx context.Context) (*proxmox.Version, error) {
 	return r.client.Version(ctx)
 }
 
+// WakeNode asks the connected node to send a Wake-on-LAN packet to
+// nodeName and returns the MAC address it used. It posts directly because
+// looking the node up first reads its status, which fails while it is off.
+func (r *RealProxmoxClient) WakeNode(ctx context.Context, nodeName string) (string, error) {
+	var mac string
+	err := r.client.Post(ctx, fmt.Sprintf("/nodes/%s/wakeonlan", url.PathEscape(nodeName)), nil, &mac)
+	return mac, err
+}
+
+func (r *RealProxmoxClient) Pools(ctx context.Context) (proxmox.Pools, error) {
+	return r.client.Pools(ctx)
+}
+
+func (r *RealProxmoxClient) Pool(ctx context.Context, poolID string, filters ...string) (*proxmox.Pool, error) {
+	return r.client.Pool(ctx, poolID, filters...)
+}
+
+func (r *RealProxmoxClient) NewPool(ctx context.Context, poolID, comment string) error {
+	return r.client.NewPool(ctx, poolID, comment)
+}
+
+func (r *RealProxmoxClient) UpdatePool(ctx context.Context, poolID string, options *proxmox.PoolUpdateOption) error {
+	pool, err := r.client.Pool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+	return pool.Update(ctx, options)
+}
+
+func (r *RealProxmoxClient) DeletePool(ctx context.Context, poolID string) error {
+	pool, err := r.client.Pool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+	return pool.Delete(ctx)
+}
+
+func (r *RealProxmoxClient) Users(ctx context.Context) (proxmox.Users, error) {
+	return r.client.Users(ctx)
+}
+
+func (r *RealProxmoxClient) User(ctx context.Context, userID string) (*proxmox.User, error) {
+	return r.client.User(ctx, userID)
+}
+
+func (r *RealProxmoxClient) NewUser(ctx context.Context, user *proxmox.NewUser) error {
+	return r.client.NewUser(ctx, user)
+}
+
+func (r *RealProxmoxClient) UpdateUser(ctx context.Context, userID string, options *proxmox.UserOptions) error {
+	return r.client.Put(ctx, "/access/users/"+url.PathEscape(userID), options, nil)
+}
+
+func (r *RealProxmoxClient) DeleteUser(ctx context.Context, userID string) error {
+	return r.client.Delete(ctx, "/access/users/"+url.PathEscape(userID), nil)
+}
+
+// ChangePassword sets a user's password. Proxmox 8.1 and later require the
+// calling user's own password as confirmation; it is omitted when empty.
+func (r *RealProxmoxClient) ChangePassword(ctx context.Context, userID, password, confirmation string) error {
+	params := map[string]string{"userid": userID, "password": password}
+	if confirmation != "" {
+		params["confirmation-password"] = confirmation
+	}
+	return r.client.Put(ctx, "/access/password", params, nil)
+}
+
+func (r *RealProxmoxClient) APITokens(ctx context.Context, userID string) (proxmox.Tokens, error) {
+	var tokens proxmox.Tokens
+	return tokens, r.client.Get(ctx, "/access/users/"+url.PathEscape(userID)+"/token", &tokens)
+}
+
+// NewAPIToken creates an API token for a user. The returned Value is the
+// token secret, which the server never shows again.
+func (r *RealProxmoxClient) NewAPIToken(ctx context.Context, userID string, token proxmox.Token) (proxmox.NewAPIToken, error) {
+	var created proxmox.NewAPIToken
+	path := "/access/users/" + url.PathEscape(userID) + "/token/" + url.PathEscape(token.TokenID)
+	return created, r.client.Post(ctx, path, token, &created)
+}
+
+func (r *RealProxmoxClient) DeleteAPIToken(ctx context.Context, userID, tokenID string) error {
+	return r.client.Delete(ctx, "/access/users/"+url.PathEscape(userID)+"/token/"+url.PathEscape(tokenID), nil)
+}
+
+func (r *RealProxmoxClient) Groups(ctx context.Context) (proxmox.Groups, error) {
+	return r.client.Groups(ctx)
+}
+
+func (r *RealProxmoxClient) Group(ctx context.Context, groupID string) (*proxmox.Group, error) {
+	return r.client.Group(ctx, groupID)
+}
+
+func (r *RealProxmoxClient) NewGroup(ctx context.Context, groupID, comment string) error {
+	return r.client.NewGroup(ctx, groupID, comment)
+}
+
+func (r *RealProxmoxClient) UpdateGroup(ctx context.Context, groupID, comment string) error {
+	return r.client.Put(ctx, "/access/groups/"+url.PathEscape(groupID), map[string]string{"comment": comment}, nil)
+}
+
+func (r *RealProxmoxClient) DeleteGroup(ctx context.Context, groupID string) error {
+	return r.client.Delete(ctx, "/access/groups/"+url.PathEscape(groupID), nil)
+}
+
+func (r *RealProxmoxClient) Roles(ctx context.Context) (proxmox.Roles, error) {
+	return r.client.Roles(ctx)
+}
+
+func (r *RealProxmoxClient) NewRole(ctx context.Context, roleID, privs string) error {
+	return r.client.NewRole(ctx, roleID, privs)
+}
+
+func (r *RealProxmoxClient) ACL(ctx context.Context) (proxmox.ACLs, error) {
+	return r.client.ACL(ctx)
+}
+
+func (r *RealProxmoxClient) UpdateACL(ctx context.Context, options proxmox.ACLOptions) error {
+	return r.client.UpdateACL(ctx, options)
+}
+
+func (r *RealProxmoxClient) Permissions(ctx context.Context, options *proxmox.PermissionsOptions) (proxmox.Permissions, error) {
+	return r.client.Permissions(ctx, options)
+}
+
+func (r *RealProxmoxClient) Firewall(path string) interfaces.FirewallInterface {
+	return &RealFirewall{client: r.client, path: strings.TrimSuffix(path, "/")}
+}
+
+// RealFirewall addresses the firewall API of one scope by path; go-proxmox
+// covers each scope with differently shaped helpers, so calls go through the
+// client directly.
+type RealFirewall struct {
+	client *proxmox.Client
+	path   string
+}
+
+// rulesPath returns the rule list endpoint. Security groups keep their rules
+// directly under the group rather than under /rules.
+func (r *RealFirewall) rulesPath() string {
+	if strings.HasPrefix(r.path, "/cluster/firewall/groups/") {
+		return r.path
+	}
+	return r.path + "/rules"
+}
+
+func (r *RealFirewall) Rules(ctx context.Context) ([]*proxmox.FirewallRule, error) {
+	var rules []*proxmox.FirewallRule
+	return rules, r.client.Get(ctx, r.rulesPath(), &rules)
+}
+
+func (r *RealFirewall) NewRule(ctx context.Context, params map[string]any) error {
+	return r.client.Post(ctx, r.rulesPath(), params, nil)
+}
+
+func (r *RealFirewall) UpdateRule(ctx context.Context, pos int, params map[string]any) error {
+	return r.client.Put(ctx, fmt.Sprintf("%s/%d", r.rulesPath(), pos), params, nil)
+}
+
+func (r *RealFirewall) DeleteRule(ctx context.Context, pos int) error {
+	return r.client.Delete(ctx, fmt.Sprintf("%s/%d", r.rulesPath(), pos), nil)
+}
+
+func (r *RealFirewall) Options(ctx context.Context) (map[string]any, error) {
+	options := map[string]any{}
+	return options, r.client.Get(ctx, r.path+"/options", &options)
+}
+
+func (r *RealFirewall) UpdateOptions(ctx context.Context, params map[string]any) error {
+	return r.client.Put(ctx, r.path+"/options", params, nil)
+}
+
+func (r *RealFirewall) IPSets(ctx context.Context) ([]*proxmox.FirewallIPSet, error) {
+	var ipsets []*proxmox.FirewallIPSet
+	return ipsets, r.client.Get(ctx, r.path+"/ipset", &ipsets)
+}
+
+func (r *RealFirewall) IPSetEntries(ctx context.Context, name string) ([]*proxmox.FirewallIPSetEntry, error) {
+	var entries []*proxmox.FirewallIPSetEntry
+	return entries, r.client.Get(ctx, r.path+"/ipset/"+url.PathEscape(name), &entries)
+}
+
+func (r *RealFirewall) NewIPSet(ctx context.Context, name, comment string) error {
+	return r.client.Post(ctx, r.path+"/ipset", &proxmox.FirewallIPSetCreationOption{Name: name, Comment: comment}, nil)
+}
+
+// DeleteIPSet deletes an IP set together with its entries.
+func (r *RealFirewall) DeleteIPSet(ctx context.Context, name string) error {
+	return r.client.Delete(ctx, r.path+"/ipset/"+url.PathEscape(name)+"?force=1", nil)
+}
+
+func (r *RealFirewall) AddIPSetEntry(ctx context.Context, name string, entry *proxmox.FirewallIPSetEntryCreationOption) error {
+	return r.client.Post(ctx, r.path+"/ipset/"+url.PathEscape(name), entry, nil)
+}
+
+func (r *RealFirewall) DeleteIPSetEntry(ctx context.Context, name, cidr string) error {
+	return r.client.Delete(ctx, r.path+"/ipset/"+url.PathEscape(name)+"/"+url.PathEscape(cidr), nil)
+}
+
+func (r *RealFirewall) Aliases(ctx context.Context) ([]*proxmox.FirewallAlias, error) {
+	var aliases []*proxmox.FirewallAlias
+	return aliases, r.client.Get(ctx, r.path+"/aliases", &aliases)
+}
+
+func (r *RealFirewall) NewAlias(ctx context.Context, alias *proxmox.FirewallAliasCreateOption) error {
+	return r.client.Post(ctx, r.path+"/aliases", alias, nil)
+}
+
+func (r *RealFirewall) UpdateAlias(ctx context.Context, name, cidr, comment string) error {
+	return r.client.Put(ctx, r.path+"/aliases/"+url.PathEscape(name), map[string]string{"cidr": cidr, "comment": comment}, nil)
+}
+
+func (r *RealFirewall) DeleteAlias(ctx context.Context, name string) error {
+	return r.client.Delete(ctx, r.path+"/aliases/"+url.PathEscape(name), nil)
+}
+
+func (r *RealFirewall) SecurityGroups(ctx context.Context) ([]*proxmox.FirewallSecurityGroup, error) {
+	var groups []*proxmox.FirewallSecurityGroup
+	return groups, r.client.Get(ctx, r.path+"/groups", &groups)
+}
+
+func (r *RealFirewall) NewSecurityGroup(ctx context.Context, name, comment string) error {
+	return r.client.Post(ctx, r.path+"/groups", map[string]string{"group": name, "comment": comment}, nil)
+}
+
+func (r *RealFirewall) DeleteSecurityGroup(ctx context.Context, name string) error {
+	return r.client.Delete(ctx, r.path+"/groups/"+url.PathEscape(name), nil)
+}
+
+func (r *RealFirewall) Log(ctx context.Context, options *proxmox.NodeFirewallLogOptions) ([]*proxmox.LogEntry, error) {
+	query := url.Values{}
+	if options != nil {
+		if options.Start > 0 {
+			query.Set("start", strconv.Itoa(options.Start))
+		}
+		if options.Limit > 0 {
+			query.Set("limit", strconv.Itoa(options.Limit))
+		}
+		if options.Since > 0 {
+			query.Set("since", strconv.FormatInt(options.Since, 10))
+		}
+		if options.Until > 0 {
+			query.Set("until", strconv.FormatInt(options.Until, 10))
+		}
+	}
+	path := r.path + "/log"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	var entries []*proxmox.LogEntry
+	return entries, r.client.Get(ctx, path, &entries)
+}
+
 // RealCluster wraps the actual go-proxmox cluster
 type RealCluster struct {
 	cluster *proxmox.Cluster
+	client  *proxmox.Client
 }
 
 func (r *RealProxmoxClient) Cluster(ctx context.Context) (interfaces.ClusterInterface, error) {
@@ -72,7 +332,7 @@ func (r *RealProxmoxClient) Cluster(ctx context.Context) (interfaces.ClusterInte
 	if err != nil {
 		return nil, err
 	}
-	return &RealCluster{cluster: cluster}, nil
+	return &RealCluster{cluster: cluster, client: r.client}, nil
 }
 
 func (r *RealCluster) Resources(ctx context.Context, filters ...string) (proxmox.ClusterResources, error) {
@@ -83,6 +343,147 @@ func (r *RealCluster) NextID(ctx context.Context) (int, error) {
 	return r.cluster.NextID(ctx)
 }
 
+func (r *RealCluster) PCIMappings(ctx context.Context, checkNode string) (proxmox.ClusterPCIMappings, error) {
+	return r.cluster.PCIMappings(ctx, checkNode)
+}
+
+func (r *RealCluster) USBMappings(ctx context.Context, checkNode string) (proxmox.ClusterUSBMappings, error) {
+	return r.cluster.USBMappings(ctx, checkNode)
+}
+
+func (r *RealCluster) ACMEAccounts(ctx context.Context) ([]*proxmox.ACMEAccountIndex, error) {
+	return r.cluster.ACMEAccounts(ctx)
+}
+
+func (r *RealCluster) ACMEAccount(ctx context.Context, name string) (*proxmox.ACMEAccount, error) {
+	return r.cluster.ACMEAccount(ctx, name)
+}
+
+func (r *RealCluster) NewACMEAccount(ctx context.Context, options *proxmox.ACMEAccountOptions) (*proxmox.Task, error) {
+	return r.cluster.NewACMEAccount(ctx, options)
+}
+
+func (r *RealCluster) DeleteACMEAccount(ctx context.Context, name string) (*proxmox.Task, error) {
+	return r.cluster.DeleteACMEAccount(ctx, name)
+}
+
+func (r *RealCluster) ACMETermsOfService(ctx context.Context, directory string) (string, error) {
+	return r.cluster.ACMETermsOfService(ctx, directory)
+}
+
+// Quorate re-reads the cluster status and reports whether it has quorum.
+// A standalone node has no cluster status and is always quorate.
+func (r *RealCluster) Quorate(ctx context.Context) (bool, error) {
+	if err := r.cluster.Status(ctx); err != nil {
+		return false, err
+	}
+	if r.cluster.Name == "" {
+		return true, nil
+	}
+	return r.cluster.Quorate == 1, nil
+}
+
+func (r *RealCluster) HAStatus(ctx context.Context) ([]*proxmox.HAStatusEntry, error) {
+	return r.cluster.HAStatus(ctx)
+}
+
+func (r *RealCluster) HAResources(ctx context.Context, resourceType string) ([]*proxmox.HAResource, error) {
+	return r.cluster.HAResources(ctx, resourceType)
+}
+
+func (r *RealCluster) NewHAResource(ctx context.Context, options *proxmox.HAResourceCreateOption) error {
+	return r.cluster.NewHAResource(ctx, options)
+}
+
+func (r *RealCluster) UpdateHAResource(ctx context.Context, sid string, options *proxmox.HAResourceUpdateOption) error {
+	return r.cluster.HAResourceUpdate(ctx, sid, options)
+}
+
+func (r *RealCluster) DeleteHAResource(ctx context.Context, sid string, purge bool) error {
+	return r.cluster.HAResourceDelete(ctx, sid, purge)
+}
+
+func (r *RealCluster) MigrateHAResource(ctx context.Context, sid, node string) error {
+	return r.cluster.HAResourceMigrate(ctx, sid, node)
+}
+
+func (r *RealCluster) RelocateHAResource(ctx context.Context, sid, node string) error {
+	return r.cluster.HAResourceRelocate(ctx, sid, node)
+}
+
+func (r *RealCluster) HAGroups(ctx context.Context) ([]*proxmox.HAGroup, error) {
+	return r.cluster.HAGroups(ctx)
+}
+
+func (r *RealCluster) NewHAGroup(ctx context.Context, options *proxmox.HAGroupCreateOption) error {
+	return r.cluster.NewHAGroup(ctx, options)
+}
+
+func (r *RealCluster) UpdateHAGroup(ctx context.Context, name string, options *proxmox.HAGroupUpdateOption) error {
+	return r.cluster.HAGroupUpdate(ctx, name, options)
+}
+
+func (r *RealCluster) DeleteHAGroup(ctx context.Context, name string) error {
+	return r.cluster.HAGroupDelete(ctx, name)
+}
+
+func (r *RealCluster) HARules(ctx context.Context) ([]*proxmox.HARule, error) {
+	return r.cluster.HARules(ctx, "", "")
+}
+
+func (r *RealCluster) NewHARule(ctx context.Context, options *proxmox.HARuleCreateOption) error {
+	return r.cluster.NewHARule(ctx, options)
+}
+
+func (r *RealCluster) UpdateHARule(ctx context.Context, name string, options *proxmox.HARuleUpdateOption) error {
+	return r.cluster.HARuleUpdate(ctx, name, options)
+}
+
+func (r *RealCluster) DeleteHARule(ctx context.Context, name string) error {
+	return r.cluster.HARuleDelete(ctx, name)
+}
+
+func (r *RealCluster) SDNZones(ctx context.Context) ([]*proxmox.SDNZone, error) {
+	return r.cluster.SDNZones(ctx)
+}
+
+func (r *RealCluster) SDNVNets(ctx context.Context) ([]*proxmox.VNet, error) {
+	return r.cluster.SDNVNets(ctx)
+}
+
+func (r *RealCluster) SDNSubnets(ctx context.Context, vnet string) ([]*proxmox.VNetSubnet, error) {
+	return r.cluster.SDNSubnets(ctx, url.PathEscape(vnet))
+}
+
+func (r *RealCluster) SDNControllers(ctx context.Context) ([]*proxmox.SDNController, error) {
+	return r.cluster.SDNControllers(ctx, "")
+}
+
+func (r *RealCluster) SDNIPAMs(ctx context.Context) ([]*proxmox.SDNIPAM, error) {
+	return r.cluster.SDNIPAMs(ctx, "")
+}
+
+func (r *RealCluster) SDNIPAMStatus(ctx context.Context, ipam string) ([]map[string]any, error) {
+	return r.cluster.SDNIPAM(ipam).Status(ctx)
+}
+
+// SDNPending lists the SDN objects under path, e.g. "zones" or
+// "vnets/v1/subnets", with their undeployed changes. The pending variant of
+// the listing carries a pending object that the typed go-proxmox structs
+// cannot decode, so it is read as generic maps.
+func (r *RealCluster) SDNPending(ctx context.Context, path string) ([]map[string]any, error) {
+	var entries []map[string]any
+	return entries, r.client.Get(ctx, "/cluster/sdn/"+path+"?pending=1", &entries)
+}
+
+func (r *RealCluster) SDNDryRun(ctx context.Context, node string) (*proxmox.SDNDryRun, error) {
+	return r.cluster.SDNDryRun(ctx, node)
+}
+
+func (r *RealCluster) SDNApply(ctx context.Context) (*proxmox.Task, error) {
+	return r.cluster.SDNApply(ctx)
+}
+
 func (r *RealNode) VirtualMachines(ctx context.Context) (proxmox.VirtualMachines, error) {
 	return r.node.VirtualMachines(ctx)
 }
@@ -96,7 +497,7 @@ func (r *RealNode) Container(ctx context.Context, vmid int) (interfaces.Containe
 	if err != nil {
 		return nil, err
 	}
-	return &RealContainer{container: container}, nil
+	return &RealContainer{container: container, client: r.client}, nil
 }
 
 func (r *RealNode) VirtualMachine(ctx context.Context, vmid int) (interfaces.VirtualMachineInterface, error) {
@@ -136,6 +537,14 @@ func (r *RealStorage) GetContent(ctx context.Context) ([]*proxmox.StorageContent
 	return r.storage.GetContent(ctx)
 }
 
+func (r *RealStorage) CopyContent(ctx context.Context, sourceVolume, targetVolume, targetNode string) (*proxmox.Task, error) {
+	return r.storage.CopyContent(ctx, sourceVolume, targetVolume, targetNode)
+}
+
+func (r *RealStorage) DeleteContent(ctx context.Context, volume string) (*proxmox.Task, error) {
+	return r.storage.DeleteContent(ctx, volume)
+}
+
 func (r *RealNode) Tasks(ctx context.Context, options *proxmox.NodeTasksOptions) ([]*proxmox.Task, error) {
 	return r.node.Tasks(ctx, options)
 }
@@ -144,6 +553,75 @@ func (r *RealNode) Vzdump(ctx context.Context, options *proxmox.VirtualMachineBa
 	return r.node.Vzdump(ctx, options)
 }
 
+// Task returns a handle for the task with the given UPID, for API calls
+// that return a bare UPID rather than a task.
+func (r *RealNode) Task(upid string) *proxmox.Task {
+	return proxmox.NewTask(proxmox.UPID(upid), r.client)
+}
+
+// TaskLog returns the log lines of the task with the given UPID in order.
+func (r *RealNode) TaskLog(ctx context.Context, upid string) ([]string, error) {
+	log, err := proxmox.NewTask(proxmox.UPID(upid), r.client).Log(ctx, 0, taskLogLimit)
+	if err != nil {
+		return nil, err
+	}
+	numbers := make([]int, 0, len(log))
+	for n := range log {
+		numbers = append(numbers, n)
+	}
+	sort.Ints(numbers)
+	lines := make([]string, 0, len(numbers))
+	for _, n := range numbers {
+		lines = append(lines, log[n])
+	}
+	return lines, nil
+}
+
+func (r *RealNode) Network(ctx context.Context, iface string) (*proxmox.NodeNetwork, error) {
+	return r.node.Network(ctx, iface)
+}
+
+// CreateNetwork stages a new interface in /etc/network/interfaces.new.
+// Unlike go-proxmox's NewNetwork it does not reload the network, so
+// changes can be reviewed with NetworkChanges and applied together.
+func (r *RealNode) CreateNetwork(ctx context.Context, params map[string]any) error {
+	return r.client.Post(ctx, fmt.Sprintf("/nodes/%s/network", r.node.Name), params, nil)
+}
+
+// UpdateNetwork stages changes to an interface without reloading.
+func (r *RealNode) UpdateNetwork(ctx context.Context, iface string, params map[string]any) error {
+	return r.client.Put(ctx, fmt.Sprintf("/nodes/%s/network/%s", r.node.Name, url.PathEscape(iface)), params, nil)
+}
+
+// DeleteNetwork stages the removal of an interface without reloading.
+func (r *RealNode) DeleteNetwork(ctx context.Context, iface string) error {
+	return r.client.Delete(ctx, fmt.Sprintf("/nodes/%s/network/%s", r.node.Name, url.PathEscape(iface)), nil)
+}
+
+// NetworkChanges returns the diff between the active and the pending
+// interfaces file, or "" when nothing is staged. Proxmox sends it next to
+// the data key, which go-proxmox discards, so it is read with a raw request.
+func (r *RealNode) NetworkChanges(ctx context.Context) (string, error) {
+	if r.raw == nil {
+		return "", errors.New("raw API access is not configured")
+	}
+	var response struct {
+		Changes string `json:"changes"`
+	}
+	if err := r.raw.get(ctx, fmt.Sprintf("/nodes/%s/network", r.node.Name), &response); err != nil {
+		return "", err
+	}
+	return response.Changes, nil
+}
+
+func (r *RealNode) ApplyNetwork(ctx context.Context) (*proxmox.Task, error) {
+	return r.node.NetworkReload(ctx)
+}
+
+func (r *RealNode) RevertNetwork(ctx context.Context) error {
+	return r.node.RevertNetworkChanges(ctx)
+}
+
 func (r *RealNode) Appliances(ctx context.Context) (proxmox.Appliances, error) {
 	return r.node.Appliances(ctx)
 }
@@ -164,6 +642,177 @@ func (r *RealNode) RRDData(ctx context.Context, timeframe proxmox.Timeframe, cf
 	return r.node.RRDData(ctx, timeframe, cf)
 }
 
+func (r *RealNode) Networks(ctx context.Context, ifaceType ...string) (proxmox.NodeNetworks, error) {
+	return r.node.Networks(ctx, ifaceType...)
+}
+
+func (r *RealNode) ListPCIDevices(ctx context.Context, opts *proxmox.HardwarePCIOptions) ([]*proxmox.PCIDevice, error) {
+	return r.node.ListPCIDevices(ctx, opts)
+}
+
+func (r *RealNode) ListUSBDevices(ctx context.Context) ([]*proxmox.USBDevice, error) {
+	return r.node.ListUSBDevices(ctx)
+}
+
+func (r *RealNode) PCIMdevTypes(ctx context.Context, id string) ([]*proxmox.PCIMdevType, error) {
+	return r.node.PCIDevice(id).Mdev(ctx)
+}
+
+func (r *RealNode) Services(ctx context.Context) ([]*proxmox.NodeService, error) {
+	return r.node.Services(ctx)
+}
+
+// ServiceAction starts, stops, restarts, or reloads a node service.
+func (r *RealNode) ServiceAction(ctx context.Context, service, action string) (*proxmox.Task, error) {
+	handle := r.node.Service(service)
+	switch action {
+	case "start":
+		return handle.Start(ctx)
+	case "stop":
+		return handle.Stop(ctx)
+	case "restart":
+		return handle.Restart(ctx)
+	case "reload":
+		return handle.Reload(ctx)
+	default:
+		return nil, fmt.Errorf("unsupported service action %q", action)
+	}
+}
+
+func (r *RealNode) APTUpdates(ctx context.Context) ([]*proxmox.APTUpdate, error) {
+	return r.node.APTUpdates(ctx)
+}
+
+// APTRefresh runs apt-get update on the node.
+func (r *RealNode) APTRefresh(ctx context.Context) (*proxmox.Task, error) {
+	return r.node.APTUpdate(ctx, false, false)
+}
+
+func (r *RealNode) APTVersions(ctx context.Context) ([]*proxmox.APTPackageVersion, error) {
+	return r.node.APTVersions(ctx)
+}
+
+func (r *RealNode) APTChangelog(ctx context.Context, name, version string) (string, error) {
+	return r.node.APTChangelog(ctx, name, version)
+}
+
+func (r *RealNode) Journal(ctx context.Context, options *proxmox.NodeJournalOptions) ([]string, error) {
+	return r.node.Journal(ctx, options)
+}
+
+func (r *RealNode) Syslog(ctx context.Context, options *proxmox.NodeSyslogOptions) ([]*proxmox.LogEntry, error) {
+	return r.node.Syslog(ctx, options)
+}
+
+func (r *RealNode) Disks(ctx context.Context, includePartitions, skipSMART bool, diskType string) ([]*proxmox.Disk, error) {
+	return r.node.Disks(ctx, includePartitions, skipSMART, diskType)
+}
+
+func (r *RealNode) DiskSMART(ctx context.Context, disk string, healthOnly bool) (*proxmox.DiskSMART, error) {
+	return r.node.DiskSMART(ctx, disk, healthOnly)
+}
+
+func (r *RealNode) DiskInitGPT(ctx context.Context, disk string) (*proxmox.Task, error) {
+	return r.node.DiskInitGPT(ctx, disk, "")
+}
+
+func (r *RealNode) NewZFSPool(ctx context.Context, options *proxmox.NodeZFSPoolOptions) (*proxmox.Task, error) {
+	return r.node.NewZFSPool(ctx, options)
+}
+
+func (r *RealNode) NewLVMThin(ctx context.Context, options *proxmox.NodeLVMThinOptions) (*proxmox.Task, error) {
+	return r.node.NewLVMThin(ctx, options)
+}
+
+func (r *RealNode) NewDirectory(ctx context.Context, options *proxmox.NodeDirectoryOptions) (*proxmox.Task, error) {
+	return r.node.NewDirectory(ctx, options)
+}
+
+func (r *RealNode) Certificates(ctx context.Context) (proxmox.NodeCertificates, error) {
+	certificates, err := r.node.GetCustomCertificates(ctx)
+	if err != nil || certificates == nil {
+		return nil, err
+	}
+	return *certificates, nil
+}
+
+func (r *RealNode) UploadCertificate(ctx context.Context, certificate *proxmox.CustomCertificate) error {
+	return r.node.UploadCustomCertificate(ctx, certificate)
+}
+
+func (r *RealNode) Config(ctx context.Context) (*proxmox.NodeConfig, error) {
+	return r.node.GetConfig(ctx)
+}
+
+func (r *RealNode) UpdateConfig(ctx context.Context, options *proxmox.NodeConfigOptions) error {
+	return r.node.UpdateConfig(ctx, options)
+}
+
+func (r *RealNode) OrderACMECertificate(ctx context.Context, force bool) (*proxmox.Task, error) {
+	return r.node.OrderACMECertificate(ctx, force)
+}
+
+func (r *RealNode) RenewACMECertificate(ctx context.Context, force bool) (*proxmox.Task, error) {
+	return r.node.RenewACMECertificate(ctx, force)
+}
+
+// TermProxy opens a node shell proxy. go-proxmox cannot pass a command, so
+// requests for one other than the default login shell are posted directly.
+func (r *RealNode) TermProxy(ctx context.Context, command proxmox.NodeConsoleCmd, commandOptions string) (*proxmox.Term, error) {
+	if command == "" {
+		return r.node.TermProxy(ctx)
+	}
+	params := map[string]string{"cmd": string(command)}
+	if commandOptions != "" {
+		params["cmd-opts"] = commandOptions
+	}
+	var term *proxmox.Term
+	if err := r.client.Post(ctx, fmt.Sprintf("/nodes/%s/termproxy", r.node.Name), params, &term); err != nil {
+		return nil, err
+	}
+	return term, nil
+}
+
+func (r *RealNode) TermWebSocket(term *proxmox.Term) (chan []byte, chan []byte, chan error, func() error, error) {
+	return r.node.TermWebSocket(term)
+}
+
+func (r *RealNode) StartAll(ctx context.Context, force bool, vms string) (*proxmox.Task, error) {
+	return r.node.StartAll(ctx, &proxmox.NodeStartAllOptions{Force: proxmox.IntOrBool(force), VMs: vms})
+}
+
+// StopAll posts the request directly since go-proxmox omits force-stop when
+// false, and Proxmox then defaults it to true.
+func (r *RealNode) StopAll(ctx context.Context, forceStop bool, timeout int, vms string) (*proxmox.Task, error) {
+	params := map[string]any{"force-stop": 0}
+	if forceStop {
+		params["force-stop"] = 1
+	}
+	if timeout > 0 {
+		params["timeout"] = timeout
+	}
+	if vms != "" {
+		params["vms"] = vms
+	}
+	var upid proxmox.UPID
+	if err := r.client.Post(ctx, fmt.Sprintf("/nodes/%s/stopall", r.node.Name), params, &upid); err != nil {
+		return nil, err
+	}
+	return proxmox.NewTask(upid, r.client), nil
+}
+
+func (r *RealNode) SuspendAll(ctx context.Context, vms string) (*proxmox.Task, error) {
+	return r.node.SuspendAll(ctx, &proxmox.NodeSuspendAllOptions{VMs: vms})
+}
+
+func (r *RealNode) Reboot(ctx context.Context) error {
+	return r.node.Reboot(ctx)
+}
+
+func (r *RealNode) Shutdown(ctx context.Context) error {
+	return r.node.Shutdown(ctx)
+}
+
 func (r *RealContainer) Start(ctx context.Context) (*proxmox.Task, error) {
 	return r.container.Start(ctx)
 }
@@ -216,6 +865,10 @@ func (r *RealContainer) Migrate(ctx context.Context, options *proxmox.ContainerM
 	return r.container.Migrate(ctx, options)
 }
 
+func (r *RealContainer) MigratePreconditions(ctx context.Context, target string) (*proxmox.ContainerMigratePreconditions, error) {
+	return r.container.MigratePreconditions(ctx, target)
+}
+
 func (r *RealContainer) Config(ctx context.Context, options ...proxmox.ContainerOption) (*proxmox.Task, error) {
 	return r.container.Config(ctx, options...)
 }
@@ -248,6 +901,24 @@ func (r *RealContainer) TermWebSocket(term *proxmox.Term) (chan []byte, chan []b
 	return r.container.TermWebSocket(term)
 }
 
+func (r *RealContainer) MoveVolume(ctx context.Context, options *interfaces.ContainerMoveVolumeOptions) (*proxmox.Task, error) {
+	var upid proxmox.UPID
+	path := fmt.Sprintf("/nodes/%s/lxc/%d/move_volume", url.PathEscape(r.container.Node), r.container.VMID)
+	if err := r.client.Post(ctx, path, options, &upid); err != nil {
+		return nil, err
+	}
+	return proxmox.NewTask(upid, r.client), nil
+}
+
+// CurrentConfig returns the configuration fetched when the container was
+// looked up; it is never nil.
+func (r *RealContainer) CurrentConfig() *proxmox.ContainerConfig {
+	if r.container.ContainerConfig == nil {
+		return &proxmox.ContainerConfig{}
+	}
+	return r.container.ContainerConfig
+}
+
 func (r *RealContainer) Details() interfaces.ContainerDetails {
 	return interfaces.ContainerDetails{
 		Name:      r.container.Name,
@@ -294,6 +965,15 @@ func (r *RealVirtualMachine) Details() interfaces.VirtualMachineDetails {
 	}
 }
 
+// CurrentConfig returns the configuration fetched when the VM was looked
+// up; it is never nil.
+func (r *RealVirtualMachine) CurrentConfig() *proxmox.VirtualMachineConfig {
+	if r.vm.VirtualMachineConfig == nil {
+		return &proxmox.VirtualMachineConfig{}
+	}
+	return r.vm.VirtualMachineConfig
+}
+
 func (r *RealVirtualMachine) Delete(ctx context.Context, options *proxmox.VirtualMachineDeleteOptions) (*proxmox.Task, error) {
 	return r.vm.Delete(ctx, options)
 }
@@ -378,6 +1058,18 @@ func (r *RealVirtualMachine) TermWebSocket(term *proxmox.Term) (chan []byte, cha
 	return r.vm.TermWebSocket(term)
 }
 
+func (r *RealVirtualMachine) VNCProxy(ctx context.Context, config *proxmox.VNCConfig) (*proxmox.VNC, error) {
+	return r.vm.VNCProxy(ctx, config)
+}
+
+func (r *RealVirtualMachine) VNCWebSocket(vnc *proxmox.VNC) (chan []byte, chan []byte, chan error, func() error, error) {
+	return r.vm.VNCWebSocket(vnc)
+}
+
+func (r *RealVirtualMachine) SpiceProxy(ctx context.Context) (*proxmox.SpiceProxy, error) {
+	return r.vm.SpiceProxy(ctx)
+}
+
 // Global variable for dependency injection (for testing)
 var (
 	clientFactory   func() interfaces.ProxmoxClientInterface
@@ -461,21 +1153,24 @@ func GetClient() (interfaces.ProxmoxClientInterface, error) {
 	apiEndpoint := normalizedEndpoint + "/api2/json"
 
 	var realClient *proxmox.Client
+	raw := &rawAPI{httpClient: httpClient, baseURL: apiEndpoint, header: http.Header{}}
 	if HasAPIToken() {
 		realClient = proxmox.NewClient(apiEndpoint,
 			proxmox.WithHTTPClient(httpClient),
 			proxmox.WithAPIToken(ContextString("api_token.token_id"), ContextString("api_token.secret")))
+		raw.useAPIToken(ContextString("api_token.token_id"), ContextString("api_token.secret"))
 	} else if HasSessionTicket() {
 		realClient = proxmox.NewClient(apiEndpoint,
 			proxmox.WithHTTPClient(httpClient),
 			proxmox.WithSession(ContextString("auth_ticket.ticket"), ContextString("auth_ticket.CSRFPreventionToken")))
+		raw.useSession(ContextString("auth_ticket.ticket"))
 	}
 
 	if realClient == nil {
 		realClient = proxmox.NewClient(apiEndpoint, proxmox.WithHTTPClient(httpClient))
 	}
 
-	return &RealProxmoxClient{client: realClient}, nil
+	return &RealProxmoxClient{client: realClient, raw: raw}, nil
 }
 
 func AuthenticatedClient() (interfaces.ProxmoxClientInterface, error) {
@@ -517,7 +1212,41 @@ func SessionClient() (interfaces.ProxmoxClientInterface, error) {
 	realClient := proxmox.NewClient(normalizedEndpoint+"/api2/json",
 		proxmox.WithHTTPClient(httpClient),
 		proxmox.WithSession(ContextString("auth_ticket.ticket"), ContextString("auth_ticket.CSRFPreventionToken")))
-	return &RealProxmoxClient{client: realClient}, nil
+	raw := &rawAPI{httpClient: httpClient, baseURL: normalizedEndpoint + "/api2/json", header: http.Header{}}
+	raw.useSession(ContextString("auth_ticket.ticket"))
+	return &RealProxmoxClient{client: realClient, raw: raw}, nil
+}
+
+// TokenClient returns a client authenticated with the given API token rather
+// than the stored credentials, for verifying a token before it is saved.
+func TokenClient(tokenID, secret string) (interfaces.ProxmoxClientInterface, error) {
+	endpoint := ContextString("server_url")
+	if endpoint == "" {
+		return nil, errors.New("server URL is not configured; run 'proxmox-cli init'")
+	}
+
+	clientFactoryMu.RLock()
+	factory := clientFactory
+	clientFactoryMu.RUnlock()
+	if factory != nil {
+		return factory(), nil
+	}
+
+	normalizedEndpoint, err := NormalizeServerURL(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid server URL: %w", err)
+	}
+	httpClient, err := NewHTTPClient(ContextBool("insecure"), ContextString("ca_cert"))
+	if err != nil {
+		return nil, fmt.Errorf("configure HTTP client: %w", err)
+	}
+
+	realClient := proxmox.NewClient(normalizedEndpoint+"/api2/json",
+		proxmox.WithHTTPClient(httpClient),
+		proxmox.WithAPIToken(tokenID, secret))
+	raw := &rawAPI{httpClient: httpClient, baseURL: normalizedEndpoint + "/api2/json", header: http.Header{}}
+	raw.useAPIToken(tokenID, secret)
+	return &RealProxmoxClient{client: realClient, raw: raw}, nil
 }
 
 // SetClientFactory sets a factory function for creating clients (used by tests)
@@ -795,6 +1524,97 @@ func RegisterNodeFlagCompletion(cmd *cobra.Command, flag string) {
 	})
 }
 
+// RegisterBridgeFlagCompletion wires completion of bridge names for the
+// given flag from the node named by nodeFlag.
+func RegisterBridgeFlagCompletion(cmd *cobra.Command, flag, nodeFlag string) {
+	_ = cmd.RegisterFlagCompletionFunc(flag, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		nodeName, err := cmd.Flags().GetString(nodeFlag)
+		if err != nil || nodeName == "" {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		client, err := AuthenticatedClient()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
+		defer cancel()
+		node, err := client.Node(ctx, nodeName)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		networks, err := node.Networks(ctx, "any_bridge")
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		names := make([]string, 0, len(networks))
+		for _, network := range networks {
+			names = append(names, network.Iface)
+		}
+		if cluster, err := client.Cluster(ctx); err == nil {
+			if vnets, err := cluster.SDNVNets(ctx); err == nil {
+				for _, vnet := range vnets {
+					names = append(names, vnet.Name)
+				}
+			}
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// ValidateBridge checks that bridge is a bridge on the node or an SDN vnet
+// whose zone spans the node, listing both when it is neither. SDN lookup
+// errors are ignored so clusters without SDN get the plain bridge error.
+func ValidateBridge(ctx context.Context, client interfaces.ProxmoxClientInterface, node interfaces.NodeInterface, nodeName, bridge string) error {
+	networks, err := node.Networks(ctx)
+	if err != nil {
+		return fmt.Errorf("list networks on node %q: %w", nodeName, err)
+	}
+	bridges := []string{}
+	for _, network := range networks {
+		if network.Type != "bridge" && network.Type != "OVSBridge" {
+			continue
+		}
+		if network.Iface == bridge {
+			return nil
+		}
+		bridges = append(bridges, network.Iface)
+	}
+	sort.Strings(bridges)
+	available := strings.Join(bridges, ", ")
+
+	if cluster, err := client.Cluster(ctx); err == nil {
+		if vnets, err := cluster.SDNVNets(ctx); err == nil && len(vnets) > 0 {
+			names := make([]string, 0, len(vnets))
+			for _, vnet := range vnets {
+				if vnet.Name == bridge {
+					return checkVNetZone(ctx, cluster, vnet, nodeName)
+				}
+				names = append(names, vnet.Name)
+			}
+			sort.Strings(names)
+			available += "; SDN vnets: " + strings.Join(names, ", ")
+		}
+	}
+	return fmt.Errorf("bridge %q not found on node %q (available: %s)", bridge, nodeName, available)
+}
+
+// checkVNetZone rejects a vnet whose zone is restricted to other nodes.
+func checkVNetZone(ctx context.Context, cluster interfaces.ClusterInterface, vnet *proxmox.VNet, nodeName string) error {
+	zones, err := cluster.SDNZones(ctx)
+	if err != nil {
+		return fmt.Errorf("list SDN zones: %w", err)
+	}
+	for _, zone := range zones {
+		if zone.Name != vnet.Zone || len(zone.Nodes) == 0 {
+			continue
+		}
+		if !slices.Contains([]string(zone.Nodes), nodeName) {
+			return fmt.Errorf("SDN vnet %q is in zone %q, which does not include node %q (nodes: %s)", vnet.Name, zone.Name, nodeName, strings.Join(zone.Nodes, ", "))
+		}
+	}
+	return nil
+}
+
 // ResolveVMID returns id unchanged when positive, or asks the cluster for
 // the next free guest ID when id is zero.
 func ResolveVMID(ctx context.Context, client interfaces.ProxmoxClientInterface, id int) (int, error) {
@@ -815,6 +1635,53 @@ func ResolveVMID(ctx context.Context, client interfaces.ProxmoxClientInterface,
 	return next, nil
 }
 
+// GuestPools maps guest IDs to the resource pool they belong to, using the
+// pool field of the cluster resource list. Guests outside any pool are not
+// included.
+func GuestPools(ctx context.Context, client interfaces.ProxmoxClientInterface) (map[uint64]string, error) {
+	cluster, err := client.Cluster(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get cluster: %w", err)
+	}
+	resources, err := cluster.Resources(ctx, "vm")
+	if err != nil {
+		return nil, fmt.Errorf("list cluster resources: %w", err)
+	}
+	pools := map[uint64]string{}
+	for _, resource := range resources {
+		if resource != nil && resource.Pool != "" {
+			pools[resource.VMID] = resource.Pool
+		}
+	}
+	return pools, nil
+}
+
+// RegisterPoolFlagCompletion wires completion of resource pool names for the
+// given flag.
+func RegisterPoolFlagCompletion(cmd *cobra.Command, flag string) {
+	_ = cmd.RegisterFlagCompletionFunc(flag, CompletePools)
+}
+
+// CompletePools completes resource pool names. It can be used directly as a
+// ValidArgsFunction for commands that take a pool argument.
+func CompletePools(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	client, err := AuthenticatedClient()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
+	defer cancel()
+	pools, err := client.Pools(ctx)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	names := make([]string, 0, len(pools))
+	for _, pool := range pools {
+		names = append(names, pool.PoolID)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
 // OutputFormat validates and returns the shared --output flag.
 func OutputFormat(cmd *cobra.Command) (string, error) {
 	format, err := cmd.Flags().GetString("output")
@@ -839,6 +1706,22 @@ func PrintJSON(out io.Writer, v any) error {
 	return nil
 }
 
+// DashIfEmpty returns value, or "-" for an empty table cell.
+func DashIfEmpty(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// YesNo renders a boolean table cell.
+func YesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
+
 // RRDSummary condenses a series of RRD samples into latest/average/peak
 // figures for display.
 type RRDSummary struct {
@@ -951,6 +1834,10 @@ func AddTimeframeFlag(cmd *cobra.Command) {
 // the user does not override it with --timeout.
 const DefaultTaskTimeout = 10 * time.Minute
 
+// taskLogLimit is how many lines TaskLog reads; Proxmox returns only 50 by
+// default, which cuts off the summaries of long backups and migrations.
+const taskLogLimit = 10000
+
 // TaskTimeout returns the value of the root --timeout flag, falling back to
 // the default when the flag is missing or not positive.
 func TaskTimeout(cmd *cobra.Command) time.Duration {
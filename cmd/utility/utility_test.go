@@ -3,8 +3,10 @@ package utility
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"math"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
@@ -412,3 +414,58 @@ func TestLoadConfigSecuresLegacyFile(t *testing.T) {
 		t.Fatalf("legacy config permissions = %o, want 600", info.Mode().Perm())
 	}
 }
+
+func TestContainerMoveVolumePostsVolume(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api2/json/nodes/pve/lxc/200/move_volume" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"data":"UPID:pve:00001234:00112233:65432100:vzmove:200:root@pam:"}`))
+	}))
+	defer server.Close()
+
+	container := &RealContainer{
+		container: &proxmox.Container{Node: "pve", VMID: 200},
+		client:    proxmox.NewClient(server.URL + "/api2/json"),
+	}
+	task, err := container.MoveVolume(context.Background(), &interfaces.ContainerMoveVolumeOptions{
+		Volume: "mp0", Storage: "ceph", Delete: proxmox.IntOrBool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task == nil || task.Type != "vzmove" {
+		t.Fatalf("unexpected task %+v", task)
+	}
+	if body["volume"] != "mp0" || body["storage"] != "ceph" || body["delete"] != float64(1) {
+		t.Fatalf("unexpected body %v", body)
+	}
+	if _, ok := body["disk"]; ok {
+		t.Fatalf("disk must not be sent: %v", body)
+	}
+}
+
+func TestWakeNodePostsWithoutNodeLookup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api2/json/nodes/pve2/wakeonlan" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":"aa:bb:cc:dd:ee:ff"}`))
+	}))
+	defer server.Close()
+
+	client := &RealProxmoxClient{client: proxmox.NewClient(server.URL + "/api2/json")}
+	mac, err := client.WakeNode(context.Background(), "pve2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mac != "aa:bb:cc:dd:ee:ff" {
+		t.Fatalf("unexpected MAC %q", mac)
+	}
+}
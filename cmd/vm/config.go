@@ -25,7 +25,11 @@ func newConfigSetCmd() *cobra.Command {
 		Short: "Set virtual machine configuration options",
 		Long: `Apply one or more configuration options to a virtual machine, e.g.:
 
-  proxmox-cli vm config set -n pve -i 100 memory=4096 cores=4`,
+  proxmox-cli vm config set -n pve -i 100 memory=4096 cores=4
+
+Values of known options are checked before they are sent. Options the
+built-in schema does not know only produce a warning, and --no-validate
+skips the checks entirely.`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			out := cmd.OutOrStdout()
@@ -40,6 +44,22 @@ func newConfigSetCmd() *cobra.Command {
 				}
 				options = append(options, proxmox.VirtualMachineOption{Name: key, Value: value})
 			}
+			noValidate, err := cmd.Flags().GetBool("no-validate")
+			if err != nil {
+				return fmt.Errorf("read no-validate flag: %w", err)
+			}
+			if !noValidate {
+				unknown, problems, err := checkVMOptions(options, "config")
+				if err != nil {
+					return err
+				}
+				if err := optionsError(problems); err != nil {
+					return err
+				}
+				for _, problem := range unknown {
+					fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s; sending it anyway\n", problem)
+				}
+			}
 
 			vm, id, err := vmFromFlags(cmd)
 			if err != nil {
@@ -60,6 +80,7 @@ func newConfigSetCmd() *cobra.Command {
 	}
 
 	addVMTargetFlags(cmd)
+	cmd.Flags().Bool("no-validate", false, "Send the options without checking them against the built-in schema")
 	return cmd
 }
 
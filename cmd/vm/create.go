@@ -5,21 +5,31 @@ import (
 	"fmt"
 	"github.com/Adz-ai/proxmox-cli/cmd/utility"
 	"io"
-	"os"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/luthermonson/go-proxmox"
 	"github.com/spf13/cobra"
-	"gopkg.in/yaml.v3"
 )
 
 func newCreateVMCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a new virtual machine from a YAML spec file",
-		Args:  cobra.NoArgs,
+		Long: `Create a virtual machine from a YAML file of qemu parameters. The spec is
+checked against the qemu parameter schema first, so typos and out-of-range
+values are reported before anything is sent to Proxmox.
+
+Specs may use variables, Go templates, "extends: base.yaml", and several
+YAML documents to create one VM per document:
+
+  proxmox-cli vm create -n pve -s web.yaml --set name=web1 --values prod.yaml
+
+Use --validate-only to lint a spec without a node or credentials, e.g. in CI:
+
+  proxmox-cli vm create -s vm.yaml --validate-only`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			out := cmd.OutOrStdout()
 
@@ -35,9 +45,13 @@ func newCreateVMCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("get id flag: %w", err)
 			}
+			validateOnly, err := cmd.Flags().GetBool("validate-only")
+			if err != nil {
+				return fmt.Errorf("get validate-only flag: %w", err)
+			}
 			node = strings.TrimSpace(node)
 			specFile = strings.TrimSpace(specFile)
-			if node == "" {
+			if node == "" && !validateOnly {
 				return fmt.Errorf("validate node: node cannot be empty")
 			}
 			if specFile == "" {
@@ -47,22 +61,49 @@ func newCreateVMCmd() *cobra.Command {
 				return fmt.Errorf("validate id: id must be positive")
 			}
 
-			spec, err := readYAMLSpec(specFile)
+			values, err := utility.SpecValuesFromFlags(cmd)
 			if err != nil {
-				return fmt.Errorf("read VM spec %q: %w", specFile, err)
+				return err
 			}
-
-			vmOptions, err := mapToVMOptions(spec)
+			specs, err := readYAMLSpec(specFile, values)
 			if err != nil {
-				return fmt.Errorf("map VM spec to options: %w", err)
+				return fmt.Errorf("read VM spec %q: %w", specFile, err)
+			}
+			if len(specs) > 1 && id != 0 {
+				return fmt.Errorf("validate id: --id cannot be used with a multi-document spec; IDs are auto-assigned")
 			}
 
-			createdID, err := createVirtualMachine(cmd.Context(), node, id, vmOptions, utility.TaskTimeout(cmd), out)
-			if err != nil {
-				return fmt.Errorf("create virtual machine on node %q: %w", node, err)
+			// Check every document before creating anything.
+			allOptions := make([][]proxmox.VirtualMachineOption, 0, len(specs))
+			for index, spec := range specs {
+				vmOptions, err := mapToVMOptions(spec)
+				if err == nil {
+					err = validateVMOptions(vmOptions, "create")
+				}
+				if err != nil {
+					if len(specs) > 1 {
+						return fmt.Errorf("validate VM spec %q document %d: %w", specFile, index+1, err)
+					}
+					return fmt.Errorf("validate VM spec %q: %w", specFile, err)
+				}
+				allOptions = append(allOptions, vmOptions)
+			}
+			if validateOnly {
+				if len(specs) > 1 {
+					fmt.Fprintf(out, "VM spec %s is valid (%d documents)\n", specFile, len(specs))
+				} else {
+					fmt.Fprintf(out, "VM spec %s is valid (%d options)\n", specFile, len(allOptions[0]))
+				}
+				return nil
 			}
 
-			fmt.Fprintf(out, "Virtual machine %d created successfully.\n", createdID)
+			for _, vmOptions := range allOptions {
+				createdID, err := createVirtualMachine(cmd.Context(), node, id, vmOptions, utility.TaskTimeout(cmd), out)
+				if err != nil {
+					return fmt.Errorf("create virtual machine on node %q: %w", node, err)
+				}
+				fmt.Fprintf(out, "Virtual machine %d created successfully.\n", createdID)
+			}
 			return nil
 		},
 	}
@@ -70,9 +111,8 @@ func newCreateVMCmd() *cobra.Command {
 	cmd.Flags().StringP("node", "n", "", "Node to create the virtual machine")
 	cmd.Flags().StringP("spec", "s", "", "Path to the YAML spec file")
 	cmd.Flags().IntP("id", "i", 0, "ID of the virtual machine (omit to auto-assign the next free ID)")
-	if err := cmd.MarkFlagRequired("node"); err != nil {
-		panic(err)
-	}
+	cmd.Flags().Bool("validate-only", false, "Check the spec against the qemu parameter schema without creating anything")
+	utility.AddSpecValueFlags(cmd)
 	if err := cmd.MarkFlagRequired("spec"); err != nil {
 		panic(err)
 	}
@@ -81,18 +121,10 @@ func newCreateVMCmd() *cobra.Command {
 	return cmd
 }
 
-func readYAMLSpec(filename string) (map[string]interface{}, error) {
-	file, err := os.ReadFile(filename)
-	if err != nil {
-		return nil, fmt.Errorf("read file: %w", err)
-	}
-
-	var spec map[string]interface{}
-	if err := yaml.Unmarshal(file, &spec); err != nil {
-		return nil, fmt.Errorf("parse YAML: %w", err)
-	}
-
-	return spec, nil
+// readYAMLSpec loads every document of a VM spec file, with variables,
+// templates, and extends resolved by utility.LoadSpecs.
+func readYAMLSpec(filename string, values map[string]interface{}) ([]map[string]interface{}, error) {
+	return utility.LoadSpecs(filename, values)
 }
 
 func mapToVMOptions(spec map[string]interface{}) ([]proxmox.VirtualMachineOption, error) {
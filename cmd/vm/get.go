@@ -15,6 +15,7 @@ type vmSummary struct {
 	VMID   uint64 `json:"vmid"`
 	Name   string `json:"name"`
 	Status string `json:"status"`
+	Pool   string `json:"pool,omitempty"`
 	Uptime uint64 `json:"uptime_seconds"`
 }
 
@@ -39,6 +40,10 @@ func newGetCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("get status flag: %w", err)
 			}
+			poolFilter, err := cmd.Flags().GetString("pool")
+			if err != nil {
+				return fmt.Errorf("get pool flag: %w", err)
+			}
 
 			client, err := utility.AuthenticatedClient()
 			if err != nil {
@@ -50,6 +55,11 @@ func newGetCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("fetch cluster nodes: %w", err)
 			}
+			// Pools only decorate the listing unless --pool filters on them.
+			pools, err := utility.GuestPools(ctx, client)
+			if err != nil && poolFilter != "" {
+				return err
+			}
 
 			summaries := []vmSummary{}
 			var nodeErrors []error
@@ -73,11 +83,16 @@ func newGetCmd() *cobra.Command {
 					if statusFilter != "" && vm.Status != statusFilter {
 						continue
 					}
+					pool := pools[uint64(vm.VMID)]
+					if poolFilter != "" && pool != poolFilter {
+						continue
+					}
 					summaries = append(summaries, vmSummary{
 						Node:   nodeStatus.Node,
 						VMID:   uint64(vm.VMID),
 						Name:   vm.Name,
 						Status: vm.Status,
+						Pool:   pool,
 						Uptime: vm.Uptime,
 					})
 				}
@@ -99,7 +114,9 @@ func newGetCmd() *cobra.Command {
 
 	cmd.Flags().StringP("node", "n", "", "Only list VMs on this node")
 	cmd.Flags().String("status", "", "Only list VMs with this status")
+	cmd.Flags().String("pool", "", "Only list VMs in this resource pool")
 	utility.RegisterNodeFlagCompletion(cmd, "node")
+	utility.RegisterPoolFlagCompletion(cmd, "pool")
 	utility.AddOutputFlag(cmd)
 	return cmd
 }
@@ -113,8 +130,8 @@ func printVMTable(out io.Writer, summaries []vmSummary) {
 		if summary.Node != currentNode {
 			currentNode = summary.Node
 			fmt.Fprintf(out, "\nNode: %s\n", summary.Node)
-			fmt.Fprintf(out, "%-10s %-20s %-10s %-12s\n", "VMID", "Name", "Status", "Uptime")
-			fmt.Fprintf(out, "%-10s %-20s %-10s %-12s\n", "----", "----", "------", "------")
+			fmt.Fprintf(out, "%-10s %-20s %-10s %-12s %-12s\n", "VMID", "Name", "Status", "Pool", "Uptime")
+			fmt.Fprintf(out, "%-10s %-20s %-10s %-12s %-12s\n", "----", "----", "------", "----", "------")
 		}
 		uptime := "N/A"
 		if summary.Uptime > 0 {
@@ -122,10 +139,15 @@ func printVMTable(out io.Writer, summaries []vmSummary) {
 			hours := (summary.Uptime % 86400) / 3600
 			uptime = fmt.Sprintf("%dd %dh", days, hours)
 		}
-		fmt.Fprintf(out, "%-10v %-20s %-10s %-12s\n",
+		pool := summary.Pool
+		if pool == "" {
+			pool = "-"
+		}
+		fmt.Fprintf(out, "%-10v %-20s %-10s %-12s %-12s\n",
 			summary.VMID,
 			summary.Name,
 			summary.Status,
+			pool,
 			uptime)
 	}
 
@@ -0,0 +1,586 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// maxNICs is the number of netN slots Proxmox allows per VM.
+const maxNICs = 32
+
+var nicModels = []string{"virtio", "e1000", "e1000e", "rtl8139", "vmxnet3"}
+
+// vmNIC is a parsed netN value. Options the CLI has no typed flag for
+// (queues, trunks, ...) are kept verbatim in Extra so edits preserve them.
+type vmNIC struct {
+	Name     string   `json:"name"`
+	Model    string   `json:"model"`
+	MAC      string   `json:"mac,omitempty"`
+	Bridge   string   `json:"bridge,omitempty"`
+	Tag      int      `json:"tag,omitempty"`
+	MTU      int      `json:"mtu,omitempty"`
+	Rate     string   `json:"rate_mbs,omitempty"`
+	Firewall bool     `json:"firewall"`
+	LinkDown bool     `json:"link_down"`
+	Extra    []string `json:"extra,omitempty"`
+}
+
+func isNICModel(value string) bool {
+	for _, model := range nicModels {
+		if value == model {
+			return true
+		}
+	}
+	return false
+}
+
+// parseNIC decodes a netN value such as
+// "virtio=BC:24:11:00:00:01,bridge=vmbr0,tag=20,firewall=1".
+func parseNIC(name, value string) (vmNIC, error) {
+	nic := vmNIC{Name: name}
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, val, _ := strings.Cut(part, "=")
+		switch {
+		case isNICModel(key):
+			nic.Model, nic.MAC = key, val
+		case key == "model":
+			nic.Model = val
+		case key == "macaddr":
+			nic.MAC = val
+		case key == "bridge":
+			nic.Bridge = val
+		case key == "tag":
+			tag, err := strconv.Atoi(val)
+			if err != nil {
+				return vmNIC{}, fmt.Errorf("parse %s: invalid tag %q", name, val)
+			}
+			nic.Tag = tag
+		case key == "mtu":
+			mtu, err := strconv.Atoi(val)
+			if err != nil {
+				return vmNIC{}, fmt.Errorf("parse %s: invalid mtu %q", name, val)
+			}
+			nic.MTU = mtu
+		case key == "rate":
+			nic.Rate = val
+		case key == "firewall":
+			nic.Firewall = val == "1"
+		case key == "link_down":
+			nic.LinkDown = val == "1"
+		default:
+			nic.Extra = append(nic.Extra, part)
+		}
+	}
+	if nic.Model == "" {
+		return vmNIC{}, fmt.Errorf("parse %s: no NIC model in %q", name, value)
+	}
+	return nic, nil
+}
+
+// String encodes the NIC back into the netN property format.
+func (nic vmNIC) String() string {
+	parts := []string{nic.Model}
+	if nic.MAC != "" {
+		parts[0] += "=" + nic.MAC
+	}
+	if nic.Bridge != "" {
+		parts = append(parts, "bridge="+nic.Bridge)
+	}
+	if nic.Tag > 0 {
+		parts = append(parts, "tag="+strconv.Itoa(nic.Tag))
+	}
+	if nic.MTU > 0 {
+		parts = append(parts, "mtu="+strconv.Itoa(nic.MTU))
+	}
+	if nic.Rate != "" {
+		parts = append(parts, "rate="+nic.Rate)
+	}
+	if nic.Firewall {
+		parts = append(parts, "firewall=1")
+	}
+	if nic.LinkDown {
+		parts = append(parts, "link_down=1")
+	}
+	return strings.Join(append(parts, nic.Extra...), ",")
+}
+
+func (nic vmNIC) validate() error {
+	if !isNICModel(nic.Model) {
+		return fmt.Errorf("unsupported NIC model %q; use %s", nic.Model, strings.Join(nicModels, ", "))
+	}
+	if nic.MAC != "" {
+		hw, err := net.ParseMAC(nic.MAC)
+		if err != nil || len(hw) != 6 {
+			return fmt.Errorf("invalid MAC address %q", nic.MAC)
+		}
+	}
+	if nic.Tag < 0 || nic.Tag > 4094 {
+		return fmt.Errorf("VLAN tag must be between 1 and 4094 (0 for untagged)")
+	}
+	// An MTU of 1 tells virtio to inherit the bridge MTU.
+	if nic.MTU != 0 && nic.MTU != 1 && (nic.MTU < 576 || nic.MTU > 65520) {
+		return fmt.Errorf("MTU must be 1 (inherit from bridge) or between 576 and 65520")
+	}
+	if nic.MTU != 0 && nic.Model != "virtio" {
+		return fmt.Errorf("MTU can only be set on virtio NICs")
+	}
+	if nic.Rate != "" {
+		rate, err := strconv.ParseFloat(nic.Rate, 64)
+		if err != nil || rate < 0 {
+			return fmt.Errorf("invalid rate limit %q; use MB/s, e.g. 12.5", nic.Rate)
+		}
+	}
+	return nil
+}
+
+// sortedNICs parses every netN entry of a VM config, ordered by index.
+func sortedNICs(config *proxmox.VirtualMachineConfig) ([]vmNIC, error) {
+	names := make([]string, 0, len(config.Nets))
+	for name := range config.Nets {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return nicIndex(names[i]) < nicIndex(names[j]) })
+
+	nics := make([]vmNIC, 0, len(names))
+	for _, name := range names {
+		nic, err := parseNIC(name, config.Nets[name])
+		if err != nil {
+			return nil, err
+		}
+		nics = append(nics, nic)
+	}
+	return nics, nil
+}
+
+func nicIndex(name string) int {
+	index, err := strconv.Atoi(strings.TrimPrefix(name, "net"))
+	if err != nil {
+		return -1
+	}
+	return index
+}
+
+// nextFreeNIC returns the lowest netN key not used by the config.
+func nextFreeNIC(config *proxmox.VirtualMachineConfig) (string, error) {
+	for index := 0; index < maxNICs; index++ {
+		name := fmt.Sprintf("net%d", index)
+		if _, used := config.Nets[name]; !used {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("all %d NIC slots are in use", maxNICs)
+}
+
+func validateNICName(name string) error {
+	if index := nicIndex(name); !strings.HasPrefix(name, "net") || index < 0 || index >= maxNICs {
+		return fmt.Errorf("invalid NIC %q; use net0 to net%d", name, maxNICs-1)
+	}
+	return nil
+}
+
+// validateBridge checks that bridge is a bridge on the node or an SDN vnet
+// available there.
+// nicTarget is the VM a nic command edits, with the client and node that
+// bridge validation needs.
+type nicTarget struct {
+	client   interfaces.ProxmoxClientInterface
+	node     interfaces.NodeInterface
+	vm       interfaces.VirtualMachineInterface
+	nodeName string
+	id       int
+}
+
+func nicTargetFromFlags(cmd *cobra.Command) (*nicTarget, error) {
+	nodeName, id, err := vmTargetFromFlags(cmd)
+	if err != nil {
+		return nil, err
+	}
+	client, err := utility.AuthenticatedClient()
+	if err != nil {
+		return nil, fmt.Errorf("authenticate Proxmox client: %w", err)
+	}
+	node, err := client.Node(cmd.Context(), nodeName)
+	if err != nil {
+		return nil, fmt.Errorf("get node %q: %w", nodeName, err)
+	}
+	vm, err := node.VirtualMachine(cmd.Context(), id)
+	if err != nil {
+		return nil, fmt.Errorf("get VM %d: %w", id, err)
+	}
+	return &nicTarget{client: client, node: node, vm: vm, nodeName: nodeName, id: id}, nil
+}
+
+func (t *nicTarget) validateBridge(ctx context.Context, bridge string) error {
+	return utility.ValidateBridge(ctx, t.client, t.node, t.nodeName, bridge)
+}
+
+func newNICCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nic",
+		Short: "Manage virtual machine network interfaces",
+		Long: `List, add, edit, and remove VM network interfaces with typed flags
+instead of hand-written netN strings.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newNICListCmd(), newNICAddCmd(), newNICSetCmd(), newNICRemoveCmd())
+	return cmd
+}
+
+func newNICListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List virtual machine network interfaces",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			vm, id, err := vmFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			nics, err := sortedNICs(vm.CurrentConfig())
+			if err != nil {
+				return fmt.Errorf("read NICs of VM %d: %w", id, err)
+			}
+
+			if format == "json" {
+				return utility.PrintJSON(out, nics)
+			}
+			printNICTable(out, id, nics)
+			return nil
+		},
+	}
+
+	addVMTargetFlags(cmd)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func printNICTable(out io.Writer, id int, nics []vmNIC) {
+	fmt.Fprintf(out, "Network interfaces of VM %d:\n", id)
+	fmt.Fprintf(out, "%-6s %-8s %-18s %-10s %-5s %-6s %-8s %-9s %s\n", "NIC", "Model", "MAC", "Bridge", "Tag", "MTU", "Rate", "Firewall", "Link")
+	fmt.Fprintf(out, "%-6s %-8s %-18s %-10s %-5s %-6s %-8s %-9s %s\n", "---", "-----", "---", "------", "---", "---", "----", "--------", "----")
+	for _, nic := range nics {
+		tag, mtu, rate := "-", "-", "-"
+		if nic.Tag > 0 {
+			tag = strconv.Itoa(nic.Tag)
+		}
+		if nic.MTU > 0 {
+			mtu = strconv.Itoa(nic.MTU)
+		}
+		if nic.Rate != "" {
+			rate = nic.Rate
+		}
+		firewall, link := "no", "up"
+		if nic.Firewall {
+			firewall = "yes"
+		}
+		if nic.LinkDown {
+			link = "down"
+		}
+		fmt.Fprintf(out, "%-6s %-8s %-18s %-10s %-5s %-6s %-8s %-9s %s\n",
+			nic.Name, nic.Model, nic.MAC, nic.Bridge, tag, mtu, rate, firewall, link)
+	}
+	if len(nics) == 0 {
+		fmt.Fprintln(out, "No network interfaces configured")
+	}
+}
+
+func addNICFlags(cmd *cobra.Command) {
+	cmd.Flags().String("model", "virtio", "NIC model: "+strings.Join(nicModels, ", "))
+	cmd.Flags().String("bridge", "", "Bridge or SDN vnet to attach to, e.g. vmbr0")
+	cmd.Flags().Int("tag", 0, "VLAN tag (0 for untagged)")
+	cmd.Flags().Int("mtu", 0, "MTU (virtio only; 1 inherits the bridge MTU)")
+	cmd.Flags().String("rate", "", "Rate limit in MB/s (empty for unlimited)")
+	cmd.Flags().Bool("firewall", false, "Enable the Proxmox firewall on this NIC")
+	cmd.Flags().Bool("link-down", false, "Disconnect the virtual link")
+	cmd.Flags().String("mac", "", "MAC address (omit to let Proxmox generate one)")
+	_ = cmd.RegisterFlagCompletionFunc("model", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return nicModels, cobra.ShellCompDirectiveNoFileComp
+	})
+	utility.RegisterBridgeFlagCompletion(cmd, "bridge", "node")
+}
+
+// applyNICFlags copies every explicitly set NIC flag onto nic, leaving the
+// remaining fields (notably the MAC address) untouched. It reports whether
+// any flag was set.
+func applyNICFlags(cmd *cobra.Command, nic *vmNIC) (bool, error) {
+	flags := cmd.Flags()
+	changed := false
+	var err error
+	if flags.Changed("model") {
+		changed = true
+		if nic.Model, err = flags.GetString("model"); err != nil {
+			return false, fmt.Errorf("read model flag: %w", err)
+		}
+	}
+	if flags.Changed("bridge") {
+		changed = true
+		if nic.Bridge, err = flags.GetString("bridge"); err != nil {
+			return false, fmt.Errorf("read bridge flag: %w", err)
+		}
+		nic.Bridge = strings.TrimSpace(nic.Bridge)
+	}
+	if flags.Changed("tag") {
+		changed = true
+		if nic.Tag, err = flags.GetInt("tag"); err != nil {
+			return false, fmt.Errorf("read tag flag: %w", err)
+		}
+	}
+	if flags.Changed("mtu") {
+		changed = true
+		if nic.MTU, err = flags.GetInt("mtu"); err != nil {
+			return false, fmt.Errorf("read mtu flag: %w", err)
+		}
+	}
+	if flags.Changed("rate") {
+		changed = true
+		if nic.Rate, err = flags.GetString("rate"); err != nil {
+			return false, fmt.Errorf("read rate flag: %w", err)
+		}
+		nic.Rate = strings.TrimSpace(nic.Rate)
+	}
+	if flags.Changed("firewall") {
+		changed = true
+		if nic.Firewall, err = flags.GetBool("firewall"); err != nil {
+			return false, fmt.Errorf("read firewall flag: %w", err)
+		}
+	}
+	if flags.Changed("link-down") {
+		changed = true
+		if nic.LinkDown, err = flags.GetBool("link-down"); err != nil {
+			return false, fmt.Errorf("read link-down flag: %w", err)
+		}
+	}
+	if flags.Changed("mac") {
+		changed = true
+		if nic.MAC, err = flags.GetString("mac"); err != nil {
+			return false, fmt.Errorf("read mac flag: %w", err)
+		}
+		nic.MAC = strings.ToUpper(strings.TrimSpace(nic.MAC))
+	}
+	return changed, nil
+}
+
+func newNICAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a network interface to a virtual machine",
+		Long: `Attach a new NIC in the next free netN slot, e.g.:
+
+  proxmox-cli vm nic add -n pve -i 100 --bridge vmbr0 --tag 20 --firewall
+
+The bridge is checked against the node's network configuration first; SDN
+vnets are accepted as bridges too.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			name, err := cmd.Flags().GetString("nic")
+			if err != nil {
+				return fmt.Errorf("read nic flag: %w", err)
+			}
+			name = strings.TrimSpace(name)
+			if name != "" {
+				if err := validateNICName(name); err != nil {
+					return err
+				}
+			}
+
+			nic := vmNIC{Model: "virtio"}
+			if _, err := applyNICFlags(cmd, &nic); err != nil {
+				return err
+			}
+			if nic.Bridge == "" {
+				return fmt.Errorf("bridge cannot be empty")
+			}
+			if err := nic.validate(); err != nil {
+				return err
+			}
+
+			target, err := nicTargetFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			vm, id := target.vm, target.id
+			config := vm.CurrentConfig()
+			if name == "" {
+				if name, err = nextFreeNIC(config); err != nil {
+					return fmt.Errorf("add NIC to VM %d: %w", id, err)
+				}
+			} else if _, used := config.Nets[name]; used {
+				return fmt.Errorf("%s already exists on VM %d; use 'vm nic set' to edit it", name, id)
+			}
+			if err := target.validateBridge(ctx, nic.Bridge); err != nil {
+				return err
+			}
+
+			nic.Name = name
+			if err := applyNIC(cmd, vm, nic); err != nil {
+				return fmt.Errorf("add %s to VM %d: %w", name, id, err)
+			}
+			fmt.Fprintf(out, "NIC %s added to VM %d (%s)\n", name, id, nic)
+			return nil
+		},
+	}
+
+	addVMTargetFlags(cmd)
+	addNICFlags(cmd)
+	cmd.Flags().String("nic", "", "NIC slot to use, e.g. net1 (default: next free)")
+	return cmd
+}
+
+func newNICSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Edit a virtual machine network interface",
+		Long: `Change selected properties of an existing NIC, e.g.:
+
+  proxmox-cli vm nic set -n pve -i 100 --nic net0 --tag 30
+
+Only the flags given are changed; the MAC address is kept unless --mac is
+passed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			name, err := nicNameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			target, err := nicTargetFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			vm, id := target.vm, target.id
+			current, ok := vm.CurrentConfig().Nets[name]
+			if !ok {
+				return fmt.Errorf("VM %d has no %s", id, name)
+			}
+			nic, err := parseNIC(name, current)
+			if err != nil {
+				return err
+			}
+			bridge := nic.Bridge
+			changed, err := applyNICFlags(cmd, &nic)
+			if err != nil {
+				return err
+			}
+			if !changed {
+				return fmt.Errorf("nothing to do; pass at least one NIC flag")
+			}
+			if nic.Bridge == "" {
+				return fmt.Errorf("bridge cannot be empty")
+			}
+			if err := nic.validate(); err != nil {
+				return err
+			}
+			if nic.Bridge != bridge {
+				if err := target.validateBridge(ctx, nic.Bridge); err != nil {
+					return err
+				}
+			}
+
+			if err := applyNIC(cmd, vm, nic); err != nil {
+				return fmt.Errorf("update %s of VM %d: %w", name, id, err)
+			}
+			fmt.Fprintf(out, "NIC %s of VM %d updated (%s)\n", name, id, nic)
+			return nil
+		},
+	}
+
+	addVMTargetFlags(cmd)
+	addNICFlags(cmd)
+	addNICNameFlag(cmd)
+	return cmd
+}
+
+func newNICRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove a network interface from a virtual machine",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			name, err := nicNameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			vm, id, err := vmFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if _, ok := vm.CurrentConfig().Nets[name]; !ok {
+				return fmt.Errorf("VM %d has no %s", id, name)
+			}
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Remove %s from VM %d?", name, id)); err != nil {
+				return err
+			}
+
+			task, err := vm.Config(ctx, proxmox.VirtualMachineOption{Name: "delete", Value: name})
+			if err != nil {
+				return fmt.Errorf("remove %s from VM %d: %w", name, id, err)
+			}
+			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+				return fmt.Errorf("remove %s from VM %d: %w", name, id, err)
+			}
+
+			fmt.Fprintf(out, "NIC %s removed from VM %d\n", name, id)
+			return nil
+		},
+	}
+
+	addVMTargetFlags(cmd)
+	addNICNameFlag(cmd)
+	utility.AddYesFlag(cmd)
+	return cmd
+}
+
+func addNICNameFlag(cmd *cobra.Command) {
+	cmd.Flags().String("nic", "", "NIC to act on, e.g. net0")
+	if err := cmd.MarkFlagRequired("nic"); err != nil {
+		panic(err)
+	}
+}
+
+func nicNameFromFlags(cmd *cobra.Command) (string, error) {
+	name, err := cmd.Flags().GetString("nic")
+	if err != nil {
+		return "", fmt.Errorf("read nic flag: %w", err)
+	}
+	name = strings.TrimSpace(name)
+	if err := validateNICName(name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func applyNIC(cmd *cobra.Command, vm interfaces.VirtualMachineInterface, nic vmNIC) error {
+	ctx := cmd.Context()
+	task, err := vm.Config(ctx, proxmox.VirtualMachineOption{Name: nic.Name, Value: nic.String()})
+	if err != nil {
+		return err
+	}
+	return utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), cmd.OutOrStdout())
+}
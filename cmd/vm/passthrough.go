@@ -0,0 +1,461 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// maxHostPCI and maxUSB are the hostpciN and usbN slot counts of qemu-server.
+	maxHostPCI = 16
+	maxUSB     = 14
+)
+
+var (
+	pciAddressPattern = regexp.MustCompile(`^([0-9a-fA-F]{4}:)?[0-9a-fA-F]{2}:[0-9a-fA-F]{2}(\.[0-7])?$`)
+	usbIDPattern      = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{4}$`)
+	usbPortPattern    = regexp.MustCompile(`^[0-9]+-[0-9]+(\.[0-9]+)*$`)
+)
+
+type passthroughDevice struct {
+	Slot  string `json:"slot"`
+	Value string `json:"value"`
+}
+
+func newPassthroughCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "passthrough",
+		Short: "Manage PCI and USB passthrough devices",
+		Long: `List, attach, and detach host PCI and USB devices. Devices are checked
+against the node inventory ('nodes hardware pci|usb') or the cluster
+hardware mappings before the VM config is written.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(newPassthroughListCmd(), newPassthroughAddCmd(), newPassthroughRemoveCmd())
+	return cmd
+}
+
+func newPassthroughListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List passthrough devices of a virtual machine",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format, err := utility.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			vm, id, err := vmFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			config := vm.CurrentConfig()
+			devices := append(sortedDevices("hostpci", config.HostPCIs), sortedDevices("usb", config.USBs)...)
+
+			if format == "json" {
+				return utility.PrintJSON(out, devices)
+			}
+			fmt.Fprintf(out, "Passthrough devices of VM %d:\n", id)
+			fmt.Fprintf(out, "%-10s %s\n", "Slot", "Device")
+			fmt.Fprintf(out, "%-10s %s\n", "----", "------")
+			for _, device := range devices {
+				fmt.Fprintf(out, "%-10s %s\n", device.Slot, device.Value)
+			}
+			if len(devices) == 0 {
+				fmt.Fprintln(out, "No passthrough devices configured")
+			}
+			return nil
+		},
+	}
+
+	addVMTargetFlags(cmd)
+	utility.AddOutputFlag(cmd)
+	return cmd
+}
+
+func sortedDevices(prefix string, entries map[string]string) []passthroughDevice {
+	devices := make([]passthroughDevice, 0, len(entries))
+	for slot, value := range entries {
+		devices = append(devices, passthroughDevice{Slot: slot, Value: value})
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		return slotIndex(prefix, devices[i].Slot) < slotIndex(prefix, devices[j].Slot)
+	})
+	return devices
+}
+
+func slotIndex(prefix, slot string) int {
+	index, err := strconv.Atoi(strings.TrimPrefix(slot, prefix))
+	if err != nil || !strings.HasPrefix(slot, prefix) {
+		return -1
+	}
+	return index
+}
+
+// nextFreeSlot returns the lowest prefixN key not present in entries.
+func nextFreeSlot(prefix string, limit int, entries map[string]string) (string, error) {
+	for index := 0; index < limit; index++ {
+		slot := fmt.Sprintf("%s%d", prefix, index)
+		if _, used := entries[slot]; !used {
+			return slot, nil
+		}
+	}
+	return "", fmt.Errorf("all %d %s slots are in use", limit, prefix)
+}
+
+func newPassthroughAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Attach a host PCI or USB device to a virtual machine",
+		Long: `Attach a host device in the next free hostpciN or usbN slot, e.g.:
+
+  proxmox-cli vm passthrough add -n pve -i 100 --pci 0000:01:00.0 --pcie
+  proxmox-cli vm passthrough add -n pve -i 100 --pci gpu0
+  proxmox-cli vm passthrough add -n pve -i 100 --usb 046d:c52b
+
+--pci takes a PCI address (omit the function, e.g. 01:00, to pass all
+functions) or a cluster PCI mapping name; --usb takes a vendor:product ID,
+a bus-port path such as 1-2.3, or a cluster USB mapping name.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			pci, err := cmd.Flags().GetString("pci")
+			if err != nil {
+				return fmt.Errorf("read pci flag: %w", err)
+			}
+			usb, err := cmd.Flags().GetString("usb")
+			if err != nil {
+				return fmt.Errorf("read usb flag: %w", err)
+			}
+			pci, usb = strings.TrimSpace(pci), strings.TrimSpace(usb)
+			if (pci == "") == (usb == "") {
+				return fmt.Errorf("pass exactly one of --pci or --usb")
+			}
+
+			nodeName, _, err := vmTargetFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			node, vm, id, err := vmWithNodeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			config := vm.CurrentConfig()
+
+			var slot, value, device string
+			if pci != "" {
+				if slot, err = nextFreeSlot("hostpci", maxHostPCI, config.HostPCIs); err != nil {
+					return fmt.Errorf("attach PCI device to VM %d: %w", id, err)
+				}
+				if value, err = pciPassthroughValue(cmd, node, nodeName, pci, config); err != nil {
+					return err
+				}
+				device = "PCI device " + pci
+			} else {
+				if slot, err = nextFreeSlot("usb", maxUSB, config.USBs); err != nil {
+					return fmt.Errorf("attach USB device to VM %d: %w", id, err)
+				}
+				if value, err = usbPassthroughValue(cmd, node, nodeName, usb); err != nil {
+					return err
+				}
+				device = "USB device " + usb
+			}
+
+			task, err := vm.Config(ctx, proxmox.VirtualMachineOption{Name: slot, Value: value})
+			if err != nil {
+				return fmt.Errorf("attach %s to VM %d: %w", device, id, err)
+			}
+			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+				return fmt.Errorf("attach %s to VM %d: %w", device, id, err)
+			}
+
+			fmt.Fprintf(out, "%s attached to VM %d as %s (%s)\n", device, id, slot, value)
+			if vm.Details().Status == "running" {
+				fmt.Fprintln(out, "The device is available to the guest after the VM is restarted")
+			}
+			return nil
+		},
+	}
+
+	addVMTargetFlags(cmd)
+	cmd.Flags().String("pci", "", "PCI address or cluster PCI mapping to attach")
+	cmd.Flags().String("usb", "", "USB vendor:product ID, bus-port, or cluster USB mapping to attach")
+	cmd.Flags().Bool("pcie", false, "Attach as a PCI Express device (q35 machine type only)")
+	cmd.Flags().Bool("rombar", true, "Expose the device ROM to the guest")
+	cmd.Flags().String("mdev", "", "Mediated device type to create, e.g. nvidia-63")
+	cmd.Flags().Bool("usb3", false, "Attach to a USB 3 controller")
+	return cmd
+}
+
+// pciPassthroughValue validates a --pci argument against the node's PCI
+// inventory or the cluster PCI mappings and builds the hostpciN value.
+func pciPassthroughValue(cmd *cobra.Command, node interfaces.NodeInterface, nodeName, pci string, config *proxmox.VirtualMachineConfig) (string, error) {
+	ctx := cmd.Context()
+	flags := cmd.Flags()
+	pcie, err := flags.GetBool("pcie")
+	if err != nil {
+		return "", fmt.Errorf("read pcie flag: %w", err)
+	}
+	rombar, err := flags.GetBool("rombar")
+	if err != nil {
+		return "", fmt.Errorf("read rombar flag: %w", err)
+	}
+	mdev, err := flags.GetString("mdev")
+	if err != nil {
+		return "", fmt.Errorf("read mdev flag: %w", err)
+	}
+	mdev = strings.TrimSpace(mdev)
+	if pcie && !strings.Contains(config.Machine, "q35") {
+		return "", fmt.Errorf("--pcie requires the q35 machine type; set it with 'vm config set machine=q35'")
+	}
+
+	parts := []string{}
+	if pciAddressPattern.MatchString(pci) {
+		address := strings.ToLower(pci)
+		if strings.Count(address, ":") == 1 {
+			address = "0000:" + address
+		}
+		device, err := findPCIDevice(ctx, node, nodeName, address)
+		if err != nil {
+			return "", err
+		}
+		if mdev != "" {
+			if err := validateMdevType(ctx, node, device, mdev); err != nil {
+				return "", err
+			}
+		}
+		parts = append(parts, address)
+	} else {
+		if err := validateHardwareMapping(ctx, "pci", pci, nodeName); err != nil {
+			return "", err
+		}
+		parts = append(parts, "mapping="+pci)
+	}
+
+	if mdev != "" {
+		parts = append(parts, "mdev="+mdev)
+	}
+	if pcie {
+		parts = append(parts, "pcie=1")
+	}
+	if flags.Changed("rombar") {
+		parts = append(parts, "rombar="+boolFlagValue(rombar))
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// findPCIDevice looks address up in the node inventory. An address without
+// a function number (0000:01:00) matches the device's first function.
+func findPCIDevice(ctx context.Context, node interfaces.NodeInterface, nodeName, address string) (*proxmox.PCIDevice, error) {
+	// Query every class so bridges and co-processors are found too.
+	devices, err := node.ListPCIDevices(ctx, &proxmox.HardwarePCIOptions{ClassBlacklist: []string{""}})
+	if err != nil {
+		return nil, fmt.Errorf("list PCI devices on node %q: %w", nodeName, err)
+	}
+	for _, device := range devices {
+		if device.ID == address || strings.HasPrefix(device.ID, address+".") {
+			return device, nil
+		}
+	}
+	return nil, fmt.Errorf("PCI device %s not found on node %q; see 'proxmox-cli nodes hardware pci -n %s'", address, nodeName, nodeName)
+}
+
+func validateMdevType(ctx context.Context, node interfaces.NodeInterface, device *proxmox.PCIDevice, mdev string) error {
+	if !device.MdevCapable {
+		return fmt.Errorf("PCI device %s does not support mediated devices", device.ID)
+	}
+	types, err := node.PCIMdevTypes(ctx, device.ID)
+	if err != nil {
+		return fmt.Errorf("list mdev types of %s: %w", device.ID, err)
+	}
+	available := make([]string, 0, len(types))
+	for _, mdevType := range types {
+		if mdevType.Type == mdev {
+			if mdevType.Available == 0 {
+				return fmt.Errorf("no %s instances left on PCI device %s", mdev, device.ID)
+			}
+			return nil
+		}
+		available = append(available, mdevType.Type)
+	}
+	return fmt.Errorf("PCI device %s has no mdev type %q (available: %s)", device.ID, mdev, strings.Join(available, ", "))
+}
+
+// usbPassthroughValue validates a --usb argument against the node's USB
+// inventory or the cluster USB mappings and builds the usbN value.
+func usbPassthroughValue(cmd *cobra.Command, node interfaces.NodeInterface, nodeName, usb string) (string, error) {
+	ctx := cmd.Context()
+	usb3, err := cmd.Flags().GetBool("usb3")
+	if err != nil {
+		return "", fmt.Errorf("read usb3 flag: %w", err)
+	}
+
+	var value string
+	if usbIDPattern.MatchString(usb) || usbPortPattern.MatchString(usb) {
+		devices, err := node.ListUSBDevices(ctx)
+		if err != nil {
+			return "", fmt.Errorf("list USB devices on node %q: %w", nodeName, err)
+		}
+		found := false
+		for _, device := range devices {
+			if strings.EqualFold(device.VendID+":"+device.ProdID, usb) || fmt.Sprintf("%d-%s", device.BusNum, device.USBPath) == usb {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", fmt.Errorf("USB device %s not found on node %q; see 'proxmox-cli nodes hardware usb -n %s'", usb, nodeName, nodeName)
+		}
+		value = "host=" + strings.ToLower(usb)
+	} else {
+		if err := validateHardwareMapping(ctx, "usb", usb, nodeName); err != nil {
+			return "", err
+		}
+		value = "mapping=" + usb
+	}
+	if usb3 {
+		value += ",usb3=1"
+	}
+	return value, nil
+}
+
+// validateHardwareMapping checks that the named cluster mapping exists, has
+// a device on nodeName, and that Proxmox reports no errors for it there.
+func validateHardwareMapping(ctx context.Context, kind, name, nodeName string) error {
+	client, err := utility.AuthenticatedClient()
+	if err != nil {
+		return fmt.Errorf("authenticate Proxmox client: %w", err)
+	}
+	cluster, err := client.Cluster(ctx)
+	if err != nil {
+		return fmt.Errorf("get cluster: %w", err)
+	}
+
+	var (
+		ids     []string
+		entries []string
+		checks  []*proxmox.ClusterMappingCheck
+		found   bool
+	)
+	switch kind {
+	case "pci":
+		mappings, err := cluster.PCIMappings(ctx, nodeName)
+		if err != nil {
+			return fmt.Errorf("list PCI mappings: %w", err)
+		}
+		for _, mapping := range mappings {
+			ids = append(ids, mapping.ID)
+			if mapping.ID == name {
+				entries, checks, found = mapping.Map, mapping.Checks, true
+			}
+		}
+	default:
+		mappings, err := cluster.USBMappings(ctx, nodeName)
+		if err != nil {
+			return fmt.Errorf("list USB mappings: %w", err)
+		}
+		for _, mapping := range mappings {
+			ids = append(ids, mapping.ID)
+			if mapping.ID == name {
+				entries, checks, found = mapping.Map, mapping.Error, true
+			}
+		}
+	}
+
+	label := strings.ToUpper(kind)
+	if !found {
+		if len(ids) == 0 {
+			return fmt.Errorf("%q is neither a %s device nor a cluster %s mapping", name, label, label)
+		}
+		return fmt.Errorf("%q is neither a %s device nor a cluster %s mapping (mappings: %s)", name, label, label, strings.Join(ids, ", "))
+	}
+	onNode := false
+	for _, entry := range entries {
+		for _, part := range strings.Split(entry, ",") {
+			if part == "node="+nodeName {
+				onNode = true
+			}
+		}
+	}
+	if !onNode {
+		return fmt.Errorf("%s mapping %q has no device on node %q", label, name, nodeName)
+	}
+	for _, check := range checks {
+		if check.Severity == "error" {
+			return fmt.Errorf("%s mapping %q on node %q: %s", label, name, nodeName, check.Message)
+		}
+	}
+	return nil
+}
+
+func boolFlagValue(value bool) string {
+	if value {
+		return "1"
+	}
+	return "0"
+}
+
+func newPassthroughRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Detach a passthrough device from a virtual machine",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			slot, err := cmd.Flags().GetString("slot")
+			if err != nil {
+				return fmt.Errorf("read slot flag: %w", err)
+			}
+			slot = strings.TrimSpace(slot)
+			if slotIndex("hostpci", slot) < 0 && slotIndex("usb", slot) < 0 {
+				return fmt.Errorf("invalid slot %q; use hostpciN or usbN", slot)
+			}
+
+			vm, id, err := vmFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			config := vm.CurrentConfig()
+			if _, ok := config.HostPCIs[slot]; !ok {
+				if _, ok := config.USBs[slot]; !ok {
+					return fmt.Errorf("VM %d has no %s", id, slot)
+				}
+			}
+			if err := utility.ConfirmAction(cmd, fmt.Sprintf("Detach %s from VM %d?", slot, id)); err != nil {
+				return err
+			}
+
+			task, err := vm.Config(ctx, proxmox.VirtualMachineOption{Name: "delete", Value: slot})
+			if err != nil {
+				return fmt.Errorf("detach %s from VM %d: %w", slot, id, err)
+			}
+			if err := utility.WaitForTask(ctx, task, utility.TaskTimeout(cmd), out); err != nil {
+				return fmt.Errorf("detach %s from VM %d: %w", slot, id, err)
+			}
+
+			fmt.Fprintf(out, "%s detached from VM %d\n", slot, id)
+			return nil
+		},
+	}
+
+	addVMTargetFlags(cmd)
+	cmd.Flags().String("slot", "", "Slot to detach, e.g. hostpci0 or usb1")
+	if err := cmd.MarkFlagRequired("slot"); err != nil {
+		panic(err)
+	}
+	utility.AddYesFlag(cmd)
+	return cmd
+}
@@ -0,0 +1,181 @@
+package vm
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/luthermonson/go-proxmox"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed qemu_schema.yaml
+var qemuSchemaYAML []byte
+
+// qemuParam describes one qemu create/config parameter; see the header of
+// qemu_schema.yaml for the meaning of each field.
+type qemuParam struct {
+	Type    string   `yaml:"type"`
+	Enum    []string `yaml:"enum"`
+	Min     *float64 `yaml:"min"`
+	Max     *float64 `yaml:"max"`
+	Pattern string   `yaml:"pattern"`
+	Indexed int      `yaml:"indexed"`
+	Scope   string   `yaml:"scope"`
+
+	pattern *regexp.Regexp
+}
+
+var loadQEMUSchema = sync.OnceValues(func() (map[string]*qemuParam, error) {
+	schema := map[string]*qemuParam{}
+	if err := yaml.Unmarshal(qemuSchemaYAML, &schema); err != nil {
+		return nil, fmt.Errorf("parse embedded qemu schema: %w", err)
+	}
+	for name, param := range schema {
+		if param.Pattern == "" {
+			continue
+		}
+		pattern, err := regexp.Compile(param.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("parse embedded qemu schema: %s: %w", name, err)
+		}
+		param.pattern = pattern
+	}
+	return schema, nil
+})
+
+// lookupQEMUParam resolves key to its schema entry, mapping numbered keys
+// such as net3 to their net[n] entry. It returns a reason when the key is
+// unknown or out of range.
+func lookupQEMUParam(schema map[string]*qemuParam, key string) (*qemuParam, string) {
+	if param, ok := schema[key]; ok && param.Indexed == 0 {
+		return param, ""
+	}
+	prefix := strings.TrimRight(key, "0123456789")
+	if prefix != key && prefix != "" {
+		if param, ok := schema[prefix+"[n]"]; ok {
+			suffix := key[len(prefix):]
+			index, err := strconv.Atoi(suffix)
+			if err == nil && index < param.Indexed && strconv.Itoa(index) == suffix {
+				return param, ""
+			}
+			return nil, fmt.Sprintf("out of range; use %s0 to %s%d", prefix, prefix, param.Indexed-1)
+		}
+	}
+	if suggestion := utility.ClosestMatch(key, qemuParamNames(schema)); suggestion != "" {
+		return nil, fmt.Sprintf("unknown option (did you mean %q?)", suggestion)
+	}
+	return nil, "unknown option"
+}
+
+// qemuParamNames lists the schema keys, with numbered keys shown as their
+// first slot (net0) so suggestions are valid option names.
+func qemuParamNames(schema map[string]*qemuParam) []string {
+	names := make([]string, 0, len(schema))
+	for name, param := range schema {
+		if param.Indexed > 0 {
+			name = strings.TrimSuffix(name, "[n]") + "0"
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validateVMOptions checks options against the embedded qemu schema for the
+// given scope ("create" or "config") and reports every problem at once.
+func validateVMOptions(options []proxmox.VirtualMachineOption, scope string) error {
+	unknown, problems, err := checkVMOptions(options, scope)
+	if err != nil {
+		return err
+	}
+	return optionsError(append(unknown, problems...))
+}
+
+// checkVMOptions returns the options missing from the schema separately
+// from those that are known but invalid, since the embedded schema can lag
+// behind newer Proxmox VE releases.
+func checkVMOptions(options []proxmox.VirtualMachineOption, scope string) (unknown, problems []string, err error) {
+	schema, err := loadQEMUSchema()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, option := range options {
+		param, reason := lookupQEMUParam(schema, option.Name)
+		if param == nil {
+			unknown = append(unknown, fmt.Sprintf("%s: %s", option.Name, reason))
+			continue
+		}
+		if param.Scope != "" && param.Scope != scope {
+			problems = append(problems, fmt.Sprintf("%s: only valid for %s", option.Name, param.Scope))
+			continue
+		}
+		if err := param.check(option.Value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", option.Name, err))
+		}
+	}
+	return unknown, problems, nil
+}
+
+func optionsError(problems []string) error {
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid VM options:\n  %s", strings.Join(problems, "\n  "))
+}
+
+func (p *qemuParam) check(value any) error {
+	text := strings.TrimSpace(fmt.Sprint(value))
+	switch p.Type {
+	case "boolean":
+		if _, ok := value.(bool); ok {
+			return nil
+		}
+		switch strings.ToLower(text) {
+		case "0", "1", "true", "false", "yes", "no", "on", "off":
+			return nil
+		}
+		return fmt.Errorf("expected a boolean (0 or 1), got %q", text)
+	case "integer", "number":
+		number, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return fmt.Errorf("expected a number, got %q", text)
+		}
+		if p.Type == "integer" && number != math.Trunc(number) {
+			return fmt.Errorf("expected an integer, got %q", text)
+		}
+		if p.Min != nil && number < *p.Min {
+			return fmt.Errorf("must be at least %s, got %s", formatBound(*p.Min), text)
+		}
+		if p.Max != nil && number > *p.Max {
+			return fmt.Errorf("must be at most %s, got %s", formatBound(*p.Max), text)
+		}
+	}
+
+	if len(p.Enum) > 0 {
+		for _, allowed := range p.Enum {
+			if text == allowed {
+				return nil
+			}
+		}
+		if suggestion := utility.ClosestMatch(text, p.Enum); suggestion != "" {
+			return fmt.Errorf("invalid value %q (did you mean %q?)", text, suggestion)
+		}
+		return fmt.Errorf("invalid value %q; use one of %s", text, strings.Join(p.Enum, ", "))
+	}
+	if p.pattern != nil && !p.pattern.MatchString(text) {
+		return fmt.Errorf("invalid value %q", text)
+	}
+	return nil
+}
+
+func formatBound(bound float64) string {
+	return strconv.FormatFloat(bound, 'f', -1, 64)
+}
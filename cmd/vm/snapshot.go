@@ -246,24 +246,31 @@ func newSnapshotListCmd() *cobra.Command {
 // vmFromFlags resolves the node/id flags to a virtual machine after
 // authenticating, shared by the snapshot subcommands.
 func vmFromFlags(cmd *cobra.Command) (interfaces.VirtualMachineInterface, int, error) {
+	_, vm, id, err := vmWithNodeFromFlags(cmd)
+	return vm, id, err
+}
+
+// vmWithNodeFromFlags is vmFromFlags for commands that also need the
+// hosting node, e.g. to check bridges or hardware before editing the VM.
+func vmWithNodeFromFlags(cmd *cobra.Command) (interfaces.NodeInterface, interfaces.VirtualMachineInterface, int, error) {
 	node, id, err := vmTargetFromFlags(cmd)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 
 	client, err := utility.AuthenticatedClient()
 	if err != nil {
-		return nil, 0, fmt.Errorf("authenticate Proxmox client: %w", err)
+		return nil, nil, 0, fmt.Errorf("authenticate Proxmox client: %w", err)
 	}
 
 	retrievedNode, err := client.Node(cmd.Context(), node)
 	if err != nil {
-		return nil, 0, fmt.Errorf("get node %q: %w", node, err)
+		return nil, nil, 0, fmt.Errorf("get node %q: %w", node, err)
 	}
 
 	vm, err := retrievedNode.VirtualMachine(cmd.Context(), id)
 	if err != nil {
-		return nil, 0, fmt.Errorf("get VM %d: %w", id, err)
+		return nil, nil, 0, fmt.Errorf("get VM %d: %w", id, err)
 	}
-	return vm, id, nil
+	return retrievedNode, vm, id, nil
 }
@@ -0,0 +1,120 @@
+package vm
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+func newSpiceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spice",
+		Short: "Open the graphical display of a virtual machine over SPICE",
+		Long: `Request a SPICE ticket for the VM and write it as a virt-viewer (.vv)
+file, or hand it straight to remote-viewer with --launch:
+
+  proxmox-cli vm spice -n pve -i 100 --launch
+  proxmox-cli vm spice -n pve -i 100 --file vm100.vv
+
+The VM needs a SPICE display (vga: qxl). Tickets are single-use and expire
+after about 30 seconds, so open the file promptly.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			path, err := cmd.Flags().GetString("file")
+			if err != nil {
+				return fmt.Errorf("read file flag: %w", err)
+			}
+			launch, err := cmd.Flags().GetBool("launch")
+			if err != nil {
+				return fmt.Errorf("read launch flag: %w", err)
+			}
+			path = strings.TrimSpace(path)
+			if path == "" && !launch {
+				return fmt.Errorf("pass --file to write the .vv file or --launch to open remote-viewer")
+			}
+			viewer := ""
+			if launch {
+				if viewer, err = exec.LookPath("remote-viewer"); err != nil {
+					return fmt.Errorf("remote-viewer not found in PATH; install virt-viewer or use --file")
+				}
+			}
+
+			vm, id, err := vmFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			spice, err := vm.SpiceProxy(ctx)
+			if err != nil {
+				return fmt.Errorf("request SPICE ticket for VM %d: %w", id, err)
+			}
+
+			if path == "" {
+				file, err := os.CreateTemp("", fmt.Sprintf("vm-%d-*.vv", id))
+				if err != nil {
+					return fmt.Errorf("create SPICE file: %w", err)
+				}
+				path = file.Name()
+				if err := file.Close(); err != nil {
+					return fmt.Errorf("create SPICE file: %w", err)
+				}
+			}
+			// The file holds a live ticket; keep it private.
+			if err := os.WriteFile(path, []byte(spiceFileContents(spice)), 0o600); err != nil {
+				return fmt.Errorf("write SPICE file: %w", err)
+			}
+
+			if !launch {
+				fmt.Fprintf(out, "SPICE connection file for VM %d written to %s\n", id, path)
+				return nil
+			}
+			fmt.Fprintf(out, "Opening SPICE display of VM %d in remote-viewer\n", id)
+			viewerCmd := exec.CommandContext(ctx, viewer, path)
+			viewerCmd.Stdout = out
+			viewerCmd.Stderr = cmd.ErrOrStderr()
+			if err := viewerCmd.Run(); err != nil {
+				return fmt.Errorf("run remote-viewer: %w", err)
+			}
+			return nil
+		},
+	}
+
+	addVMTargetFlags(cmd)
+	cmd.Flags().String("file", "", "Write the .vv connection file to this path")
+	cmd.Flags().Bool("launch", false, "Open the connection in remote-viewer")
+	return cmd
+}
+
+// spiceFileContents renders the ticket in the virt-viewer INI format that
+// remote-viewer reads.
+func spiceFileContents(spice *proxmox.SpiceProxy) string {
+	var b strings.Builder
+	b.WriteString("[virt-viewer]\n")
+	for _, field := range []struct{ key, value string }{
+		{"type", spice.Type},
+		{"host", spice.Host},
+		{"port", spice.Port},
+		{"tls-port", spice.TLSPort},
+		{"password", spice.Password},
+		{"proxy", spice.Proxy},
+		{"title", spice.Title},
+		{"host-subject", spice.HostSubject},
+		{"ca", spice.CA},
+		{"delete-this-file", spice.DeleteThisFile},
+		{"secure-attention", spice.SecureAttention},
+		{"release-cursor", spice.ReleaseCursor},
+		{"toggle-fullscreen", spice.ToggleFullscreen},
+	} {
+		if field.value == "" {
+			continue
+		}
+		// Multi-line values (the CA) are stored with escaped newlines.
+		fmt.Fprintf(&b, "%s=%s\n", field.key, strings.ReplaceAll(field.value, "\n", `\n`))
+	}
+	return b.String()
+}
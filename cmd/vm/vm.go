@@ -30,12 +30,16 @@ func NewCmd() *cobra.Command {
 	cmd.AddCommand(newCloneCmd())
 	cmd.AddCommand(newMigrateCmd())
 	cmd.AddCommand(newConfigCmd())
+	cmd.AddCommand(newNICCmd())
+	cmd.AddCommand(newPassthroughCmd())
 	cmd.AddCommand(newResizeCmd())
 	cmd.AddCommand(newTagsCmd())
 	cmd.AddCommand(newExecCmd())
 	cmd.AddCommand(newIPCmd())
 	cmd.AddCommand(newStatsCmd())
 	cmd.AddCommand(newConsoleCmd())
+	cmd.AddCommand(newVNCCmd())
+	cmd.AddCommand(newSpiceCmd())
 
 	return cmd
 }
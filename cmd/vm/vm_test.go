@@ -3,6 +3,10 @@ package vm
 import (
 	"bytes"
 	"context"
+	"crypto/des"
+	"errors"
+	"io"
+	"net"
 	"os"
 	"path/filepath"
 	"strings"
@@ -409,3 +413,514 @@ func TestCreateAutoAssignsID(t *testing.T) {
 		t.Fatalf("expected auto-assigned ID in output:\n%s", out.String())
 	}
 }
+
+func TestParseNICRoundTrip(t *testing.T) {
+	value := "virtio=BC:24:11:00:00:01,bridge=vmbr0,tag=20,firewall=1,queues=4"
+	nic, err := parseNIC("net0", value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nic.Model != "virtio" || nic.MAC != "BC:24:11:00:00:01" || nic.Bridge != "vmbr0" || nic.Tag != 20 || !nic.Firewall {
+		t.Fatalf("unexpected NIC: %+v", nic)
+	}
+	if got := nic.String(); got != value {
+		t.Fatalf("String() = %q, want %q", got, value)
+	}
+	if _, err := parseNIC("net1", "bridge=vmbr0"); err == nil {
+		t.Error("expected error for NIC without model")
+	}
+}
+
+func TestNICAddPicksNextFreeSlot(t *testing.T) {
+	ctrl, client := setupVMMocks(t)
+	node := mocks.NewMockNodeInterface(ctrl)
+	vm := mocks.NewMockVirtualMachineInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().VirtualMachine(ctx, 100).Return(vm, nil)
+	vm.EXPECT().CurrentConfig().Return(&proxmox.VirtualMachineConfig{Nets: map[string]string{
+		"net0": "virtio=BC:24:11:00:00:01,bridge=vmbr0",
+		"net2": "virtio=BC:24:11:00:00:02,bridge=vmbr0",
+	}})
+	node.EXPECT().Networks(ctx).Return(proxmox.NodeNetworks{
+		{Iface: "eno1", Type: "eth"},
+		{Iface: "vmbr0", Type: "bridge"},
+		{Iface: "vmbr1", Type: "bridge"},
+	}, nil)
+	vm.EXPECT().Config(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, options ...proxmox.VirtualMachineOption) (*proxmox.Task, error) {
+			if len(options) != 1 || options[0].Name != "net1" || options[0].Value != "virtio,bridge=vmbr1,tag=20,firewall=1" {
+				t.Errorf("unexpected NIC option: %+v", options)
+			}
+			return &proxmox.Task{IsSuccessful: true}, nil
+		})
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"nic", "add", "-n", "pve", "-i", "100", "--bridge", "vmbr1", "--tag", "20", "--firewall"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "NIC net1 added to VM 100") {
+		t.Fatalf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestNICAddRejectsUnknownBridge(t *testing.T) {
+	ctrl, client := setupVMMocks(t)
+	node := mocks.NewMockNodeInterface(ctrl)
+	vm := mocks.NewMockVirtualMachineInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().VirtualMachine(ctx, 100).Return(vm, nil)
+	vm.EXPECT().CurrentConfig().Return(&proxmox.VirtualMachineConfig{})
+	node.EXPECT().Networks(ctx).Return(proxmox.NodeNetworks{{Iface: "vmbr0", Type: "bridge"}}, nil)
+	cluster := mocks.NewMockClusterInterface(ctrl)
+	client.EXPECT().Cluster(ctx).Return(cluster, nil)
+	cluster.EXPECT().SDNVNets(ctx).Return([]*proxmox.VNet{{Name: "vnet20", Zone: "vlans"}}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"nic", "add", "-n", "pve", "-i", "100", "--bridge", "vmbr9"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), `bridge "vmbr9" not found`) || !strings.Contains(err.Error(), "vmbr0; SDN vnets: vnet20") {
+		t.Fatalf("expected unknown-bridge error, got %v", err)
+	}
+}
+
+func TestNICAddAcceptsSDNVNet(t *testing.T) {
+	ctrl, client := setupVMMocks(t)
+	node := mocks.NewMockNodeInterface(ctrl)
+	vm := mocks.NewMockVirtualMachineInterface(ctrl)
+	cluster := mocks.NewMockClusterInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().VirtualMachine(ctx, 100).Return(vm, nil)
+	vm.EXPECT().CurrentConfig().Return(&proxmox.VirtualMachineConfig{})
+	node.EXPECT().Networks(ctx).Return(proxmox.NodeNetworks{{Iface: "vmbr0", Type: "bridge"}}, nil)
+	client.EXPECT().Cluster(ctx).Return(cluster, nil)
+	cluster.EXPECT().SDNVNets(ctx).Return([]*proxmox.VNet{{Name: "vnet20", Zone: "vlans"}}, nil)
+	cluster.EXPECT().SDNZones(ctx).Return([]*proxmox.SDNZone{{Name: "vlans", Nodes: proxmox.CSV{"pve", "pve2"}}}, nil)
+	vm.EXPECT().Config(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, options ...proxmox.VirtualMachineOption) (*proxmox.Task, error) {
+			if options[0].Value != "virtio,bridge=vnet20" {
+				t.Errorf("unexpected NIC value: %v", options[0].Value)
+			}
+			return &proxmox.Task{IsSuccessful: true}, nil
+		})
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"nic", "add", "-n", "pve", "-i", "100", "--bridge", "vnet20"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNICSetKeepsMAC(t *testing.T) {
+	ctrl, client := setupVMMocks(t)
+	node := mocks.NewMockNodeInterface(ctrl)
+	vm := mocks.NewMockVirtualMachineInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().VirtualMachine(ctx, 100).Return(vm, nil)
+	vm.EXPECT().CurrentConfig().Return(&proxmox.VirtualMachineConfig{Nets: map[string]string{
+		"net0": "virtio=BC:24:11:00:00:01,bridge=vmbr0,tag=20",
+	}})
+	vm.EXPECT().Config(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, options ...proxmox.VirtualMachineOption) (*proxmox.Task, error) {
+			if options[0].Value != "virtio=BC:24:11:00:00:01,bridge=vmbr0,tag=30,link_down=1" {
+				t.Errorf("unexpected NIC value: %v", options[0].Value)
+			}
+			return &proxmox.Task{IsSuccessful: true}, nil
+		})
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"nic", "set", "-n", "pve", "-i", "100", "--nic", "net0", "--tag", "30", "--link-down"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "NIC net0 of VM 100 updated") {
+		t.Fatalf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestPassthroughAddPCIValidatesInventory(t *testing.T) {
+	ctrl, client := setupVMMocks(t)
+	node := mocks.NewMockNodeInterface(ctrl)
+	vm := mocks.NewMockVirtualMachineInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().VirtualMachine(ctx, 100).Return(vm, nil)
+	vm.EXPECT().CurrentConfig().Return(&proxmox.VirtualMachineConfig{
+		Machine:  "pc-q35-9.0",
+		HostPCIs: map[string]string{"hostpci0": "0000:02:00.0"},
+	})
+	node.EXPECT().ListPCIDevices(ctx, gomock.Any()).Return([]*proxmox.PCIDevice{
+		{ID: "0000:01:00.0"}, {ID: "0000:01:00.1"},
+	}, nil)
+	vm.EXPECT().Config(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, options ...proxmox.VirtualMachineOption) (*proxmox.Task, error) {
+			if options[0].Name != "hostpci1" || options[0].Value != "0000:01:00,pcie=1,rombar=0" {
+				t.Errorf("unexpected passthrough option: %+v", options[0])
+			}
+			return &proxmox.Task{IsSuccessful: true}, nil
+		})
+	vm.EXPECT().Details().Return(interfaces.VirtualMachineDetails{Status: "stopped"})
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"passthrough", "add", "-n", "pve", "-i", "100", "--pci", "01:00", "--pcie", "--rombar=false"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "attached to VM 100 as hostpci1") {
+		t.Fatalf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestPassthroughAddRejectsUnknownMapping(t *testing.T) {
+	ctrl, client := setupVMMocks(t)
+	node := mocks.NewMockNodeInterface(ctrl)
+	vm := mocks.NewMockVirtualMachineInterface(ctrl)
+	cluster := mocks.NewMockClusterInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().VirtualMachine(ctx, 100).Return(vm, nil)
+	vm.EXPECT().CurrentConfig().Return(&proxmox.VirtualMachineConfig{})
+	client.EXPECT().Cluster(ctx).Return(cluster, nil)
+	cluster.EXPECT().USBMappings(ctx, "pve").Return(proxmox.ClusterUSBMappings{
+		{ID: "dongle", Map: []string{"id=046d:c52b,node=pve2"}},
+	}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"passthrough", "add", "-n", "pve", "-i", "100", "--usb", "dongle"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), `USB mapping "dongle" has no device on node "pve"`) {
+		t.Fatalf("expected mapping error, got %v", err)
+	}
+}
+
+func TestVNCProxyHandshakes(t *testing.T) {
+	// Fake VM display: RFB 3.8 with VNC authentication.
+	proxySide, serverSide := net.Pipe()
+	defer proxySide.Close()
+	challenge := []byte("0123456789abcdef")
+	serverErr := make(chan error, 1)
+	go func() {
+		defer serverSide.Close()
+		serverSide.Write([]byte("RFB 003.008\n"))
+		version := make([]byte, 12)
+		io.ReadFull(serverSide, version)
+		serverSide.Write([]byte{2, rfbSecurityNone, rfbSecurityVNCAuth})
+		choice := make([]byte, 1)
+		io.ReadFull(serverSide, choice)
+		if choice[0] != rfbSecurityVNCAuth {
+			serverErr <- io.ErrUnexpectedEOF
+			return
+		}
+		serverSide.Write(challenge)
+		response := make([]byte, 16)
+		io.ReadFull(serverSide, response)
+		want, _ := vncAuthResponse("PVEVNC:ticket", challenge)
+		if !bytes.Equal(response, want) {
+			serverSide.Write([]byte{0, 0, 0, 1, 0, 0, 0, 3, 'b', 'a', 'd'})
+			serverErr <- nil
+			return
+		}
+		serverSide.Write([]byte{0, 0, 0, 0})
+		serverErr <- nil
+	}()
+	if err := authenticateVNCServer(proxySide, "PVEVNC:ticket"); err != nil {
+		t.Fatalf("authenticate: %v", err)
+	}
+	if err := <-serverErr; err != nil {
+		t.Fatal(err)
+	}
+
+	// Local client: RFB 3.8 should be offered the None security type.
+	clientSide, localSide := net.Pipe()
+	defer clientSide.Close()
+	handshakeErr := make(chan error, 1)
+	go func() {
+		defer localSide.Close()
+		handshakeErr <- serveVNCHandshake(localSide)
+	}()
+	greeting := make([]byte, 12)
+	io.ReadFull(clientSide, greeting)
+	clientSide.Write([]byte("RFB 003.008\n"))
+	types := make([]byte, 2)
+	io.ReadFull(clientSide, types)
+	if types[0] != 1 || types[1] != rfbSecurityNone {
+		t.Fatalf("unexpected security types %v", types)
+	}
+	clientSide.Write([]byte{rfbSecurityNone})
+	result := make([]byte, 4)
+	io.ReadFull(clientSide, result)
+	if err := <-handshakeErr; err != nil {
+		t.Fatalf("serve handshake: %v", err)
+	}
+	if !bytes.Equal(result, []byte{0, 0, 0, 0}) {
+		t.Fatalf("unexpected security result %v", result)
+	}
+}
+
+func TestVNCAuthResponseReversesKeyBits(t *testing.T) {
+	challenge := []byte("0123456789abcdef")
+	response, err := vncAuthResponse("password", challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// "password" with each byte bit-reversed.
+	block, err := des.NewCipher([]byte{0x0e, 0x86, 0xce, 0xce, 0xee, 0xf6, 0x4e, 0x26})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := make([]byte, 16)
+	block.Encrypt(want[:8], challenge[:8])
+	block.Encrypt(want[8:], challenge[8:])
+	if !bytes.Equal(response, want) {
+		t.Fatalf("response = %x, want %x", response, want)
+	}
+}
+
+func TestSpiceWritesVVFile(t *testing.T) {
+	ctrl, client := setupVMMocks(t)
+	node := mocks.NewMockNodeInterface(ctrl)
+	vm := mocks.NewMockVirtualMachineInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().VirtualMachine(ctx, 100).Return(vm, nil)
+	vm.EXPECT().SpiceProxy(ctx).Return(&proxmox.SpiceProxy{
+		Type:     "spice",
+		Host:     "pvespiceproxy:abc",
+		TLSPort:  "61000",
+		Password: "secret",
+		Proxy:    "http://pve.example.com:3128",
+		CA:       "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----",
+	}, nil)
+
+	path := filepath.Join(t.TempDir(), "vm100.vv")
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"spice", "-n", "pve", "-i", "100", "--file", path})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contents := string(data)
+	for _, want := range []string{"[virt-viewer]\n", "type=spice\n", "tls-port=61000\n", "password=secret\n", `ca=-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----` + "\n"} {
+		if !strings.Contains(contents, want) {
+			t.Errorf("vv file missing %q:\n%s", want, contents)
+		}
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("vv file mode = %v, want 0600", info.Mode().Perm())
+	}
+}
+
+func TestValidateVMOptionsSuggestsFixes(t *testing.T) {
+	err := validateVMOptions([]proxmox.VirtualMachineOption{
+		{Name: "memroy", Value: 2048},
+		{Name: "cores", Value: 0},
+		{Name: "scsihw", Value: "virtio-scsi-singel"},
+		{Name: "net32", Value: "virtio,bridge=vmbr0"},
+		{Name: "onboot", Value: "maybe"},
+		{Name: "delete", Value: "net1"},
+	}, "create")
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, want := range []string{
+		`memroy: unknown option (did you mean "memory"?)`,
+		"cores: must be at least 1, got 0",
+		`scsihw: invalid value "virtio-scsi-singel" (did you mean "virtio-scsi-single"?)`,
+		"net32: out of range; use net0 to net31",
+		`onboot: expected a boolean (0 or 1), got "maybe"`,
+		"delete: only valid for config",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error missing %q:\n%v", want, err)
+		}
+	}
+
+	valid := []proxmox.VirtualMachineOption{
+		{Name: "name", Value: "test-vm"},
+		{Name: "memory", Value: 2048},
+		{Name: "numa", Value: true},
+		{Name: "numa0", Value: "cpus=0-1,memory=2048"},
+		{Name: "scsi0", Value: "local-lvm:32"},
+		{Name: "hugepages", Value: 2},
+		{Name: "cpulimit", Value: "1.5"},
+	}
+	if err := validateVMOptions(valid, "create"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCreateValidateOnly(t *testing.T) {
+	setupVMMocks(t)
+
+	spec := filepath.Join(t.TempDir(), "vm.yaml")
+	if err := os.WriteFile(spec, []byte("name: web\nmemory: 2048\nostype: l26\nnet0: virtio,bridge=vmbr0\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"create", "-s", spec, "--validate-only"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "is valid (4 options)") {
+		t.Fatalf("unexpected output:\n%s", out.String())
+	}
+
+	if err := os.WriteFile(spec, []byte("ostype: linux\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cmd = NewCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"create", "-s", spec, "--validate-only"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), `ostype: invalid value "linux"`) {
+		t.Fatalf("expected ostype error, got %v", err)
+	}
+}
+
+func expectConfigOptions(t *testing.T, ctrl *gomock.Controller, client *mocks.MockProxmoxClientInterface, want map[string]any) {
+	t.Helper()
+	node := mocks.NewMockNodeInterface(ctrl)
+	vm := mocks.NewMockVirtualMachineInterface(ctrl)
+	ctx := gomock.Any()
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().VirtualMachine(ctx, 100).Return(vm, nil)
+	vm.EXPECT().Config(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, options ...proxmox.VirtualMachineOption) (*proxmox.Task, error) {
+			for _, option := range options {
+				if want[option.Name] != option.Value {
+					t.Errorf("unexpected option %s=%v", option.Name, option.Value)
+				}
+			}
+			return &proxmox.Task{IsSuccessful: true}, nil
+		})
+}
+
+func TestConfigSetWarnsAboutUnknownKey(t *testing.T) {
+	ctrl, client := setupVMMocks(t)
+	expectConfigOptions(t, ctrl, client, map[string]any{"coers": "4"})
+
+	cmd := NewCmd()
+	var out, errOut bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+	cmd.SetArgs([]string{"config", "set", "-n", "pve", "-i", "100", "coers=4"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(errOut.String(), `warning: coers: unknown option (did you mean "cores"?); sending it anyway`) {
+		t.Fatalf("expected warning, got:\n%s", errOut.String())
+	}
+}
+
+func TestConfigSetRejectsInvalidValue(t *testing.T) {
+	setupVMMocks(t)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"config", "set", "-n", "pve", "-i", "100", "allow-ksm=maybe"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), `allow-ksm: expected a boolean`) {
+		t.Fatalf("expected value error, got %v", err)
+	}
+}
+
+func TestConfigSetNoValidate(t *testing.T) {
+	ctrl, client := setupVMMocks(t)
+	expectConfigOptions(t, ctrl, client, map[string]any{"cores": "0"})
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"config", "set", "-n", "pve", "-i", "100", "--no-validate", "cores=0"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "warning") {
+		t.Fatalf("unexpected warning:\n%s", out.String())
+	}
+}
+
+func TestGetShowsDashWhenPoolLookupFails(t *testing.T) {
+	ctrl, client := setupVMMocks(t)
+	node := mocks.NewMockNodeInterface(ctrl)
+
+	ctx := gomock.Any()
+	client.EXPECT().Nodes(ctx).Return(proxmox.NodeStatuses{{Node: "pve", Status: "online"}}, nil)
+	client.EXPECT().Cluster(ctx).Return(nil, errors.New("permission denied"))
+	client.EXPECT().Node(ctx, "pve").Return(node, nil)
+	node.EXPECT().VirtualMachines(ctx).Return(proxmox.VirtualMachines{{VMID: 100, Name: "web", Status: "running"}}, nil)
+
+	cmd := NewCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"get"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "web") || !strings.Contains(out.String(), " - ") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestGetPoolFilterFailsWithoutPoolLookup(t *testing.T) {
+	_, client := setupVMMocks(t)
+
+	ctx := gomock.Any()
+	client.EXPECT().Nodes(ctx).Return(proxmox.NodeStatuses{{Node: "pve", Status: "online"}}, nil)
+	client.EXPECT().Cluster(ctx).Return(nil, errors.New("permission denied"))
+
+	cmd := NewCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"get", "--pool", "web-team"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Fatalf("expected pool lookup error, got %v", err)
+	}
+}
@@ -0,0 +1,329 @@
+package vm
+
+import (
+	"context"
+	"crypto/des"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/Adz-ai/proxmox-cli/cmd/utility"
+	"github.com/Adz-ai/proxmox-cli/internal/interfaces"
+	"github.com/luthermonson/go-proxmox"
+	"github.com/spf13/cobra"
+)
+
+// RFB security types used by the proxy.
+const (
+	rfbSecurityNone    = 1
+	rfbSecurityVNCAuth = 2
+)
+
+func newVNCCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vnc",
+		Short: "Proxy the graphical display of a virtual machine to a local VNC port",
+		Long: `Listen on a local TCP port and forward each VNC client that connects to
+the VM's display through the Proxmox vncwebsocket endpoint:
+
+  proxmox-cli vm vnc -n pve -i 100 --listen 127.0.0.1:5900
+  vncviewer 127.0.0.1:5900
+
+The proxy authenticates to Proxmox with a fresh VNC ticket per connection,
+so local clients connect without a password. Anyone who can reach the
+listen address can see the display; keep it on localhost. Requires
+password (session) authentication. Press Ctrl+C to stop.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			ctx := cmd.Context()
+			listen, err := cmd.Flags().GetString("listen")
+			if err != nil {
+				return fmt.Errorf("read listen flag: %w", err)
+			}
+			node, id, err := vmTargetFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			// API tokens cannot open websockets; use the session-only client.
+			client, err := utility.SessionClient()
+			if err != nil {
+				return err
+			}
+			retrievedNode, err := client.Node(ctx, node)
+			if err != nil {
+				return fmt.Errorf("get node %q: %w", node, err)
+			}
+			vm, err := retrievedNode.VirtualMachine(ctx, id)
+			if err != nil {
+				return fmt.Errorf("get VM %d: %w", id, err)
+			}
+
+			listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", strings.TrimSpace(listen))
+			if err != nil {
+				return fmt.Errorf("listen on %s: %w", listen, err)
+			}
+			go func() {
+				<-ctx.Done()
+				_ = listener.Close()
+			}()
+			fmt.Fprintf(out, "Forwarding VNC display of VM %d on %s (no password). Press Ctrl+C to stop.\n", id, listener.Addr())
+
+			var wg sync.WaitGroup
+			defer wg.Wait()
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					if ctx.Err() != nil {
+						return nil
+					}
+					return fmt.Errorf("accept VNC client: %w", err)
+				}
+				fmt.Fprintf(out, "Client %s connected\n", conn.RemoteAddr())
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if err := proxyVNCConnection(ctx, conn, vm); err != nil {
+						fmt.Fprintf(cmd.ErrOrStderr(), "Client %s: %v\n", conn.RemoteAddr(), err)
+						return
+					}
+					fmt.Fprintf(out, "Client %s disconnected\n", conn.RemoteAddr())
+				}()
+			}
+		},
+	}
+
+	addVMTargetFlags(cmd)
+	cmd.Flags().String("listen", "127.0.0.1:5900", "Local address for VNC clients")
+	return cmd
+}
+
+// proxyVNCConnection opens a VNC websocket for one local client,
+// authenticates to Proxmox with the ticket, offers the client a
+// password-less handshake, and then copies bytes both ways until either
+// side hangs up.
+func proxyVNCConnection(ctx context.Context, local net.Conn, vm interfaces.VirtualMachineInterface) error {
+	defer func() { _ = local.Close() }()
+
+	vnc, err := vm.VNCProxy(ctx, &proxmox.VNCConfig{Websocket: true})
+	if err != nil {
+		return fmt.Errorf("open VNC proxy: %w", err)
+	}
+	send, recv, errs, closer, err := vm.VNCWebSocket(vnc)
+	if err != nil {
+		return fmt.Errorf("connect VNC websocket: %w", err)
+	}
+	connCtx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		_ = closer()
+	}()
+	remote := &websocketStream{send: send, recv: recv, errs: errs, done: connCtx.Done()}
+
+	if err := authenticateVNCServer(remote, vnc.Ticket); err != nil {
+		return err
+	}
+	if err := serveVNCHandshake(local); err != nil {
+		return err
+	}
+
+	copied := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(remote, local)
+		copied <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(local, remote)
+		copied <- struct{}{}
+	}()
+	select {
+	case <-copied:
+	case <-ctx.Done():
+	}
+	cancel()
+	_ = local.Close()
+	return nil
+}
+
+// websocketStream adapts the message channels of a Proxmox websocket to an
+// io.ReadWriter. Reads and writes fail with io.EOF once done is closed.
+type websocketStream struct {
+	send    chan []byte
+	recv    chan []byte
+	errs    chan error
+	done    <-chan struct{}
+	pending []byte
+}
+
+func (s *websocketStream) Read(p []byte) (int, error) {
+	for len(s.pending) == 0 {
+		select {
+		case <-s.done:
+			return 0, io.EOF
+		case err, open := <-s.errs:
+			if !open || err == nil {
+				return 0, io.EOF
+			}
+			return 0, err
+		case msg, open := <-s.recv:
+			if !open {
+				return 0, io.EOF
+			}
+			s.pending = msg
+		}
+	}
+	n := copy(p, s.pending)
+	s.pending = s.pending[n:]
+	return n, nil
+}
+
+func (s *websocketStream) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+	select {
+	case <-s.done:
+		return 0, io.EOF
+	case s.send <- data:
+		return len(p), nil
+	}
+}
+
+// authenticateVNCServer performs the client half of the RFB 3.8 handshake
+// against the VM display, answering the VNC authentication challenge with
+// the Proxmox ticket.
+func authenticateVNCServer(server io.ReadWriter, ticket string) error {
+	version := make([]byte, 12)
+	if _, err := io.ReadFull(server, version); err != nil {
+		return fmt.Errorf("read VNC server version: %w", err)
+	}
+	if !strings.HasPrefix(string(version), "RFB ") {
+		return fmt.Errorf("unexpected VNC server greeting %q", version)
+	}
+	if _, err := server.Write([]byte("RFB 003.008\n")); err != nil {
+		return fmt.Errorf("send VNC version: %w", err)
+	}
+
+	count := make([]byte, 1)
+	if _, err := io.ReadFull(server, count); err != nil {
+		return fmt.Errorf("read VNC security types: %w", err)
+	}
+	if count[0] == 0 {
+		return fmt.Errorf("VNC server refused connection: %s", readVNCReason(server))
+	}
+	types := make([]byte, count[0])
+	if _, err := io.ReadFull(server, types); err != nil {
+		return fmt.Errorf("read VNC security types: %w", err)
+	}
+	security := byte(0)
+	for _, candidate := range types {
+		if candidate == rfbSecurityVNCAuth || (candidate == rfbSecurityNone && security == 0) {
+			security = candidate
+		}
+	}
+	if security == 0 {
+		return fmt.Errorf("VNC server offers no supported security type (%v)", types)
+	}
+	if _, err := server.Write([]byte{security}); err != nil {
+		return fmt.Errorf("select VNC security type: %w", err)
+	}
+
+	if security == rfbSecurityVNCAuth {
+		challenge := make([]byte, 16)
+		if _, err := io.ReadFull(server, challenge); err != nil {
+			return fmt.Errorf("read VNC challenge: %w", err)
+		}
+		response, err := vncAuthResponse(ticket, challenge)
+		if err != nil {
+			return err
+		}
+		if _, err := server.Write(response); err != nil {
+			return fmt.Errorf("send VNC challenge response: %w", err)
+		}
+	}
+
+	result := make([]byte, 4)
+	if _, err := io.ReadFull(server, result); err != nil {
+		return fmt.Errorf("read VNC security result: %w", err)
+	}
+	if binary.BigEndian.Uint32(result) != 0 {
+		return fmt.Errorf("VNC authentication failed: %s", readVNCReason(server))
+	}
+	return nil
+}
+
+// vncAuthResponse encrypts the challenge with DES keyed by the first eight
+// bytes of the password, bit-reversed per byte as the VNC spec requires.
+func vncAuthResponse(password string, challenge []byte) ([]byte, error) {
+	key := make([]byte, 8)
+	copy(key, password)
+	for i := range key {
+		key[i] = bits.Reverse8(key[i])
+	}
+	block, err := des.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("prepare VNC challenge response: %w", err)
+	}
+	response := make([]byte, len(challenge))
+	for i := 0; i+8 <= len(challenge); i += 8 {
+		block.Encrypt(response[i:i+8], challenge[i:i+8])
+	}
+	return response, nil
+}
+
+func readVNCReason(r io.Reader) string {
+	length := make([]byte, 4)
+	if _, err := io.ReadFull(r, length); err != nil {
+		return "no reason given"
+	}
+	reason := make([]byte, binary.BigEndian.Uint32(length))
+	if _, err := io.ReadFull(r, reason); err != nil {
+		return "no reason given"
+	}
+	return string(reason)
+}
+
+// serveVNCHandshake performs the server half of the RFB handshake with a
+// local client, offering only the None security type. RFB 3.3, 3.7, and
+// 3.8 clients are supported.
+func serveVNCHandshake(client io.ReadWriter) error {
+	if _, err := client.Write([]byte("RFB 003.008\n")); err != nil {
+		return fmt.Errorf("send VNC version: %w", err)
+	}
+	version := make([]byte, 12)
+	if _, err := io.ReadFull(client, version); err != nil {
+		return fmt.Errorf("read VNC client version: %w", err)
+	}
+	var major, minor int
+	if _, err := fmt.Sscanf(string(version), "RFB %03d.%03d\n", &major, &minor); err != nil || major != 3 {
+		return fmt.Errorf("unsupported VNC client version %q", version)
+	}
+
+	if minor < 7 {
+		if _, err := client.Write([]byte{0, 0, 0, rfbSecurityNone}); err != nil {
+			return fmt.Errorf("send VNC security type: %w", err)
+		}
+		return nil
+	}
+	if _, err := client.Write([]byte{1, rfbSecurityNone}); err != nil {
+		return fmt.Errorf("send VNC security types: %w", err)
+	}
+	choice := make([]byte, 1)
+	if _, err := io.ReadFull(client, choice); err != nil {
+		return fmt.Errorf("read VNC security choice: %w", err)
+	}
+	if choice[0] != rfbSecurityNone {
+		return errors.New("VNC client chose an unsupported security type")
+	}
+	if minor >= 8 {
+		if _, err := client.Write([]byte{0, 0, 0, 0}); err != nil {
+			return fmt.Errorf("send VNC security result: %w", err)
+		}
+	}
+	return nil
+}
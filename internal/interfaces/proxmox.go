@@ -6,20 +6,105 @@ import (
 	"github.com/luthermonson/go-proxmox"
 )
 
-//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../test/mocks/proxmox_client.go -package=mocks github.com/Adz-ai/proxmox-cli/internal/interfaces ProxmoxClientInterface,NodeInterface,ContainerInterface,VirtualMachineInterface,ClusterInterface,StorageInterface
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../test/mocks/proxmox_client.go -package=mocks github.com/Adz-ai/proxmox-cli/internal/interfaces ProxmoxClientInterface,NodeInterface,ContainerInterface,VirtualMachineInterface,ClusterInterface,StorageInterface,FirewallInterface
 
 // ProxmoxClientInterface defines the interface that both real and mock clients must implement
 type ProxmoxClientInterface interface {
 	Nodes(ctx context.Context) (proxmox.NodeStatuses, error)
 	Node(ctx context.Context, nodeName string) (NodeInterface, error)
 	Version(ctx context.Context) (*proxmox.Version, error)
+	WakeNode(ctx context.Context, nodeName string) (string, error)
 	Cluster(ctx context.Context) (ClusterInterface, error)
+	Pools(ctx context.Context) (proxmox.Pools, error)
+	Pool(ctx context.Context, poolID string, filters ...string) (*proxmox.Pool, error)
+	NewPool(ctx context.Context, poolID, comment string) error
+	UpdatePool(ctx context.Context, poolID string, options *proxmox.PoolUpdateOption) error
+	DeletePool(ctx context.Context, poolID string) error
+	Users(ctx context.Context) (proxmox.Users, error)
+	User(ctx context.Context, userID string) (*proxmox.User, error)
+	NewUser(ctx context.Context, user *proxmox.NewUser) error
+	UpdateUser(ctx context.Context, userID string, options *proxmox.UserOptions) error
+	DeleteUser(ctx context.Context, userID string) error
+	ChangePassword(ctx context.Context, userID, password, confirmation string) error
+	APITokens(ctx context.Context, userID string) (proxmox.Tokens, error)
+	NewAPIToken(ctx context.Context, userID string, token proxmox.Token) (proxmox.NewAPIToken, error)
+	DeleteAPIToken(ctx context.Context, userID, tokenID string) error
+	Groups(ctx context.Context) (proxmox.Groups, error)
+	Group(ctx context.Context, groupID string) (*proxmox.Group, error)
+	NewGroup(ctx context.Context, groupID, comment string) error
+	UpdateGroup(ctx context.Context, groupID, comment string) error
+	DeleteGroup(ctx context.Context, groupID string) error
+	Roles(ctx context.Context) (proxmox.Roles, error)
+	NewRole(ctx context.Context, roleID, privs string) error
+	ACL(ctx context.Context) (proxmox.ACLs, error)
+	UpdateACL(ctx context.Context, options proxmox.ACLOptions) error
+	Permissions(ctx context.Context, options *proxmox.PermissionsOptions) (proxmox.Permissions, error)
+	Firewall(path string) FirewallInterface
+}
+
+// FirewallInterface defines firewall operations for one scope, addressed by
+// its API path: /cluster/firewall, /nodes/{node}/firewall,
+// /nodes/{node}/{qemu,lxc}/{vmid}/firewall, or a security group at
+// /cluster/firewall/groups/{group}. Not every scope supports every call.
+type FirewallInterface interface {
+	Rules(ctx context.Context) ([]*proxmox.FirewallRule, error)
+	NewRule(ctx context.Context, params map[string]any) error
+	UpdateRule(ctx context.Context, pos int, params map[string]any) error
+	DeleteRule(ctx context.Context, pos int) error
+	Options(ctx context.Context) (map[string]any, error)
+	UpdateOptions(ctx context.Context, params map[string]any) error
+	IPSets(ctx context.Context) ([]*proxmox.FirewallIPSet, error)
+	IPSetEntries(ctx context.Context, name string) ([]*proxmox.FirewallIPSetEntry, error)
+	NewIPSet(ctx context.Context, name, comment string) error
+	DeleteIPSet(ctx context.Context, name string) error
+	AddIPSetEntry(ctx context.Context, name string, entry *proxmox.FirewallIPSetEntryCreationOption) error
+	DeleteIPSetEntry(ctx context.Context, name, cidr string) error
+	Aliases(ctx context.Context) ([]*proxmox.FirewallAlias, error)
+	NewAlias(ctx context.Context, alias *proxmox.FirewallAliasCreateOption) error
+	UpdateAlias(ctx context.Context, name, cidr, comment string) error
+	DeleteAlias(ctx context.Context, name string) error
+	SecurityGroups(ctx context.Context) ([]*proxmox.FirewallSecurityGroup, error)
+	NewSecurityGroup(ctx context.Context, name, comment string) error
+	DeleteSecurityGroup(ctx context.Context, name string) error
+	Log(ctx context.Context, options *proxmox.NodeFirewallLogOptions) ([]*proxmox.LogEntry, error)
 }
 
 // ClusterInterface defines the interface for cluster-level operations
 type ClusterInterface interface {
 	Resources(ctx context.Context, filters ...string) (proxmox.ClusterResources, error)
 	NextID(ctx context.Context) (int, error)
+	PCIMappings(ctx context.Context, checkNode string) (proxmox.ClusterPCIMappings, error)
+	USBMappings(ctx context.Context, checkNode string) (proxmox.ClusterUSBMappings, error)
+	ACMEAccounts(ctx context.Context) ([]*proxmox.ACMEAccountIndex, error)
+	ACMEAccount(ctx context.Context, name string) (*proxmox.ACMEAccount, error)
+	NewACMEAccount(ctx context.Context, options *proxmox.ACMEAccountOptions) (*proxmox.Task, error)
+	DeleteACMEAccount(ctx context.Context, name string) (*proxmox.Task, error)
+	ACMETermsOfService(ctx context.Context, directory string) (string, error)
+	Quorate(ctx context.Context) (bool, error)
+	HAStatus(ctx context.Context) ([]*proxmox.HAStatusEntry, error)
+	HAResources(ctx context.Context, resourceType string) ([]*proxmox.HAResource, error)
+	NewHAResource(ctx context.Context, options *proxmox.HAResourceCreateOption) error
+	UpdateHAResource(ctx context.Context, sid string, options *proxmox.HAResourceUpdateOption) error
+	DeleteHAResource(ctx context.Context, sid string, purge bool) error
+	MigrateHAResource(ctx context.Context, sid, node string) error
+	RelocateHAResource(ctx context.Context, sid, node string) error
+	HAGroups(ctx context.Context) ([]*proxmox.HAGroup, error)
+	NewHAGroup(ctx context.Context, options *proxmox.HAGroupCreateOption) error
+	UpdateHAGroup(ctx context.Context, name string, options *proxmox.HAGroupUpdateOption) error
+	DeleteHAGroup(ctx context.Context, name string) error
+	HARules(ctx context.Context) ([]*proxmox.HARule, error)
+	NewHARule(ctx context.Context, options *proxmox.HARuleCreateOption) error
+	UpdateHARule(ctx context.Context, name string, options *proxmox.HARuleUpdateOption) error
+	DeleteHARule(ctx context.Context, name string) error
+	SDNZones(ctx context.Context) ([]*proxmox.SDNZone, error)
+	SDNVNets(ctx context.Context) ([]*proxmox.VNet, error)
+	SDNSubnets(ctx context.Context, vnet string) ([]*proxmox.VNetSubnet, error)
+	SDNControllers(ctx context.Context) ([]*proxmox.SDNController, error)
+	SDNIPAMs(ctx context.Context) ([]*proxmox.SDNIPAM, error)
+	SDNIPAMStatus(ctx context.Context, ipam string) ([]map[string]any, error)
+	SDNPending(ctx context.Context, path string) ([]map[string]any, error)
+	SDNDryRun(ctx context.Context, node string) (*proxmox.SDNDryRun, error)
+	SDNApply(ctx context.Context) (*proxmox.Task, error)
 }
 
 // NodeInterface defines the interface for node operations
@@ -33,22 +118,65 @@ type NodeInterface interface {
 	Storages(ctx context.Context) (proxmox.Storages, error)
 	Storage(ctx context.Context, name string) (StorageInterface, error)
 	Tasks(ctx context.Context, options *proxmox.NodeTasksOptions) ([]*proxmox.Task, error)
+	Task(upid string) *proxmox.Task
+	TaskLog(ctx context.Context, upid string) ([]string, error)
 	Vzdump(ctx context.Context, options *proxmox.VirtualMachineBackupOptions) (*proxmox.Task, error)
 	Appliances(ctx context.Context) (proxmox.Appliances, error)
 	DownloadAppliance(ctx context.Context, template, storage string) (string, error)
 	VzTmpls(ctx context.Context, storage string) (proxmox.VzTmpls, error)
 	StorageDownloadURL(ctx context.Context, options *proxmox.StorageDownloadURLOptions) (string, error)
 	RRDData(ctx context.Context, timeframe proxmox.Timeframe, cf proxmox.ConsolidationFunction) ([]*proxmox.RRDData, error)
+	Networks(ctx context.Context, ifaceType ...string) (proxmox.NodeNetworks, error)
+	Network(ctx context.Context, iface string) (*proxmox.NodeNetwork, error)
+	CreateNetwork(ctx context.Context, params map[string]any) error
+	UpdateNetwork(ctx context.Context, iface string, params map[string]any) error
+	DeleteNetwork(ctx context.Context, iface string) error
+	NetworkChanges(ctx context.Context) (string, error)
+	ApplyNetwork(ctx context.Context) (*proxmox.Task, error)
+	RevertNetwork(ctx context.Context) error
+	ListPCIDevices(ctx context.Context, opts *proxmox.HardwarePCIOptions) ([]*proxmox.PCIDevice, error)
+	ListUSBDevices(ctx context.Context) ([]*proxmox.USBDevice, error)
+	PCIMdevTypes(ctx context.Context, id string) ([]*proxmox.PCIMdevType, error)
+	Services(ctx context.Context) ([]*proxmox.NodeService, error)
+	ServiceAction(ctx context.Context, service, action string) (*proxmox.Task, error)
+	APTUpdates(ctx context.Context) ([]*proxmox.APTUpdate, error)
+	APTRefresh(ctx context.Context) (*proxmox.Task, error)
+	APTVersions(ctx context.Context) ([]*proxmox.APTPackageVersion, error)
+	APTChangelog(ctx context.Context, name, version string) (string, error)
+	Journal(ctx context.Context, options *proxmox.NodeJournalOptions) ([]string, error)
+	Syslog(ctx context.Context, options *proxmox.NodeSyslogOptions) ([]*proxmox.LogEntry, error)
+	Disks(ctx context.Context, includePartitions, skipSMART bool, diskType string) ([]*proxmox.Disk, error)
+	DiskSMART(ctx context.Context, disk string, healthOnly bool) (*proxmox.DiskSMART, error)
+	DiskInitGPT(ctx context.Context, disk string) (*proxmox.Task, error)
+	NewZFSPool(ctx context.Context, options *proxmox.NodeZFSPoolOptions) (*proxmox.Task, error)
+	NewLVMThin(ctx context.Context, options *proxmox.NodeLVMThinOptions) (*proxmox.Task, error)
+	NewDirectory(ctx context.Context, options *proxmox.NodeDirectoryOptions) (*proxmox.Task, error)
+	Certificates(ctx context.Context) (proxmox.NodeCertificates, error)
+	UploadCertificate(ctx context.Context, certificate *proxmox.CustomCertificate) error
+	Config(ctx context.Context) (*proxmox.NodeConfig, error)
+	UpdateConfig(ctx context.Context, options *proxmox.NodeConfigOptions) error
+	OrderACMECertificate(ctx context.Context, force bool) (*proxmox.Task, error)
+	RenewACMECertificate(ctx context.Context, force bool) (*proxmox.Task, error)
+	TermProxy(ctx context.Context, command proxmox.NodeConsoleCmd, commandOptions string) (*proxmox.Term, error)
+	TermWebSocket(term *proxmox.Term) (chan []byte, chan []byte, chan error, func() error, error)
+	StartAll(ctx context.Context, force bool, vms string) (*proxmox.Task, error)
+	StopAll(ctx context.Context, forceStop bool, timeout int, vms string) (*proxmox.Task, error)
+	SuspendAll(ctx context.Context, vms string) (*proxmox.Task, error)
+	Reboot(ctx context.Context) error
+	Shutdown(ctx context.Context) error
 }
 
 // StorageInterface defines the interface for storage operations
 type StorageInterface interface {
 	GetContent(ctx context.Context) ([]*proxmox.StorageContent, error)
+	CopyContent(ctx context.Context, sourceVolume, targetVolume, targetNode string) (*proxmox.Task, error)
+	DeleteContent(ctx context.Context, volume string) (*proxmox.Task, error)
 }
 
 // ContainerInterface defines the interface for container operations
 type ContainerInterface interface {
 	Details() ContainerDetails
+	CurrentConfig() *proxmox.ContainerConfig
 	Start(ctx context.Context) (*proxmox.Task, error)
 	Stop(ctx context.Context) (*proxmox.Task, error)
 	Shutdown(ctx context.Context, force bool, timeout int) (*proxmox.Task, error)
@@ -62,8 +190,10 @@ type ContainerInterface interface {
 	Suspend(ctx context.Context) (*proxmox.Task, error)
 	Resume(ctx context.Context) (*proxmox.Task, error)
 	Migrate(ctx context.Context, options *proxmox.ContainerMigrateOptions) (*proxmox.Task, error)
+	MigratePreconditions(ctx context.Context, target string) (*proxmox.ContainerMigratePreconditions, error)
 	Config(ctx context.Context, options ...proxmox.ContainerOption) (*proxmox.Task, error)
 	Resize(ctx context.Context, disk, size string) (*proxmox.Task, error)
+	MoveVolume(ctx context.Context, options *ContainerMoveVolumeOptions) (*proxmox.Task, error)
 	AddTag(ctx context.Context, value string) (*proxmox.Task, error)
 	RemoveTag(ctx context.Context, value string) (*proxmox.Task, error)
 	Interfaces(ctx context.Context) (proxmox.ContainerInterfaces, error)
@@ -72,6 +202,16 @@ type ContainerInterface interface {
 	TermWebSocket(term *proxmox.Term) (chan []byte, chan []byte, chan error, func() error, error)
 }
 
+// ContainerMoveVolumeOptions are the parameters of POST
+// /nodes/{node}/lxc/{vmid}/move_volume. go-proxmox sends the VM move_disk
+// options there, whose disk key the container endpoint does not accept.
+type ContainerMoveVolumeOptions struct {
+	Volume  string            `json:"volume"`
+	Storage string            `json:"storage,omitempty"`
+	Delete  proxmox.IntOrBool `json:"delete,omitempty"`
+	BWLimit uint64            `json:"bwlimit,omitempty"`
+}
+
 type ContainerDetails struct {
 	Name      string `json:"name"`
 	Node      string `json:"node"`
@@ -87,6 +227,7 @@ type ContainerDetails struct {
 // VirtualMachineInterface defines the interface for VM operations
 type VirtualMachineInterface interface {
 	Details() VirtualMachineDetails
+	CurrentConfig() *proxmox.VirtualMachineConfig
 	Start(ctx context.Context) (*proxmox.Task, error)
 	Stop(ctx context.Context) (*proxmox.Task, error)
 	Shutdown(ctx context.Context) (*proxmox.Task, error)
@@ -112,6 +253,9 @@ type VirtualMachineInterface interface {
 	RRDData(ctx context.Context, timeframe proxmox.Timeframe, cf ...proxmox.ConsolidationFunction) ([]*proxmox.RRDData, error)
 	TermProxy(ctx context.Context) (*proxmox.Term, error)
 	TermWebSocket(term *proxmox.Term) (chan []byte, chan []byte, chan error, func() error, error)
+	VNCProxy(ctx context.Context, config *proxmox.VNCConfig) (*proxmox.VNC, error)
+	VNCWebSocket(vnc *proxmox.VNC) (chan []byte, chan []byte, chan error, func() error, error)
+	SpiceProxy(ctx context.Context) (*proxmox.SpiceProxy, error)
 }
 
 type VirtualMachineDetails struct {
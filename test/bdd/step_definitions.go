@@ -386,6 +386,7 @@ func (t *TestContext) setupExpectations(parts []string) error {
 					&proxmox.NodeStatus{Node: "pve", Status: "online"},
 				}
 				t.mockClient.EXPECT().Nodes(ctx).Return(nodes, nil)
+				t.mockClient.EXPECT().Cluster(ctx).Return(nil, fmt.Errorf("cluster not mocked")).AnyTimes()
 
 				// Mock getting node and containers
 				t.mockClient.EXPECT().Node(ctx, "pve").Return(t.mockNode, nil)
@@ -400,6 +401,11 @@ func (t *TestContext) setupExpectations(parts []string) error {
 				// Mock node lookup
 				t.mockClient.EXPECT().Node(ctx, t.nodeName).Return(t.mockNode, nil)
 
+				// Mock the bridge check of the spec's netN entries
+				t.mockNode.EXPECT().Networks(ctx).Return(proxmox.NodeNetworks{
+					&proxmox.NodeNetwork{Iface: "vmbr0", Type: "bridge"},
+				}, nil).AnyTimes()
+
 				// Mock container creation
 				task := &proxmox.Task{UPID: proxmox.UPID(fmt.Sprintf("UPID:%s:00001234:00112233:65432100:create", t.nodeName)), IsSuccessful: true}
 				t.mockNode.EXPECT().NewContainer(ctx, t.lxcId,
@@ -483,6 +489,7 @@ func (t *TestContext) setupExpectations(parts []string) error {
 					&proxmox.NodeStatus{Node: "pve", Status: "online"},
 				}
 				t.mockClient.EXPECT().Nodes(ctx).Return(nodes, nil)
+				t.mockClient.EXPECT().Cluster(ctx).Return(nil, fmt.Errorf("cluster not mocked")).AnyTimes()
 
 				// Mock getting node and VMs
 				t.mockClient.EXPECT().Node(ctx, "pve").Return(t.mockNode, nil)
@@ -38,6 +38,7 @@ func TestLXCGetCommand(t *testing.T) {
 		&proxmox.NodeStatus{Node: "pve2", Status: "online"},
 	}
 	mockClient.EXPECT().Nodes(ctx).Return(nodes, nil)
+	mockClient.EXPECT().Cluster(ctx).Return(nil, errors.New("cluster not mocked")).AnyTimes()
 
 	// For each node, expect container queries
 	containers1 := proxmox.Containers{
@@ -108,6 +109,7 @@ func TestLXCGetCommandContinuesAfterNodeFailure(t *testing.T) {
 		&proxmox.NodeStatus{Node: "pve2", Status: "online"},
 	}
 	mockClient.EXPECT().Nodes(ctx).Return(nodes, nil)
+	mockClient.EXPECT().Cluster(ctx).Return(nil, errors.New("cluster not mocked")).AnyTimes()
 	mockClient.EXPECT().Node(ctx, "pve1").Return(nil, errors.New("node offline"))
 	mockClient.EXPECT().Node(ctx, "pve2").Return(mockNode, nil)
 	mockNode.EXPECT().Containers(ctx).Return(proxmox.Containers{
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/Adz-ai/proxmox-cli/internal/interfaces (interfaces: ProxmoxClientInterface,NodeInterface,ContainerInterface,VirtualMachineInterface,ClusterInterface,StorageInterface)
+// Source: github.com/Adz-ai/proxmox-cli/internal/interfaces (interfaces: ProxmoxClientInterface,NodeInterface,ContainerInterface,VirtualMachineInterface,ClusterInterface,StorageInterface,FirewallInterface)
 //
 // Generated by this command:
 //
-//	mockgen -destination=../../test/mocks/proxmox_client.go -package=mocks github.com/Adz-ai/proxmox-cli/internal/interfaces ProxmoxClientInterface,NodeInterface,ContainerInterface,VirtualMachineInterface,ClusterInterface,StorageInterface
+//	mockgen -destination=../../test/mocks/proxmox_client.go -package=mocks github.com/Adz-ai/proxmox-cli/internal/interfaces ProxmoxClientInterface,NodeInterface,ContainerInterface,VirtualMachineInterface,ClusterInterface,StorageInterface,FirewallInterface
 //
 
 // Package mocks is a generated GoMock package.
@@ -42,1175 +42,2730 @@ func (m *MockProxmoxClientInterface) EXPECT() *MockProxmoxClientInterfaceMockRec
 	return m.recorder
 }
 
-// Cluster mocks base method.
-func (m *MockProxmoxClientInterface) Cluster(ctx context.Context) (interfaces.ClusterInterface, error) {
+// ACL mocks base method.
+func (m *MockProxmoxClientInterface) ACL(ctx context.Context) (proxmox.ACLs, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Cluster", ctx)
-	ret0, _ := ret[0].(interfaces.ClusterInterface)
+	ret := m.ctrl.Call(m, "ACL", ctx)
+	ret0, _ := ret[0].(proxmox.ACLs)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Cluster indicates an expected call of Cluster.
-func (mr *MockProxmoxClientInterfaceMockRecorder) Cluster(ctx any) *gomock.Call {
+// ACL indicates an expected call of ACL.
+func (mr *MockProxmoxClientInterfaceMockRecorder) ACL(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cluster", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Cluster), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ACL", reflect.TypeOf((*MockProxmoxClientInterface)(nil).ACL), ctx)
 }
 
-// Node mocks base method.
-func (m *MockProxmoxClientInterface) Node(ctx context.Context, nodeName string) (interfaces.NodeInterface, error) {
+// APITokens mocks base method.
+func (m *MockProxmoxClientInterface) APITokens(ctx context.Context, userID string) (proxmox.Tokens, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Node", ctx, nodeName)
-	ret0, _ := ret[0].(interfaces.NodeInterface)
+	ret := m.ctrl.Call(m, "APITokens", ctx, userID)
+	ret0, _ := ret[0].(proxmox.Tokens)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Node indicates an expected call of Node.
-func (mr *MockProxmoxClientInterfaceMockRecorder) Node(ctx, nodeName any) *gomock.Call {
+// APITokens indicates an expected call of APITokens.
+func (mr *MockProxmoxClientInterfaceMockRecorder) APITokens(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Node", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Node), ctx, nodeName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APITokens", reflect.TypeOf((*MockProxmoxClientInterface)(nil).APITokens), ctx, userID)
 }
 
-// Nodes mocks base method.
-func (m *MockProxmoxClientInterface) Nodes(ctx context.Context) (proxmox.NodeStatuses, error) {
+// ChangePassword mocks base method.
+func (m *MockProxmoxClientInterface) ChangePassword(ctx context.Context, userID, password, confirmation string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Nodes", ctx)
-	ret0, _ := ret[0].(proxmox.NodeStatuses)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, userID, password, confirmation)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Nodes indicates an expected call of Nodes.
-func (mr *MockProxmoxClientInterfaceMockRecorder) Nodes(ctx any) *gomock.Call {
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockProxmoxClientInterfaceMockRecorder) ChangePassword(ctx, userID, password, confirmation any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nodes", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Nodes), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockProxmoxClientInterface)(nil).ChangePassword), ctx, userID, password, confirmation)
 }
 
-// Version mocks base method.
-func (m *MockProxmoxClientInterface) Version(ctx context.Context) (*proxmox.Version, error) {
+// Cluster mocks base method.
+func (m *MockProxmoxClientInterface) Cluster(ctx context.Context) (interfaces.ClusterInterface, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Version", ctx)
-	ret0, _ := ret[0].(*proxmox.Version)
+	ret := m.ctrl.Call(m, "Cluster", ctx)
+	ret0, _ := ret[0].(interfaces.ClusterInterface)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Version indicates an expected call of Version.
-func (mr *MockProxmoxClientInterfaceMockRecorder) Version(ctx any) *gomock.Call {
+// Cluster indicates an expected call of Cluster.
+func (mr *MockProxmoxClientInterfaceMockRecorder) Cluster(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Version), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cluster", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Cluster), ctx)
 }
 
-// MockNodeInterface is a mock of NodeInterface interface.
-type MockNodeInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockNodeInterfaceMockRecorder
-	isgomock struct{}
+// DeleteAPIToken mocks base method.
+func (m *MockProxmoxClientInterface) DeleteAPIToken(ctx context.Context, userID, tokenID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIToken", ctx, userID, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockNodeInterfaceMockRecorder is the mock recorder for MockNodeInterface.
-type MockNodeInterfaceMockRecorder struct {
-	mock *MockNodeInterface
+// DeleteAPIToken indicates an expected call of DeleteAPIToken.
+func (mr *MockProxmoxClientInterfaceMockRecorder) DeleteAPIToken(ctx, userID, tokenID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIToken", reflect.TypeOf((*MockProxmoxClientInterface)(nil).DeleteAPIToken), ctx, userID, tokenID)
 }
 
-// NewMockNodeInterface creates a new mock instance.
-func NewMockNodeInterface(ctrl *gomock.Controller) *MockNodeInterface {
-	mock := &MockNodeInterface{ctrl: ctrl}
-	mock.recorder = &MockNodeInterfaceMockRecorder{mock}
-	return mock
+// DeleteGroup mocks base method.
+func (m *MockProxmoxClientInterface) DeleteGroup(ctx context.Context, groupID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroup", ctx, groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockNodeInterface) EXPECT() *MockNodeInterfaceMockRecorder {
-	return m.recorder
+// DeleteGroup indicates an expected call of DeleteGroup.
+func (mr *MockProxmoxClientInterfaceMockRecorder) DeleteGroup(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockProxmoxClientInterface)(nil).DeleteGroup), ctx, groupID)
 }
 
-// Appliances mocks base method.
-func (m *MockNodeInterface) Appliances(ctx context.Context) (proxmox.Appliances, error) {
+// DeletePool mocks base method.
+func (m *MockProxmoxClientInterface) DeletePool(ctx context.Context, poolID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Appliances", ctx)
-	ret0, _ := ret[0].(proxmox.Appliances)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeletePool", ctx, poolID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Appliances indicates an expected call of Appliances.
-func (mr *MockNodeInterfaceMockRecorder) Appliances(ctx any) *gomock.Call {
+// DeletePool indicates an expected call of DeletePool.
+func (mr *MockProxmoxClientInterfaceMockRecorder) DeletePool(ctx, poolID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Appliances", reflect.TypeOf((*MockNodeInterface)(nil).Appliances), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePool", reflect.TypeOf((*MockProxmoxClientInterface)(nil).DeletePool), ctx, poolID)
 }
 
-// Container mocks base method.
-func (m *MockNodeInterface) Container(ctx context.Context, vmid int) (interfaces.ContainerInterface, error) {
+// DeleteUser mocks base method.
+func (m *MockProxmoxClientInterface) DeleteUser(ctx context.Context, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Container", ctx, vmid)
-	ret0, _ := ret[0].(interfaces.ContainerInterface)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Container indicates an expected call of Container.
-func (mr *MockNodeInterfaceMockRecorder) Container(ctx, vmid any) *gomock.Call {
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockProxmoxClientInterfaceMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Container", reflect.TypeOf((*MockNodeInterface)(nil).Container), ctx, vmid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockProxmoxClientInterface)(nil).DeleteUser), ctx, userID)
 }
 
-// Containers mocks base method.
-func (m *MockNodeInterface) Containers(ctx context.Context) (proxmox.Containers, error) {
+// Firewall mocks base method.
+func (m *MockProxmoxClientInterface) Firewall(path string) interfaces.FirewallInterface {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Containers", ctx)
-	ret0, _ := ret[0].(proxmox.Containers)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Firewall", path)
+	ret0, _ := ret[0].(interfaces.FirewallInterface)
+	return ret0
 }
 
-// Containers indicates an expected call of Containers.
-func (mr *MockNodeInterfaceMockRecorder) Containers(ctx any) *gomock.Call {
+// Firewall indicates an expected call of Firewall.
+func (mr *MockProxmoxClientInterfaceMockRecorder) Firewall(path any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Containers", reflect.TypeOf((*MockNodeInterface)(nil).Containers), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Firewall", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Firewall), path)
 }
 
-// DownloadAppliance mocks base method.
-func (m *MockNodeInterface) DownloadAppliance(ctx context.Context, template, storage string) (string, error) {
+// Group mocks base method.
+func (m *MockProxmoxClientInterface) Group(ctx context.Context, groupID string) (*proxmox.Group, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DownloadAppliance", ctx, template, storage)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Group", ctx, groupID)
+	ret0, _ := ret[0].(*proxmox.Group)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DownloadAppliance indicates an expected call of DownloadAppliance.
-func (mr *MockNodeInterfaceMockRecorder) DownloadAppliance(ctx, template, storage any) *gomock.Call {
+// Group indicates an expected call of Group.
+func (mr *MockProxmoxClientInterfaceMockRecorder) Group(ctx, groupID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAppliance", reflect.TypeOf((*MockNodeInterface)(nil).DownloadAppliance), ctx, template, storage)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Group", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Group), ctx, groupID)
 }
 
-// NewContainer mocks base method.
-func (m *MockNodeInterface) NewContainer(ctx context.Context, vmid int, options ...proxmox.ContainerOption) (*proxmox.Task, error) {
+// Groups mocks base method.
+func (m *MockProxmoxClientInterface) Groups(ctx context.Context) (proxmox.Groups, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, vmid}
-	for _, a := range options {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "NewContainer", varargs...)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "Groups", ctx)
+	ret0, _ := ret[0].(proxmox.Groups)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// NewContainer indicates an expected call of NewContainer.
-func (mr *MockNodeInterfaceMockRecorder) NewContainer(ctx, vmid any, options ...any) *gomock.Call {
+// Groups indicates an expected call of Groups.
+func (mr *MockProxmoxClientInterfaceMockRecorder) Groups(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, vmid}, options...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewContainer", reflect.TypeOf((*MockNodeInterface)(nil).NewContainer), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Groups", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Groups), ctx)
 }
 
-// NewVirtualMachine mocks base method.
-func (m *MockNodeInterface) NewVirtualMachine(ctx context.Context, vmid int, options ...proxmox.VirtualMachineOption) (*proxmox.Task, error) {
+// NewAPIToken mocks base method.
+func (m *MockProxmoxClientInterface) NewAPIToken(ctx context.Context, userID string, token proxmox.Token) (proxmox.NewAPIToken, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, vmid}
-	for _, a := range options {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "NewVirtualMachine", varargs...)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "NewAPIToken", ctx, userID, token)
+	ret0, _ := ret[0].(proxmox.NewAPIToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// NewVirtualMachine indicates an expected call of NewVirtualMachine.
-func (mr *MockNodeInterfaceMockRecorder) NewVirtualMachine(ctx, vmid any, options ...any) *gomock.Call {
+// NewAPIToken indicates an expected call of NewAPIToken.
+func (mr *MockProxmoxClientInterfaceMockRecorder) NewAPIToken(ctx, userID, token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, vmid}, options...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewVirtualMachine", reflect.TypeOf((*MockNodeInterface)(nil).NewVirtualMachine), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAPIToken", reflect.TypeOf((*MockProxmoxClientInterface)(nil).NewAPIToken), ctx, userID, token)
 }
 
-// RRDData mocks base method.
-func (m *MockNodeInterface) RRDData(ctx context.Context, timeframe proxmox.Timeframe, cf proxmox.ConsolidationFunction) ([]*proxmox.RRDData, error) {
+// NewGroup mocks base method.
+func (m *MockProxmoxClientInterface) NewGroup(ctx context.Context, groupID, comment string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RRDData", ctx, timeframe, cf)
-	ret0, _ := ret[0].([]*proxmox.RRDData)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "NewGroup", ctx, groupID, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RRDData indicates an expected call of RRDData.
-func (mr *MockNodeInterfaceMockRecorder) RRDData(ctx, timeframe, cf any) *gomock.Call {
+// NewGroup indicates an expected call of NewGroup.
+func (mr *MockProxmoxClientInterfaceMockRecorder) NewGroup(ctx, groupID, comment any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RRDData", reflect.TypeOf((*MockNodeInterface)(nil).RRDData), ctx, timeframe, cf)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGroup", reflect.TypeOf((*MockProxmoxClientInterface)(nil).NewGroup), ctx, groupID, comment)
 }
 
-// Storage mocks base method.
-func (m *MockNodeInterface) Storage(ctx context.Context, name string) (interfaces.StorageInterface, error) {
+// NewPool mocks base method.
+func (m *MockProxmoxClientInterface) NewPool(ctx context.Context, poolID, comment string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Storage", ctx, name)
-	ret0, _ := ret[0].(interfaces.StorageInterface)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "NewPool", ctx, poolID, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Storage indicates an expected call of Storage.
-func (mr *MockNodeInterfaceMockRecorder) Storage(ctx, name any) *gomock.Call {
+// NewPool indicates an expected call of NewPool.
+func (mr *MockProxmoxClientInterfaceMockRecorder) NewPool(ctx, poolID, comment any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Storage", reflect.TypeOf((*MockNodeInterface)(nil).Storage), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPool", reflect.TypeOf((*MockProxmoxClientInterface)(nil).NewPool), ctx, poolID, comment)
 }
 
-// StorageDownloadURL mocks base method.
-func (m *MockNodeInterface) StorageDownloadURL(ctx context.Context, options *proxmox.StorageDownloadURLOptions) (string, error) {
+// NewRole mocks base method.
+func (m *MockProxmoxClientInterface) NewRole(ctx context.Context, roleID, privs string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StorageDownloadURL", ctx, options)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "NewRole", ctx, roleID, privs)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// StorageDownloadURL indicates an expected call of StorageDownloadURL.
-func (mr *MockNodeInterfaceMockRecorder) StorageDownloadURL(ctx, options any) *gomock.Call {
+// NewRole indicates an expected call of NewRole.
+func (mr *MockProxmoxClientInterfaceMockRecorder) NewRole(ctx, roleID, privs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorageDownloadURL", reflect.TypeOf((*MockNodeInterface)(nil).StorageDownloadURL), ctx, options)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRole", reflect.TypeOf((*MockProxmoxClientInterface)(nil).NewRole), ctx, roleID, privs)
 }
 
-// Storages mocks base method.
-func (m *MockNodeInterface) Storages(ctx context.Context) (proxmox.Storages, error) {
+// NewUser mocks base method.
+func (m *MockProxmoxClientInterface) NewUser(ctx context.Context, user *proxmox.NewUser) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Storages", ctx)
-	ret0, _ := ret[0].(proxmox.Storages)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "NewUser", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Storages indicates an expected call of Storages.
-func (mr *MockNodeInterfaceMockRecorder) Storages(ctx any) *gomock.Call {
+// NewUser indicates an expected call of NewUser.
+func (mr *MockProxmoxClientInterfaceMockRecorder) NewUser(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Storages", reflect.TypeOf((*MockNodeInterface)(nil).Storages), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUser", reflect.TypeOf((*MockProxmoxClientInterface)(nil).NewUser), ctx, user)
 }
 
-// Tasks mocks base method.
-func (m *MockNodeInterface) Tasks(ctx context.Context, options *proxmox.NodeTasksOptions) ([]*proxmox.Task, error) {
+// Node mocks base method.
+func (m *MockProxmoxClientInterface) Node(ctx context.Context, nodeName string) (interfaces.NodeInterface, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Tasks", ctx, options)
-	ret0, _ := ret[0].([]*proxmox.Task)
+	ret := m.ctrl.Call(m, "Node", ctx, nodeName)
+	ret0, _ := ret[0].(interfaces.NodeInterface)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Tasks indicates an expected call of Tasks.
-func (mr *MockNodeInterfaceMockRecorder) Tasks(ctx, options any) *gomock.Call {
+// Node indicates an expected call of Node.
+func (mr *MockProxmoxClientInterfaceMockRecorder) Node(ctx, nodeName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tasks", reflect.TypeOf((*MockNodeInterface)(nil).Tasks), ctx, options)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Node", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Node), ctx, nodeName)
 }
 
-// VirtualMachine mocks base method.
-func (m *MockNodeInterface) VirtualMachine(ctx context.Context, vmid int) (interfaces.VirtualMachineInterface, error) {
+// Nodes mocks base method.
+func (m *MockProxmoxClientInterface) Nodes(ctx context.Context) (proxmox.NodeStatuses, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VirtualMachine", ctx, vmid)
-	ret0, _ := ret[0].(interfaces.VirtualMachineInterface)
+	ret := m.ctrl.Call(m, "Nodes", ctx)
+	ret0, _ := ret[0].(proxmox.NodeStatuses)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// VirtualMachine indicates an expected call of VirtualMachine.
-func (mr *MockNodeInterfaceMockRecorder) VirtualMachine(ctx, vmid any) *gomock.Call {
+// Nodes indicates an expected call of Nodes.
+func (mr *MockProxmoxClientInterfaceMockRecorder) Nodes(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachine", reflect.TypeOf((*MockNodeInterface)(nil).VirtualMachine), ctx, vmid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nodes", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Nodes), ctx)
 }
 
-// VirtualMachines mocks base method.
-func (m *MockNodeInterface) VirtualMachines(ctx context.Context) (proxmox.VirtualMachines, error) {
+// Permissions mocks base method.
+func (m *MockProxmoxClientInterface) Permissions(ctx context.Context, options *proxmox.PermissionsOptions) (proxmox.Permissions, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VirtualMachines", ctx)
-	ret0, _ := ret[0].(proxmox.VirtualMachines)
+	ret := m.ctrl.Call(m, "Permissions", ctx, options)
+	ret0, _ := ret[0].(proxmox.Permissions)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// VirtualMachines indicates an expected call of VirtualMachines.
-func (mr *MockNodeInterfaceMockRecorder) VirtualMachines(ctx any) *gomock.Call {
+// Permissions indicates an expected call of Permissions.
+func (mr *MockProxmoxClientInterfaceMockRecorder) Permissions(ctx, options any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachines", reflect.TypeOf((*MockNodeInterface)(nil).VirtualMachines), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Permissions", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Permissions), ctx, options)
 }
 
-// VzTmpls mocks base method.
-func (m *MockNodeInterface) VzTmpls(ctx context.Context, storage string) (proxmox.VzTmpls, error) {
+// Pool mocks base method.
+func (m *MockProxmoxClientInterface) Pool(ctx context.Context, poolID string, filters ...string) (*proxmox.Pool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VzTmpls", ctx, storage)
-	ret0, _ := ret[0].(proxmox.VzTmpls)
+	varargs := []any{ctx, poolID}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Pool", varargs...)
+	ret0, _ := ret[0].(*proxmox.Pool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// VzTmpls indicates an expected call of VzTmpls.
-func (mr *MockNodeInterfaceMockRecorder) VzTmpls(ctx, storage any) *gomock.Call {
+// Pool indicates an expected call of Pool.
+func (mr *MockProxmoxClientInterfaceMockRecorder) Pool(ctx, poolID any, filters ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VzTmpls", reflect.TypeOf((*MockNodeInterface)(nil).VzTmpls), ctx, storage)
+	varargs := append([]any{ctx, poolID}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pool", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Pool), varargs...)
 }
 
-// Vzdump mocks base method.
-func (m *MockNodeInterface) Vzdump(ctx context.Context, options *proxmox.VirtualMachineBackupOptions) (*proxmox.Task, error) {
+// Pools mocks base method.
+func (m *MockProxmoxClientInterface) Pools(ctx context.Context) (proxmox.Pools, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Vzdump", ctx, options)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "Pools", ctx)
+	ret0, _ := ret[0].(proxmox.Pools)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Vzdump indicates an expected call of Vzdump.
-func (mr *MockNodeInterfaceMockRecorder) Vzdump(ctx, options any) *gomock.Call {
+// Pools indicates an expected call of Pools.
+func (mr *MockProxmoxClientInterfaceMockRecorder) Pools(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vzdump", reflect.TypeOf((*MockNodeInterface)(nil).Vzdump), ctx, options)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pools", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Pools), ctx)
 }
 
-// MockContainerInterface is a mock of ContainerInterface interface.
-type MockContainerInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockContainerInterfaceMockRecorder
-	isgomock struct{}
+// Roles mocks base method.
+func (m *MockProxmoxClientInterface) Roles(ctx context.Context) (proxmox.Roles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Roles", ctx)
+	ret0, _ := ret[0].(proxmox.Roles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockContainerInterfaceMockRecorder is the mock recorder for MockContainerInterface.
-type MockContainerInterfaceMockRecorder struct {
-	mock *MockContainerInterface
+// Roles indicates an expected call of Roles.
+func (mr *MockProxmoxClientInterfaceMockRecorder) Roles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Roles", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Roles), ctx)
 }
 
-// NewMockContainerInterface creates a new mock instance.
-func NewMockContainerInterface(ctrl *gomock.Controller) *MockContainerInterface {
-	mock := &MockContainerInterface{ctrl: ctrl}
-	mock.recorder = &MockContainerInterfaceMockRecorder{mock}
-	return mock
+// UpdateACL mocks base method.
+func (m *MockProxmoxClientInterface) UpdateACL(ctx context.Context, options proxmox.ACLOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateACL", ctx, options)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockContainerInterface) EXPECT() *MockContainerInterfaceMockRecorder {
-	return m.recorder
+// UpdateACL indicates an expected call of UpdateACL.
+func (mr *MockProxmoxClientInterfaceMockRecorder) UpdateACL(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateACL", reflect.TypeOf((*MockProxmoxClientInterface)(nil).UpdateACL), ctx, options)
 }
 
-// AddTag mocks base method.
-func (m *MockContainerInterface) AddTag(ctx context.Context, value string) (*proxmox.Task, error) {
+// UpdateGroup mocks base method.
+func (m *MockProxmoxClientInterface) UpdateGroup(ctx context.Context, groupID, comment string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddTag", ctx, value)
-	ret0, _ := ret[0].(*proxmox.Task)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateGroup", ctx, groupID, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// AddTag indicates an expected call of AddTag.
-func (mr *MockContainerInterfaceMockRecorder) AddTag(ctx, value any) *gomock.Call {
+// UpdateGroup indicates an expected call of UpdateGroup.
+func (mr *MockProxmoxClientInterfaceMockRecorder) UpdateGroup(ctx, groupID, comment any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockContainerInterface)(nil).AddTag), ctx, value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*MockProxmoxClientInterface)(nil).UpdateGroup), ctx, groupID, comment)
 }
 
-// Clone mocks base method.
-func (m *MockContainerInterface) Clone(ctx context.Context, options *proxmox.ContainerCloneOptions) (int, *proxmox.Task, error) {
+// UpdatePool mocks base method.
+func (m *MockProxmoxClientInterface) UpdatePool(ctx context.Context, poolID string, options *proxmox.PoolUpdateOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Clone", ctx, options)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(*proxmox.Task)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdatePool", ctx, poolID, options)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Clone indicates an expected call of Clone.
-func (mr *MockContainerInterfaceMockRecorder) Clone(ctx, options any) *gomock.Call {
+// UpdatePool indicates an expected call of UpdatePool.
+func (mr *MockProxmoxClientInterfaceMockRecorder) UpdatePool(ctx, poolID, options any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockContainerInterface)(nil).Clone), ctx, options)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePool", reflect.TypeOf((*MockProxmoxClientInterface)(nil).UpdatePool), ctx, poolID, options)
 }
 
-// Config mocks base method.
-func (m *MockContainerInterface) Config(ctx context.Context, options ...proxmox.ContainerOption) (*proxmox.Task, error) {
+// UpdateUser mocks base method.
+func (m *MockProxmoxClientInterface) UpdateUser(ctx context.Context, userID string, options *proxmox.UserOptions) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range options {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Config", varargs...)
-	ret0, _ := ret[0].(*proxmox.Task)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, userID, options)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Config indicates an expected call of Config.
-func (mr *MockContainerInterfaceMockRecorder) Config(ctx any, options ...any) *gomock.Call {
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockProxmoxClientInterfaceMockRecorder) UpdateUser(ctx, userID, options any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, options...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Config", reflect.TypeOf((*MockContainerInterface)(nil).Config), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockProxmoxClientInterface)(nil).UpdateUser), ctx, userID, options)
 }
 
-// Delete mocks base method.
-func (m *MockContainerInterface) Delete(ctx context.Context, options *proxmox.ContainerDeleteOptions) (*proxmox.Task, error) {
+// User mocks base method.
+func (m *MockProxmoxClientInterface) User(ctx context.Context, userID string) (*proxmox.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, options)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "User", ctx, userID)
+	ret0, _ := ret[0].(*proxmox.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockContainerInterfaceMockRecorder) Delete(ctx, options any) *gomock.Call {
+// User indicates an expected call of User.
+func (mr *MockProxmoxClientInterfaceMockRecorder) User(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockContainerInterface)(nil).Delete), ctx, options)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "User", reflect.TypeOf((*MockProxmoxClientInterface)(nil).User), ctx, userID)
 }
 
-// DeleteSnapshot mocks base method.
-func (m *MockContainerInterface) DeleteSnapshot(ctx context.Context, name string) (*proxmox.Task, error) {
+// Users mocks base method.
+func (m *MockProxmoxClientInterface) Users(ctx context.Context) (proxmox.Users, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSnapshot", ctx, name)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "Users", ctx)
+	ret0, _ := ret[0].(proxmox.Users)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteSnapshot indicates an expected call of DeleteSnapshot.
-func (mr *MockContainerInterfaceMockRecorder) DeleteSnapshot(ctx, name any) *gomock.Call {
+// Users indicates an expected call of Users.
+func (mr *MockProxmoxClientInterfaceMockRecorder) Users(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockContainerInterface)(nil).DeleteSnapshot), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Users", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Users), ctx)
 }
 
-// Details mocks base method.
-func (m *MockContainerInterface) Details() interfaces.ContainerDetails {
+// Version mocks base method.
+func (m *MockProxmoxClientInterface) Version(ctx context.Context) (*proxmox.Version, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Details")
-	ret0, _ := ret[0].(interfaces.ContainerDetails)
-	return ret0
+	ret := m.ctrl.Call(m, "Version", ctx)
+	ret0, _ := ret[0].(*proxmox.Version)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Details indicates an expected call of Details.
-func (mr *MockContainerInterfaceMockRecorder) Details() *gomock.Call {
+// Version indicates an expected call of Version.
+func (mr *MockProxmoxClientInterfaceMockRecorder) Version(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Details", reflect.TypeOf((*MockContainerInterface)(nil).Details))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockProxmoxClientInterface)(nil).Version), ctx)
 }
 
-// Interfaces mocks base method.
-func (m *MockContainerInterface) Interfaces(ctx context.Context) (proxmox.ContainerInterfaces, error) {
+// WakeNode mocks base method.
+func (m *MockProxmoxClientInterface) WakeNode(ctx context.Context, nodeName string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Interfaces", ctx)
-	ret0, _ := ret[0].(proxmox.ContainerInterfaces)
+	ret := m.ctrl.Call(m, "WakeNode", ctx, nodeName)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Interfaces indicates an expected call of Interfaces.
-func (mr *MockContainerInterfaceMockRecorder) Interfaces(ctx any) *gomock.Call {
+// WakeNode indicates an expected call of WakeNode.
+func (mr *MockProxmoxClientInterfaceMockRecorder) WakeNode(ctx, nodeName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interfaces", reflect.TypeOf((*MockContainerInterface)(nil).Interfaces), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WakeNode", reflect.TypeOf((*MockProxmoxClientInterface)(nil).WakeNode), ctx, nodeName)
 }
 
-// Migrate mocks base method.
-func (m *MockContainerInterface) Migrate(ctx context.Context, options *proxmox.ContainerMigrateOptions) (*proxmox.Task, error) {
+// MockNodeInterface is a mock of NodeInterface interface.
+type MockNodeInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockNodeInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockNodeInterfaceMockRecorder is the mock recorder for MockNodeInterface.
+type MockNodeInterfaceMockRecorder struct {
+	mock *MockNodeInterface
+}
+
+// NewMockNodeInterface creates a new mock instance.
+func NewMockNodeInterface(ctrl *gomock.Controller) *MockNodeInterface {
+	mock := &MockNodeInterface{ctrl: ctrl}
+	mock.recorder = &MockNodeInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNodeInterface) EXPECT() *MockNodeInterfaceMockRecorder {
+	return m.recorder
+}
+
+// APTChangelog mocks base method.
+func (m *MockNodeInterface) APTChangelog(ctx context.Context, name, version string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Migrate", ctx, options)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "APTChangelog", ctx, name, version)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Migrate indicates an expected call of Migrate.
-func (mr *MockContainerInterfaceMockRecorder) Migrate(ctx, options any) *gomock.Call {
+// APTChangelog indicates an expected call of APTChangelog.
+func (mr *MockNodeInterfaceMockRecorder) APTChangelog(ctx, name, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockContainerInterface)(nil).Migrate), ctx, options)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APTChangelog", reflect.TypeOf((*MockNodeInterface)(nil).APTChangelog), ctx, name, version)
 }
 
-// NewSnapshot mocks base method.
-func (m *MockContainerInterface) NewSnapshot(ctx context.Context, name string) (*proxmox.Task, error) {
+// APTRefresh mocks base method.
+func (m *MockNodeInterface) APTRefresh(ctx context.Context) (*proxmox.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewSnapshot", ctx, name)
+	ret := m.ctrl.Call(m, "APTRefresh", ctx)
 	ret0, _ := ret[0].(*proxmox.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// NewSnapshot indicates an expected call of NewSnapshot.
-func (mr *MockContainerInterfaceMockRecorder) NewSnapshot(ctx, name any) *gomock.Call {
+// APTRefresh indicates an expected call of APTRefresh.
+func (mr *MockNodeInterfaceMockRecorder) APTRefresh(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSnapshot", reflect.TypeOf((*MockContainerInterface)(nil).NewSnapshot), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APTRefresh", reflect.TypeOf((*MockNodeInterface)(nil).APTRefresh), ctx)
 }
 
-// RRDData mocks base method.
-func (m *MockContainerInterface) RRDData(ctx context.Context, timeframe proxmox.Timeframe, cf ...proxmox.ConsolidationFunction) ([]*proxmox.RRDData, error) {
+// APTUpdates mocks base method.
+func (m *MockNodeInterface) APTUpdates(ctx context.Context) ([]*proxmox.APTUpdate, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, timeframe}
-	for _, a := range cf {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "RRDData", varargs...)
-	ret0, _ := ret[0].([]*proxmox.RRDData)
+	ret := m.ctrl.Call(m, "APTUpdates", ctx)
+	ret0, _ := ret[0].([]*proxmox.APTUpdate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RRDData indicates an expected call of RRDData.
-func (mr *MockContainerInterfaceMockRecorder) RRDData(ctx, timeframe any, cf ...any) *gomock.Call {
+// APTUpdates indicates an expected call of APTUpdates.
+func (mr *MockNodeInterfaceMockRecorder) APTUpdates(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, timeframe}, cf...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RRDData", reflect.TypeOf((*MockContainerInterface)(nil).RRDData), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APTUpdates", reflect.TypeOf((*MockNodeInterface)(nil).APTUpdates), ctx)
 }
 
-// Reboot mocks base method.
-func (m *MockContainerInterface) Reboot(ctx context.Context) (*proxmox.Task, error) {
+// APTVersions mocks base method.
+func (m *MockNodeInterface) APTVersions(ctx context.Context) ([]*proxmox.APTPackageVersion, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Reboot", ctx)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "APTVersions", ctx)
+	ret0, _ := ret[0].([]*proxmox.APTPackageVersion)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Reboot indicates an expected call of Reboot.
-func (mr *MockContainerInterfaceMockRecorder) Reboot(ctx any) *gomock.Call {
+// APTVersions indicates an expected call of APTVersions.
+func (mr *MockNodeInterfaceMockRecorder) APTVersions(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reboot", reflect.TypeOf((*MockContainerInterface)(nil).Reboot), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APTVersions", reflect.TypeOf((*MockNodeInterface)(nil).APTVersions), ctx)
 }
 
-// RemoveTag mocks base method.
-func (m *MockContainerInterface) RemoveTag(ctx context.Context, value string) (*proxmox.Task, error) {
+// Appliances mocks base method.
+func (m *MockNodeInterface) Appliances(ctx context.Context) (proxmox.Appliances, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveTag", ctx, value)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "Appliances", ctx)
+	ret0, _ := ret[0].(proxmox.Appliances)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RemoveTag indicates an expected call of RemoveTag.
-func (mr *MockContainerInterfaceMockRecorder) RemoveTag(ctx, value any) *gomock.Call {
+// Appliances indicates an expected call of Appliances.
+func (mr *MockNodeInterfaceMockRecorder) Appliances(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTag", reflect.TypeOf((*MockContainerInterface)(nil).RemoveTag), ctx, value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Appliances", reflect.TypeOf((*MockNodeInterface)(nil).Appliances), ctx)
 }
 
-// Resize mocks base method.
-func (m *MockContainerInterface) Resize(ctx context.Context, disk, size string) (*proxmox.Task, error) {
+// ApplyNetwork mocks base method.
+func (m *MockNodeInterface) ApplyNetwork(ctx context.Context) (*proxmox.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Resize", ctx, disk, size)
+	ret := m.ctrl.Call(m, "ApplyNetwork", ctx)
 	ret0, _ := ret[0].(*proxmox.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Resize indicates an expected call of Resize.
-func (mr *MockContainerInterfaceMockRecorder) Resize(ctx, disk, size any) *gomock.Call {
+// ApplyNetwork indicates an expected call of ApplyNetwork.
+func (mr *MockNodeInterfaceMockRecorder) ApplyNetwork(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockContainerInterface)(nil).Resize), ctx, disk, size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyNetwork", reflect.TypeOf((*MockNodeInterface)(nil).ApplyNetwork), ctx)
 }
 
-// Resume mocks base method.
-func (m *MockContainerInterface) Resume(ctx context.Context) (*proxmox.Task, error) {
+// Certificates mocks base method.
+func (m *MockNodeInterface) Certificates(ctx context.Context) (proxmox.NodeCertificates, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Resume", ctx)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "Certificates", ctx)
+	ret0, _ := ret[0].(proxmox.NodeCertificates)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Resume indicates an expected call of Resume.
-func (mr *MockContainerInterfaceMockRecorder) Resume(ctx any) *gomock.Call {
+// Certificates indicates an expected call of Certificates.
+func (mr *MockNodeInterfaceMockRecorder) Certificates(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockContainerInterface)(nil).Resume), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Certificates", reflect.TypeOf((*MockNodeInterface)(nil).Certificates), ctx)
 }
 
-// RollbackSnapshot mocks base method.
-func (m *MockContainerInterface) RollbackSnapshot(ctx context.Context, name string, start bool) (*proxmox.Task, error) {
+// Config mocks base method.
+func (m *MockNodeInterface) Config(ctx context.Context) (*proxmox.NodeConfig, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackSnapshot", ctx, name, start)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "Config", ctx)
+	ret0, _ := ret[0].(*proxmox.NodeConfig)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RollbackSnapshot indicates an expected call of RollbackSnapshot.
-func (mr *MockContainerInterfaceMockRecorder) RollbackSnapshot(ctx, name, start any) *gomock.Call {
+// Config indicates an expected call of Config.
+func (mr *MockNodeInterfaceMockRecorder) Config(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSnapshot", reflect.TypeOf((*MockContainerInterface)(nil).RollbackSnapshot), ctx, name, start)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Config", reflect.TypeOf((*MockNodeInterface)(nil).Config), ctx)
 }
 
-// Shutdown mocks base method.
-func (m *MockContainerInterface) Shutdown(ctx context.Context, force bool, timeout int) (*proxmox.Task, error) {
+// Container mocks base method.
+func (m *MockNodeInterface) Container(ctx context.Context, vmid int) (interfaces.ContainerInterface, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Shutdown", ctx, force, timeout)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "Container", ctx, vmid)
+	ret0, _ := ret[0].(interfaces.ContainerInterface)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Shutdown indicates an expected call of Shutdown.
-func (mr *MockContainerInterfaceMockRecorder) Shutdown(ctx, force, timeout any) *gomock.Call {
+// Container indicates an expected call of Container.
+func (mr *MockNodeInterfaceMockRecorder) Container(ctx, vmid any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockContainerInterface)(nil).Shutdown), ctx, force, timeout)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Container", reflect.TypeOf((*MockNodeInterface)(nil).Container), ctx, vmid)
 }
 
-// Snapshots mocks base method.
-func (m *MockContainerInterface) Snapshots(ctx context.Context) ([]*proxmox.ContainerSnapshot, error) {
+// Containers mocks base method.
+func (m *MockNodeInterface) Containers(ctx context.Context) (proxmox.Containers, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Snapshots", ctx)
-	ret0, _ := ret[0].([]*proxmox.ContainerSnapshot)
+	ret := m.ctrl.Call(m, "Containers", ctx)
+	ret0, _ := ret[0].(proxmox.Containers)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Snapshots indicates an expected call of Snapshots.
-func (mr *MockContainerInterfaceMockRecorder) Snapshots(ctx any) *gomock.Call {
+// Containers indicates an expected call of Containers.
+func (mr *MockNodeInterfaceMockRecorder) Containers(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshots", reflect.TypeOf((*MockContainerInterface)(nil).Snapshots), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Containers", reflect.TypeOf((*MockNodeInterface)(nil).Containers), ctx)
 }
 
-// Start mocks base method.
-func (m *MockContainerInterface) Start(ctx context.Context) (*proxmox.Task, error) {
+// CreateNetwork mocks base method.
+func (m *MockNodeInterface) CreateNetwork(ctx context.Context, params map[string]any) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Start", ctx)
+	ret := m.ctrl.Call(m, "CreateNetwork", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MockNodeInterfaceMockRecorder) CreateNetwork(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockNodeInterface)(nil).CreateNetwork), ctx, params)
+}
+
+// DeleteNetwork mocks base method.
+func (m *MockNodeInterface) DeleteNetwork(ctx context.Context, iface string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetwork", ctx, iface)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetwork indicates an expected call of DeleteNetwork.
+func (mr *MockNodeInterfaceMockRecorder) DeleteNetwork(ctx, iface any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetwork", reflect.TypeOf((*MockNodeInterface)(nil).DeleteNetwork), ctx, iface)
+}
+
+// DiskInitGPT mocks base method.
+func (m *MockNodeInterface) DiskInitGPT(ctx context.Context, disk string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiskInitGPT", ctx, disk)
 	ret0, _ := ret[0].(*proxmox.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Start indicates an expected call of Start.
-func (mr *MockContainerInterfaceMockRecorder) Start(ctx any) *gomock.Call {
+// DiskInitGPT indicates an expected call of DiskInitGPT.
+func (mr *MockNodeInterfaceMockRecorder) DiskInitGPT(ctx, disk any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockContainerInterface)(nil).Start), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiskInitGPT", reflect.TypeOf((*MockNodeInterface)(nil).DiskInitGPT), ctx, disk)
 }
 
-// Stop mocks base method.
-func (m *MockContainerInterface) Stop(ctx context.Context) (*proxmox.Task, error) {
+// DiskSMART mocks base method.
+func (m *MockNodeInterface) DiskSMART(ctx context.Context, disk string, healthOnly bool) (*proxmox.DiskSMART, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Stop", ctx)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "DiskSMART", ctx, disk, healthOnly)
+	ret0, _ := ret[0].(*proxmox.DiskSMART)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Stop indicates an expected call of Stop.
-func (mr *MockContainerInterfaceMockRecorder) Stop(ctx any) *gomock.Call {
+// DiskSMART indicates an expected call of DiskSMART.
+func (mr *MockNodeInterfaceMockRecorder) DiskSMART(ctx, disk, healthOnly any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockContainerInterface)(nil).Stop), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiskSMART", reflect.TypeOf((*MockNodeInterface)(nil).DiskSMART), ctx, disk, healthOnly)
 }
 
-// Suspend mocks base method.
-func (m *MockContainerInterface) Suspend(ctx context.Context) (*proxmox.Task, error) {
+// Disks mocks base method.
+func (m *MockNodeInterface) Disks(ctx context.Context, includePartitions, skipSMART bool, diskType string) ([]*proxmox.Disk, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Suspend", ctx)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "Disks", ctx, includePartitions, skipSMART, diskType)
+	ret0, _ := ret[0].([]*proxmox.Disk)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Suspend indicates an expected call of Suspend.
-func (mr *MockContainerInterfaceMockRecorder) Suspend(ctx any) *gomock.Call {
+// Disks indicates an expected call of Disks.
+func (mr *MockNodeInterfaceMockRecorder) Disks(ctx, includePartitions, skipSMART, diskType any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockContainerInterface)(nil).Suspend), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disks", reflect.TypeOf((*MockNodeInterface)(nil).Disks), ctx, includePartitions, skipSMART, diskType)
 }
 
-// TermProxy mocks base method.
-func (m *MockContainerInterface) TermProxy(ctx context.Context) (*proxmox.Term, error) {
+// DownloadAppliance mocks base method.
+func (m *MockNodeInterface) DownloadAppliance(ctx context.Context, template, storage string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TermProxy", ctx)
-	ret0, _ := ret[0].(*proxmox.Term)
+	ret := m.ctrl.Call(m, "DownloadAppliance", ctx, template, storage)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// TermProxy indicates an expected call of TermProxy.
-func (mr *MockContainerInterfaceMockRecorder) TermProxy(ctx any) *gomock.Call {
+// DownloadAppliance indicates an expected call of DownloadAppliance.
+func (mr *MockNodeInterfaceMockRecorder) DownloadAppliance(ctx, template, storage any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TermProxy", reflect.TypeOf((*MockContainerInterface)(nil).TermProxy), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAppliance", reflect.TypeOf((*MockNodeInterface)(nil).DownloadAppliance), ctx, template, storage)
 }
 
-// TermWebSocket mocks base method.
-func (m *MockContainerInterface) TermWebSocket(term *proxmox.Term) (chan []byte, chan []byte, chan error, func() error, error) {
+// Journal mocks base method.
+func (m *MockNodeInterface) Journal(ctx context.Context, options *proxmox.NodeJournalOptions) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TermWebSocket", term)
-	ret0, _ := ret[0].(chan []byte)
-	ret1, _ := ret[1].(chan []byte)
-	ret2, _ := ret[2].(chan error)
-	ret3, _ := ret[3].(func() error)
-	ret4, _ := ret[4].(error)
-	return ret0, ret1, ret2, ret3, ret4
+	ret := m.ctrl.Call(m, "Journal", ctx, options)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// TermWebSocket indicates an expected call of TermWebSocket.
-func (mr *MockContainerInterfaceMockRecorder) TermWebSocket(term any) *gomock.Call {
+// Journal indicates an expected call of Journal.
+func (mr *MockNodeInterfaceMockRecorder) Journal(ctx, options any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TermWebSocket", reflect.TypeOf((*MockContainerInterface)(nil).TermWebSocket), term)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Journal", reflect.TypeOf((*MockNodeInterface)(nil).Journal), ctx, options)
 }
 
-// MockVirtualMachineInterface is a mock of VirtualMachineInterface interface.
-type MockVirtualMachineInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockVirtualMachineInterfaceMockRecorder
-	isgomock struct{}
-}
-
-// MockVirtualMachineInterfaceMockRecorder is the mock recorder for MockVirtualMachineInterface.
-type MockVirtualMachineInterfaceMockRecorder struct {
-	mock *MockVirtualMachineInterface
-}
-
-// NewMockVirtualMachineInterface creates a new mock instance.
-func NewMockVirtualMachineInterface(ctrl *gomock.Controller) *MockVirtualMachineInterface {
-	mock := &MockVirtualMachineInterface{ctrl: ctrl}
-	mock.recorder = &MockVirtualMachineInterfaceMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockVirtualMachineInterface) EXPECT() *MockVirtualMachineInterfaceMockRecorder {
-	return m.recorder
-}
-
-// AddTag mocks base method.
-func (m *MockVirtualMachineInterface) AddTag(ctx context.Context, value string) (*proxmox.Task, error) {
+// ListPCIDevices mocks base method.
+func (m *MockNodeInterface) ListPCIDevices(ctx context.Context, opts *proxmox.HardwarePCIOptions) ([]*proxmox.PCIDevice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddTag", ctx, value)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "ListPCIDevices", ctx, opts)
+	ret0, _ := ret[0].([]*proxmox.PCIDevice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddTag indicates an expected call of AddTag.
-func (mr *MockVirtualMachineInterfaceMockRecorder) AddTag(ctx, value any) *gomock.Call {
+// ListPCIDevices indicates an expected call of ListPCIDevices.
+func (mr *MockNodeInterfaceMockRecorder) ListPCIDevices(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockVirtualMachineInterface)(nil).AddTag), ctx, value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPCIDevices", reflect.TypeOf((*MockNodeInterface)(nil).ListPCIDevices), ctx, opts)
 }
 
-// AgentExec mocks base method.
-func (m *MockVirtualMachineInterface) AgentExec(ctx context.Context, command []string, inputData string) (int, error) {
+// ListUSBDevices mocks base method.
+func (m *MockNodeInterface) ListUSBDevices(ctx context.Context) ([]*proxmox.USBDevice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AgentExec", ctx, command, inputData)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "ListUSBDevices", ctx)
+	ret0, _ := ret[0].([]*proxmox.USBDevice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AgentExec indicates an expected call of AgentExec.
-func (mr *MockVirtualMachineInterfaceMockRecorder) AgentExec(ctx, command, inputData any) *gomock.Call {
+// ListUSBDevices indicates an expected call of ListUSBDevices.
+func (mr *MockNodeInterfaceMockRecorder) ListUSBDevices(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AgentExec", reflect.TypeOf((*MockVirtualMachineInterface)(nil).AgentExec), ctx, command, inputData)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUSBDevices", reflect.TypeOf((*MockNodeInterface)(nil).ListUSBDevices), ctx)
 }
 
-// AgentGetNetworkIFaces mocks base method.
-func (m *MockVirtualMachineInterface) AgentGetNetworkIFaces(ctx context.Context) ([]*proxmox.AgentNetworkIface, error) {
+// Network mocks base method.
+func (m *MockNodeInterface) Network(ctx context.Context, iface string) (*proxmox.NodeNetwork, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AgentGetNetworkIFaces", ctx)
-	ret0, _ := ret[0].([]*proxmox.AgentNetworkIface)
+	ret := m.ctrl.Call(m, "Network", ctx, iface)
+	ret0, _ := ret[0].(*proxmox.NodeNetwork)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AgentGetNetworkIFaces indicates an expected call of AgentGetNetworkIFaces.
-func (mr *MockVirtualMachineInterfaceMockRecorder) AgentGetNetworkIFaces(ctx any) *gomock.Call {
+// Network indicates an expected call of Network.
+func (mr *MockNodeInterfaceMockRecorder) Network(ctx, iface any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AgentGetNetworkIFaces", reflect.TypeOf((*MockVirtualMachineInterface)(nil).AgentGetNetworkIFaces), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Network", reflect.TypeOf((*MockNodeInterface)(nil).Network), ctx, iface)
 }
 
-// Clone mocks base method.
-func (m *MockVirtualMachineInterface) Clone(ctx context.Context, options *proxmox.VirtualMachineCloneOptions) (int, *proxmox.Task, error) {
+// NetworkChanges mocks base method.
+func (m *MockNodeInterface) NetworkChanges(ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Clone", ctx, options)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(*proxmox.Task)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "NetworkChanges", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Clone indicates an expected call of Clone.
-func (mr *MockVirtualMachineInterfaceMockRecorder) Clone(ctx, options any) *gomock.Call {
+// NetworkChanges indicates an expected call of NetworkChanges.
+func (mr *MockNodeInterfaceMockRecorder) NetworkChanges(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Clone), ctx, options)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkChanges", reflect.TypeOf((*MockNodeInterface)(nil).NetworkChanges), ctx)
 }
 
-// Config mocks base method.
-func (m *MockVirtualMachineInterface) Config(ctx context.Context, options ...proxmox.VirtualMachineOption) (*proxmox.Task, error) {
+// Networks mocks base method.
+func (m *MockNodeInterface) Networks(ctx context.Context, ifaceType ...string) (proxmox.NodeNetworks, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range options {
+	for _, a := range ifaceType {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Config", varargs...)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "Networks", varargs...)
+	ret0, _ := ret[0].(proxmox.NodeNetworks)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Config indicates an expected call of Config.
-func (mr *MockVirtualMachineInterfaceMockRecorder) Config(ctx any, options ...any) *gomock.Call {
+// Networks indicates an expected call of Networks.
+func (mr *MockNodeInterfaceMockRecorder) Networks(ctx any, ifaceType ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, options...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Config", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Config), varargs...)
+	varargs := append([]any{ctx}, ifaceType...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Networks", reflect.TypeOf((*MockNodeInterface)(nil).Networks), varargs...)
 }
 
-// Delete mocks base method.
-func (m *MockVirtualMachineInterface) Delete(ctx context.Context, options *proxmox.VirtualMachineDeleteOptions) (*proxmox.Task, error) {
+// NewContainer mocks base method.
+func (m *MockNodeInterface) NewContainer(ctx context.Context, vmid int, options ...proxmox.ContainerOption) (*proxmox.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, options)
+	varargs := []any{ctx, vmid}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewContainer", varargs...)
 	ret0, _ := ret[0].(*proxmox.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockVirtualMachineInterfaceMockRecorder) Delete(ctx, options any) *gomock.Call {
+// NewContainer indicates an expected call of NewContainer.
+func (mr *MockNodeInterfaceMockRecorder) NewContainer(ctx, vmid any, options ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Delete), ctx, options)
+	varargs := append([]any{ctx, vmid}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewContainer", reflect.TypeOf((*MockNodeInterface)(nil).NewContainer), varargs...)
 }
 
-// DeleteSnapshot mocks base method.
-func (m *MockVirtualMachineInterface) DeleteSnapshot(ctx context.Context, name string) (*proxmox.Task, error) {
+// NewDirectory mocks base method.
+func (m *MockNodeInterface) NewDirectory(ctx context.Context, options *proxmox.NodeDirectoryOptions) (*proxmox.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSnapshot", ctx, name)
+	ret := m.ctrl.Call(m, "NewDirectory", ctx, options)
 	ret0, _ := ret[0].(*proxmox.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteSnapshot indicates an expected call of DeleteSnapshot.
-func (mr *MockVirtualMachineInterfaceMockRecorder) DeleteSnapshot(ctx, name any) *gomock.Call {
+// NewDirectory indicates an expected call of NewDirectory.
+func (mr *MockNodeInterfaceMockRecorder) NewDirectory(ctx, options any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockVirtualMachineInterface)(nil).DeleteSnapshot), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDirectory", reflect.TypeOf((*MockNodeInterface)(nil).NewDirectory), ctx, options)
 }
 
-// Details mocks base method.
-func (m *MockVirtualMachineInterface) Details() interfaces.VirtualMachineDetails {
+// NewLVMThin mocks base method.
+func (m *MockNodeInterface) NewLVMThin(ctx context.Context, options *proxmox.NodeLVMThinOptions) (*proxmox.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Details")
-	ret0, _ := ret[0].(interfaces.VirtualMachineDetails)
-	return ret0
+	ret := m.ctrl.Call(m, "NewLVMThin", ctx, options)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Details indicates an expected call of Details.
-func (mr *MockVirtualMachineInterfaceMockRecorder) Details() *gomock.Call {
+// NewLVMThin indicates an expected call of NewLVMThin.
+func (mr *MockNodeInterfaceMockRecorder) NewLVMThin(ctx, options any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Details", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Details))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewLVMThin", reflect.TypeOf((*MockNodeInterface)(nil).NewLVMThin), ctx, options)
 }
 
-// Migrate mocks base method.
-func (m *MockVirtualMachineInterface) Migrate(ctx context.Context, options *proxmox.VirtualMachineMigrateOptions) (*proxmox.Task, error) {
+// NewVirtualMachine mocks base method.
+func (m *MockNodeInterface) NewVirtualMachine(ctx context.Context, vmid int, options ...proxmox.VirtualMachineOption) (*proxmox.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Migrate", ctx, options)
+	varargs := []any{ctx, vmid}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*proxmox.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Migrate indicates an expected call of Migrate.
-func (mr *MockVirtualMachineInterfaceMockRecorder) Migrate(ctx, options any) *gomock.Call {
+// NewVirtualMachine indicates an expected call of NewVirtualMachine.
+func (mr *MockNodeInterfaceMockRecorder) NewVirtualMachine(ctx, vmid any, options ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Migrate), ctx, options)
+	varargs := append([]any{ctx, vmid}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewVirtualMachine", reflect.TypeOf((*MockNodeInterface)(nil).NewVirtualMachine), varargs...)
 }
 
-// MigratePreconditions mocks base method.
-func (m *MockVirtualMachineInterface) MigratePreconditions(ctx context.Context, target string) (*proxmox.VirtualMachineMigratePreconditions, error) {
+// NewZFSPool mocks base method.
+func (m *MockNodeInterface) NewZFSPool(ctx context.Context, options *proxmox.NodeZFSPoolOptions) (*proxmox.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MigratePreconditions", ctx, target)
-	ret0, _ := ret[0].(*proxmox.VirtualMachineMigratePreconditions)
+	ret := m.ctrl.Call(m, "NewZFSPool", ctx, options)
+	ret0, _ := ret[0].(*proxmox.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MigratePreconditions indicates an expected call of MigratePreconditions.
-func (mr *MockVirtualMachineInterfaceMockRecorder) MigratePreconditions(ctx, target any) *gomock.Call {
+// NewZFSPool indicates an expected call of NewZFSPool.
+func (mr *MockNodeInterfaceMockRecorder) NewZFSPool(ctx, options any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigratePreconditions", reflect.TypeOf((*MockVirtualMachineInterface)(nil).MigratePreconditions), ctx, target)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewZFSPool", reflect.TypeOf((*MockNodeInterface)(nil).NewZFSPool), ctx, options)
 }
 
-// NewSnapshot mocks base method.
-func (m *MockVirtualMachineInterface) NewSnapshot(ctx context.Context, name string) (*proxmox.Task, error) {
+// OrderACMECertificate mocks base method.
+func (m *MockNodeInterface) OrderACMECertificate(ctx context.Context, force bool) (*proxmox.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewSnapshot", ctx, name)
+	ret := m.ctrl.Call(m, "OrderACMECertificate", ctx, force)
 	ret0, _ := ret[0].(*proxmox.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// NewSnapshot indicates an expected call of NewSnapshot.
-func (mr *MockVirtualMachineInterfaceMockRecorder) NewSnapshot(ctx, name any) *gomock.Call {
+// OrderACMECertificate indicates an expected call of OrderACMECertificate.
+func (mr *MockNodeInterfaceMockRecorder) OrderACMECertificate(ctx, force any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSnapshot", reflect.TypeOf((*MockVirtualMachineInterface)(nil).NewSnapshot), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderACMECertificate", reflect.TypeOf((*MockNodeInterface)(nil).OrderACMECertificate), ctx, force)
 }
 
-// Pause mocks base method.
-func (m *MockVirtualMachineInterface) Pause(ctx context.Context) (*proxmox.Task, error) {
+// PCIMdevTypes mocks base method.
+func (m *MockNodeInterface) PCIMdevTypes(ctx context.Context, id string) ([]*proxmox.PCIMdevType, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Pause", ctx)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "PCIMdevTypes", ctx, id)
+	ret0, _ := ret[0].([]*proxmox.PCIMdevType)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Pause indicates an expected call of Pause.
-func (mr *MockVirtualMachineInterfaceMockRecorder) Pause(ctx any) *gomock.Call {
+// PCIMdevTypes indicates an expected call of PCIMdevTypes.
+func (mr *MockNodeInterfaceMockRecorder) PCIMdevTypes(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Pause), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PCIMdevTypes", reflect.TypeOf((*MockNodeInterface)(nil).PCIMdevTypes), ctx, id)
 }
 
 // RRDData mocks base method.
-func (m *MockVirtualMachineInterface) RRDData(ctx context.Context, timeframe proxmox.Timeframe, cf ...proxmox.ConsolidationFunction) ([]*proxmox.RRDData, error) {
+func (m *MockNodeInterface) RRDData(ctx context.Context, timeframe proxmox.Timeframe, cf proxmox.ConsolidationFunction) ([]*proxmox.RRDData, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, timeframe}
-	for _, a := range cf {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "RRDData", varargs...)
+	ret := m.ctrl.Call(m, "RRDData", ctx, timeframe, cf)
 	ret0, _ := ret[0].([]*proxmox.RRDData)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RRDData indicates an expected call of RRDData.
-func (mr *MockVirtualMachineInterfaceMockRecorder) RRDData(ctx, timeframe any, cf ...any) *gomock.Call {
+func (mr *MockNodeInterfaceMockRecorder) RRDData(ctx, timeframe, cf any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, timeframe}, cf...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RRDData", reflect.TypeOf((*MockVirtualMachineInterface)(nil).RRDData), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RRDData", reflect.TypeOf((*MockNodeInterface)(nil).RRDData), ctx, timeframe, cf)
 }
 
 // Reboot mocks base method.
-func (m *MockVirtualMachineInterface) Reboot(ctx context.Context) (*proxmox.Task, error) {
+func (m *MockNodeInterface) Reboot(ctx context.Context) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Reboot", ctx)
-	ret0, _ := ret[0].(*proxmox.Task)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Reboot indicates an expected call of Reboot.
-func (mr *MockVirtualMachineInterfaceMockRecorder) Reboot(ctx any) *gomock.Call {
+func (mr *MockNodeInterfaceMockRecorder) Reboot(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reboot", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Reboot), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reboot", reflect.TypeOf((*MockNodeInterface)(nil).Reboot), ctx)
 }
 
-// RemoveTag mocks base method.
-func (m *MockVirtualMachineInterface) RemoveTag(ctx context.Context, value string) (*proxmox.Task, error) {
+// RenewACMECertificate mocks base method.
+func (m *MockNodeInterface) RenewACMECertificate(ctx context.Context, force bool) (*proxmox.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveTag", ctx, value)
+	ret := m.ctrl.Call(m, "RenewACMECertificate", ctx, force)
 	ret0, _ := ret[0].(*proxmox.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RemoveTag indicates an expected call of RemoveTag.
-func (mr *MockVirtualMachineInterfaceMockRecorder) RemoveTag(ctx, value any) *gomock.Call {
+// RenewACMECertificate indicates an expected call of RenewACMECertificate.
+func (mr *MockNodeInterfaceMockRecorder) RenewACMECertificate(ctx, force any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTag", reflect.TypeOf((*MockVirtualMachineInterface)(nil).RemoveTag), ctx, value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewACMECertificate", reflect.TypeOf((*MockNodeInterface)(nil).RenewACMECertificate), ctx, force)
 }
 
-// ResizeDisk mocks base method.
-func (m *MockVirtualMachineInterface) ResizeDisk(ctx context.Context, disk, size string) (*proxmox.Task, error) {
+// RevertNetwork mocks base method.
+func (m *MockNodeInterface) RevertNetwork(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResizeDisk", ctx, disk, size)
-	ret0, _ := ret[0].(*proxmox.Task)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RevertNetwork", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ResizeDisk indicates an expected call of ResizeDisk.
-func (mr *MockVirtualMachineInterfaceMockRecorder) ResizeDisk(ctx, disk, size any) *gomock.Call {
+// RevertNetwork indicates an expected call of RevertNetwork.
+func (mr *MockNodeInterfaceMockRecorder) RevertNetwork(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeDisk", reflect.TypeOf((*MockVirtualMachineInterface)(nil).ResizeDisk), ctx, disk, size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertNetwork", reflect.TypeOf((*MockNodeInterface)(nil).RevertNetwork), ctx)
 }
 
-// Resume mocks base method.
-func (m *MockVirtualMachineInterface) Resume(ctx context.Context) (*proxmox.Task, error) {
+// ServiceAction mocks base method.
+func (m *MockNodeInterface) ServiceAction(ctx context.Context, service, action string) (*proxmox.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Resume", ctx)
+	ret := m.ctrl.Call(m, "ServiceAction", ctx, service, action)
 	ret0, _ := ret[0].(*proxmox.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Resume indicates an expected call of Resume.
-func (mr *MockVirtualMachineInterfaceMockRecorder) Resume(ctx any) *gomock.Call {
+// ServiceAction indicates an expected call of ServiceAction.
+func (mr *MockNodeInterfaceMockRecorder) ServiceAction(ctx, service, action any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Resume), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceAction", reflect.TypeOf((*MockNodeInterface)(nil).ServiceAction), ctx, service, action)
 }
 
-// RollbackSnapshot mocks base method.
-func (m *MockVirtualMachineInterface) RollbackSnapshot(ctx context.Context, name string) (*proxmox.Task, error) {
+// Services mocks base method.
+func (m *MockNodeInterface) Services(ctx context.Context) ([]*proxmox.NodeService, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackSnapshot", ctx, name)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "Services", ctx)
+	ret0, _ := ret[0].([]*proxmox.NodeService)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RollbackSnapshot indicates an expected call of RollbackSnapshot.
-func (mr *MockVirtualMachineInterfaceMockRecorder) RollbackSnapshot(ctx, name any) *gomock.Call {
+// Services indicates an expected call of Services.
+func (mr *MockNodeInterfaceMockRecorder) Services(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSnapshot", reflect.TypeOf((*MockVirtualMachineInterface)(nil).RollbackSnapshot), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Services", reflect.TypeOf((*MockNodeInterface)(nil).Services), ctx)
 }
 
 // Shutdown mocks base method.
-func (m *MockVirtualMachineInterface) Shutdown(ctx context.Context) (*proxmox.Task, error) {
+func (m *MockNodeInterface) Shutdown(ctx context.Context) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Shutdown", ctx)
-	ret0, _ := ret[0].(*proxmox.Task)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Shutdown indicates an expected call of Shutdown.
-func (mr *MockVirtualMachineInterfaceMockRecorder) Shutdown(ctx any) *gomock.Call {
+func (mr *MockNodeInterfaceMockRecorder) Shutdown(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Shutdown), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockNodeInterface)(nil).Shutdown), ctx)
 }
 
-// Snapshots mocks base method.
-func (m *MockVirtualMachineInterface) Snapshots(ctx context.Context) ([]*proxmox.VirtualMachineSnapshot, error) {
+// StartAll mocks base method.
+func (m *MockNodeInterface) StartAll(ctx context.Context, force bool, vms string) (*proxmox.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Snapshots", ctx)
-	ret0, _ := ret[0].([]*proxmox.VirtualMachineSnapshot)
+	ret := m.ctrl.Call(m, "StartAll", ctx, force, vms)
+	ret0, _ := ret[0].(*proxmox.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Snapshots indicates an expected call of Snapshots.
-func (mr *MockVirtualMachineInterfaceMockRecorder) Snapshots(ctx any) *gomock.Call {
+// StartAll indicates an expected call of StartAll.
+func (mr *MockNodeInterfaceMockRecorder) StartAll(ctx, force, vms any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshots", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Snapshots), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAll", reflect.TypeOf((*MockNodeInterface)(nil).StartAll), ctx, force, vms)
 }
 
-// Start mocks base method.
-func (m *MockVirtualMachineInterface) Start(ctx context.Context) (*proxmox.Task, error) {
+// StopAll mocks base method.
+func (m *MockNodeInterface) StopAll(ctx context.Context, forceStop bool, timeout int, vms string) (*proxmox.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Start", ctx)
+	ret := m.ctrl.Call(m, "StopAll", ctx, forceStop, timeout, vms)
 	ret0, _ := ret[0].(*proxmox.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Start indicates an expected call of Start.
-func (mr *MockVirtualMachineInterfaceMockRecorder) Start(ctx any) *gomock.Call {
+// StopAll indicates an expected call of StopAll.
+func (mr *MockNodeInterfaceMockRecorder) StopAll(ctx, forceStop, timeout, vms any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Start), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopAll", reflect.TypeOf((*MockNodeInterface)(nil).StopAll), ctx, forceStop, timeout, vms)
 }
 
-// Stop mocks base method.
-func (m *MockVirtualMachineInterface) Stop(ctx context.Context) (*proxmox.Task, error) {
+// Storage mocks base method.
+func (m *MockNodeInterface) Storage(ctx context.Context, name string) (interfaces.StorageInterface, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Stop", ctx)
-	ret0, _ := ret[0].(*proxmox.Task)
+	ret := m.ctrl.Call(m, "Storage", ctx, name)
+	ret0, _ := ret[0].(interfaces.StorageInterface)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Stop indicates an expected call of Stop.
-func (mr *MockVirtualMachineInterfaceMockRecorder) Stop(ctx any) *gomock.Call {
+// Storage indicates an expected call of Storage.
+func (mr *MockNodeInterfaceMockRecorder) Storage(ctx, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Stop), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Storage", reflect.TypeOf((*MockNodeInterface)(nil).Storage), ctx, name)
 }
 
-// TermProxy mocks base method.
-func (m *MockVirtualMachineInterface) TermProxy(ctx context.Context) (*proxmox.Term, error) {
+// StorageDownloadURL mocks base method.
+func (m *MockNodeInterface) StorageDownloadURL(ctx context.Context, options *proxmox.StorageDownloadURLOptions) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TermProxy", ctx)
-	ret0, _ := ret[0].(*proxmox.Term)
+	ret := m.ctrl.Call(m, "StorageDownloadURL", ctx, options)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// TermProxy indicates an expected call of TermProxy.
-func (mr *MockVirtualMachineInterfaceMockRecorder) TermProxy(ctx any) *gomock.Call {
+// StorageDownloadURL indicates an expected call of StorageDownloadURL.
+func (mr *MockNodeInterfaceMockRecorder) StorageDownloadURL(ctx, options any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TermProxy", reflect.TypeOf((*MockVirtualMachineInterface)(nil).TermProxy), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorageDownloadURL", reflect.TypeOf((*MockNodeInterface)(nil).StorageDownloadURL), ctx, options)
 }
 
-// TermWebSocket mocks base method.
-func (m *MockVirtualMachineInterface) TermWebSocket(term *proxmox.Term) (chan []byte, chan []byte, chan error, func() error, error) {
+// Storages mocks base method.
+func (m *MockNodeInterface) Storages(ctx context.Context) (proxmox.Storages, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TermWebSocket", term)
-	ret0, _ := ret[0].(chan []byte)
-	ret1, _ := ret[1].(chan []byte)
-	ret2, _ := ret[2].(chan error)
-	ret3, _ := ret[3].(func() error)
-	ret4, _ := ret[4].(error)
-	return ret0, ret1, ret2, ret3, ret4
+	ret := m.ctrl.Call(m, "Storages", ctx)
+	ret0, _ := ret[0].(proxmox.Storages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// TermWebSocket indicates an expected call of TermWebSocket.
-func (mr *MockVirtualMachineInterfaceMockRecorder) TermWebSocket(term any) *gomock.Call {
+// Storages indicates an expected call of Storages.
+func (mr *MockNodeInterfaceMockRecorder) Storages(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Storages", reflect.TypeOf((*MockNodeInterface)(nil).Storages), ctx)
+}
+
+// SuspendAll mocks base method.
+func (m *MockNodeInterface) SuspendAll(ctx context.Context, vms string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendAll", ctx, vms)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendAll indicates an expected call of SuspendAll.
+func (mr *MockNodeInterfaceMockRecorder) SuspendAll(ctx, vms any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendAll", reflect.TypeOf((*MockNodeInterface)(nil).SuspendAll), ctx, vms)
+}
+
+// Syslog mocks base method.
+func (m *MockNodeInterface) Syslog(ctx context.Context, options *proxmox.NodeSyslogOptions) ([]*proxmox.LogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Syslog", ctx, options)
+	ret0, _ := ret[0].([]*proxmox.LogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Syslog indicates an expected call of Syslog.
+func (mr *MockNodeInterfaceMockRecorder) Syslog(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Syslog", reflect.TypeOf((*MockNodeInterface)(nil).Syslog), ctx, options)
+}
+
+// Task mocks base method.
+func (m *MockNodeInterface) Task(upid string) *proxmox.Task {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Task", upid)
+	ret0, _ := ret[0].(*proxmox.Task)
+	return ret0
+}
+
+// Task indicates an expected call of Task.
+func (mr *MockNodeInterfaceMockRecorder) Task(upid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Task", reflect.TypeOf((*MockNodeInterface)(nil).Task), upid)
+}
+
+// TaskLog mocks base method.
+func (m *MockNodeInterface) TaskLog(ctx context.Context, upid string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskLog", ctx, upid)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskLog indicates an expected call of TaskLog.
+func (mr *MockNodeInterfaceMockRecorder) TaskLog(ctx, upid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskLog", reflect.TypeOf((*MockNodeInterface)(nil).TaskLog), ctx, upid)
+}
+
+// Tasks mocks base method.
+func (m *MockNodeInterface) Tasks(ctx context.Context, options *proxmox.NodeTasksOptions) ([]*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tasks", ctx, options)
+	ret0, _ := ret[0].([]*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tasks indicates an expected call of Tasks.
+func (mr *MockNodeInterfaceMockRecorder) Tasks(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tasks", reflect.TypeOf((*MockNodeInterface)(nil).Tasks), ctx, options)
+}
+
+// TermProxy mocks base method.
+func (m *MockNodeInterface) TermProxy(ctx context.Context, command proxmox.NodeConsoleCmd, commandOptions string) (*proxmox.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TermProxy", ctx, command, commandOptions)
+	ret0, _ := ret[0].(*proxmox.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TermProxy indicates an expected call of TermProxy.
+func (mr *MockNodeInterfaceMockRecorder) TermProxy(ctx, command, commandOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TermProxy", reflect.TypeOf((*MockNodeInterface)(nil).TermProxy), ctx, command, commandOptions)
+}
+
+// TermWebSocket mocks base method.
+func (m *MockNodeInterface) TermWebSocket(term *proxmox.Term) (chan []byte, chan []byte, chan error, func() error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TermWebSocket", term)
+	ret0, _ := ret[0].(chan []byte)
+	ret1, _ := ret[1].(chan []byte)
+	ret2, _ := ret[2].(chan error)
+	ret3, _ := ret[3].(func() error)
+	ret4, _ := ret[4].(error)
+	return ret0, ret1, ret2, ret3, ret4
+}
+
+// TermWebSocket indicates an expected call of TermWebSocket.
+func (mr *MockNodeInterfaceMockRecorder) TermWebSocket(term any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TermWebSocket", reflect.TypeOf((*MockNodeInterface)(nil).TermWebSocket), term)
+}
+
+// UpdateConfig mocks base method.
+func (m *MockNodeInterface) UpdateConfig(ctx context.Context, options *proxmox.NodeConfigOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConfig", ctx, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConfig indicates an expected call of UpdateConfig.
+func (mr *MockNodeInterfaceMockRecorder) UpdateConfig(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfig", reflect.TypeOf((*MockNodeInterface)(nil).UpdateConfig), ctx, options)
+}
+
+// UpdateNetwork mocks base method.
+func (m *MockNodeInterface) UpdateNetwork(ctx context.Context, iface string, params map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetwork", ctx, iface, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNetwork indicates an expected call of UpdateNetwork.
+func (mr *MockNodeInterfaceMockRecorder) UpdateNetwork(ctx, iface, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetwork", reflect.TypeOf((*MockNodeInterface)(nil).UpdateNetwork), ctx, iface, params)
+}
+
+// UploadCertificate mocks base method.
+func (m *MockNodeInterface) UploadCertificate(ctx context.Context, certificate *proxmox.CustomCertificate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadCertificate", ctx, certificate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadCertificate indicates an expected call of UploadCertificate.
+func (mr *MockNodeInterfaceMockRecorder) UploadCertificate(ctx, certificate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadCertificate", reflect.TypeOf((*MockNodeInterface)(nil).UploadCertificate), ctx, certificate)
+}
+
+// VirtualMachine mocks base method.
+func (m *MockNodeInterface) VirtualMachine(ctx context.Context, vmid int) (interfaces.VirtualMachineInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachine", ctx, vmid)
+	ret0, _ := ret[0].(interfaces.VirtualMachineInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VirtualMachine indicates an expected call of VirtualMachine.
+func (mr *MockNodeInterfaceMockRecorder) VirtualMachine(ctx, vmid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachine", reflect.TypeOf((*MockNodeInterface)(nil).VirtualMachine), ctx, vmid)
+}
+
+// VirtualMachines mocks base method.
+func (m *MockNodeInterface) VirtualMachines(ctx context.Context) (proxmox.VirtualMachines, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachines", ctx)
+	ret0, _ := ret[0].(proxmox.VirtualMachines)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VirtualMachines indicates an expected call of VirtualMachines.
+func (mr *MockNodeInterfaceMockRecorder) VirtualMachines(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachines", reflect.TypeOf((*MockNodeInterface)(nil).VirtualMachines), ctx)
+}
+
+// VzTmpls mocks base method.
+func (m *MockNodeInterface) VzTmpls(ctx context.Context, storage string) (proxmox.VzTmpls, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VzTmpls", ctx, storage)
+	ret0, _ := ret[0].(proxmox.VzTmpls)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VzTmpls indicates an expected call of VzTmpls.
+func (mr *MockNodeInterfaceMockRecorder) VzTmpls(ctx, storage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VzTmpls", reflect.TypeOf((*MockNodeInterface)(nil).VzTmpls), ctx, storage)
+}
+
+// Vzdump mocks base method.
+func (m *MockNodeInterface) Vzdump(ctx context.Context, options *proxmox.VirtualMachineBackupOptions) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vzdump", ctx, options)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vzdump indicates an expected call of Vzdump.
+func (mr *MockNodeInterfaceMockRecorder) Vzdump(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vzdump", reflect.TypeOf((*MockNodeInterface)(nil).Vzdump), ctx, options)
+}
+
+// MockContainerInterface is a mock of ContainerInterface interface.
+type MockContainerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockContainerInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockContainerInterfaceMockRecorder is the mock recorder for MockContainerInterface.
+type MockContainerInterfaceMockRecorder struct {
+	mock *MockContainerInterface
+}
+
+// NewMockContainerInterface creates a new mock instance.
+func NewMockContainerInterface(ctrl *gomock.Controller) *MockContainerInterface {
+	mock := &MockContainerInterface{ctrl: ctrl}
+	mock.recorder = &MockContainerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContainerInterface) EXPECT() *MockContainerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddTag mocks base method.
+func (m *MockContainerInterface) AddTag(ctx context.Context, value string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTag", ctx, value)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTag indicates an expected call of AddTag.
+func (mr *MockContainerInterfaceMockRecorder) AddTag(ctx, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockContainerInterface)(nil).AddTag), ctx, value)
+}
+
+// Clone mocks base method.
+func (m *MockContainerInterface) Clone(ctx context.Context, options *proxmox.ContainerCloneOptions) (int, *proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone", ctx, options)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*proxmox.Task)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockContainerInterfaceMockRecorder) Clone(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockContainerInterface)(nil).Clone), ctx, options)
+}
+
+// Config mocks base method.
+func (m *MockContainerInterface) Config(ctx context.Context, options ...proxmox.ContainerOption) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Config", varargs...)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Config indicates an expected call of Config.
+func (mr *MockContainerInterfaceMockRecorder) Config(ctx any, options ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Config", reflect.TypeOf((*MockContainerInterface)(nil).Config), varargs...)
+}
+
+// CurrentConfig mocks base method.
+func (m *MockContainerInterface) CurrentConfig() *proxmox.ContainerConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentConfig")
+	ret0, _ := ret[0].(*proxmox.ContainerConfig)
+	return ret0
+}
+
+// CurrentConfig indicates an expected call of CurrentConfig.
+func (mr *MockContainerInterfaceMockRecorder) CurrentConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentConfig", reflect.TypeOf((*MockContainerInterface)(nil).CurrentConfig))
+}
+
+// Delete mocks base method.
+func (m *MockContainerInterface) Delete(ctx context.Context, options *proxmox.ContainerDeleteOptions) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, options)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockContainerInterfaceMockRecorder) Delete(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockContainerInterface)(nil).Delete), ctx, options)
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockContainerInterface) DeleteSnapshot(ctx context.Context, name string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", ctx, name)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockContainerInterfaceMockRecorder) DeleteSnapshot(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockContainerInterface)(nil).DeleteSnapshot), ctx, name)
+}
+
+// Details mocks base method.
+func (m *MockContainerInterface) Details() interfaces.ContainerDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Details")
+	ret0, _ := ret[0].(interfaces.ContainerDetails)
+	return ret0
+}
+
+// Details indicates an expected call of Details.
+func (mr *MockContainerInterfaceMockRecorder) Details() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Details", reflect.TypeOf((*MockContainerInterface)(nil).Details))
+}
+
+// Interfaces mocks base method.
+func (m *MockContainerInterface) Interfaces(ctx context.Context) (proxmox.ContainerInterfaces, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Interfaces", ctx)
+	ret0, _ := ret[0].(proxmox.ContainerInterfaces)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Interfaces indicates an expected call of Interfaces.
+func (mr *MockContainerInterfaceMockRecorder) Interfaces(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interfaces", reflect.TypeOf((*MockContainerInterface)(nil).Interfaces), ctx)
+}
+
+// Migrate mocks base method.
+func (m *MockContainerInterface) Migrate(ctx context.Context, options *proxmox.ContainerMigrateOptions) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Migrate", ctx, options)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Migrate indicates an expected call of Migrate.
+func (mr *MockContainerInterfaceMockRecorder) Migrate(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockContainerInterface)(nil).Migrate), ctx, options)
+}
+
+// MigratePreconditions mocks base method.
+func (m *MockContainerInterface) MigratePreconditions(ctx context.Context, target string) (*proxmox.ContainerMigratePreconditions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigratePreconditions", ctx, target)
+	ret0, _ := ret[0].(*proxmox.ContainerMigratePreconditions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigratePreconditions indicates an expected call of MigratePreconditions.
+func (mr *MockContainerInterfaceMockRecorder) MigratePreconditions(ctx, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigratePreconditions", reflect.TypeOf((*MockContainerInterface)(nil).MigratePreconditions), ctx, target)
+}
+
+// MoveVolume mocks base method.
+func (m *MockContainerInterface) MoveVolume(ctx context.Context, options *interfaces.ContainerMoveVolumeOptions) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveVolume", ctx, options)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveVolume indicates an expected call of MoveVolume.
+func (mr *MockContainerInterfaceMockRecorder) MoveVolume(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveVolume", reflect.TypeOf((*MockContainerInterface)(nil).MoveVolume), ctx, options)
+}
+
+// NewSnapshot mocks base method.
+func (m *MockContainerInterface) NewSnapshot(ctx context.Context, name string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSnapshot", ctx, name)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewSnapshot indicates an expected call of NewSnapshot.
+func (mr *MockContainerInterfaceMockRecorder) NewSnapshot(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSnapshot", reflect.TypeOf((*MockContainerInterface)(nil).NewSnapshot), ctx, name)
+}
+
+// RRDData mocks base method.
+func (m *MockContainerInterface) RRDData(ctx context.Context, timeframe proxmox.Timeframe, cf ...proxmox.ConsolidationFunction) ([]*proxmox.RRDData, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, timeframe}
+	for _, a := range cf {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RRDData", varargs...)
+	ret0, _ := ret[0].([]*proxmox.RRDData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RRDData indicates an expected call of RRDData.
+func (mr *MockContainerInterfaceMockRecorder) RRDData(ctx, timeframe any, cf ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, timeframe}, cf...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RRDData", reflect.TypeOf((*MockContainerInterface)(nil).RRDData), varargs...)
+}
+
+// Reboot mocks base method.
+func (m *MockContainerInterface) Reboot(ctx context.Context) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reboot", ctx)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reboot indicates an expected call of Reboot.
+func (mr *MockContainerInterfaceMockRecorder) Reboot(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reboot", reflect.TypeOf((*MockContainerInterface)(nil).Reboot), ctx)
+}
+
+// RemoveTag mocks base method.
+func (m *MockContainerInterface) RemoveTag(ctx context.Context, value string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTag", ctx, value)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTag indicates an expected call of RemoveTag.
+func (mr *MockContainerInterfaceMockRecorder) RemoveTag(ctx, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTag", reflect.TypeOf((*MockContainerInterface)(nil).RemoveTag), ctx, value)
+}
+
+// Resize mocks base method.
+func (m *MockContainerInterface) Resize(ctx context.Context, disk, size string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resize", ctx, disk, size)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resize indicates an expected call of Resize.
+func (mr *MockContainerInterfaceMockRecorder) Resize(ctx, disk, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockContainerInterface)(nil).Resize), ctx, disk, size)
+}
+
+// Resume mocks base method.
+func (m *MockContainerInterface) Resume(ctx context.Context) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", ctx)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockContainerInterfaceMockRecorder) Resume(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockContainerInterface)(nil).Resume), ctx)
+}
+
+// RollbackSnapshot mocks base method.
+func (m *MockContainerInterface) RollbackSnapshot(ctx context.Context, name string, start bool) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackSnapshot", ctx, name, start)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackSnapshot indicates an expected call of RollbackSnapshot.
+func (mr *MockContainerInterfaceMockRecorder) RollbackSnapshot(ctx, name, start any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSnapshot", reflect.TypeOf((*MockContainerInterface)(nil).RollbackSnapshot), ctx, name, start)
+}
+
+// Shutdown mocks base method.
+func (m *MockContainerInterface) Shutdown(ctx context.Context, force bool, timeout int) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Shutdown", ctx, force, timeout)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Shutdown indicates an expected call of Shutdown.
+func (mr *MockContainerInterfaceMockRecorder) Shutdown(ctx, force, timeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockContainerInterface)(nil).Shutdown), ctx, force, timeout)
+}
+
+// Snapshots mocks base method.
+func (m *MockContainerInterface) Snapshots(ctx context.Context) ([]*proxmox.ContainerSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshots", ctx)
+	ret0, _ := ret[0].([]*proxmox.ContainerSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshots indicates an expected call of Snapshots.
+func (mr *MockContainerInterfaceMockRecorder) Snapshots(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshots", reflect.TypeOf((*MockContainerInterface)(nil).Snapshots), ctx)
+}
+
+// Start mocks base method.
+func (m *MockContainerInterface) Start(ctx context.Context) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockContainerInterfaceMockRecorder) Start(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockContainerInterface)(nil).Start), ctx)
+}
+
+// Stop mocks base method.
+func (m *MockContainerInterface) Stop(ctx context.Context) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockContainerInterfaceMockRecorder) Stop(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockContainerInterface)(nil).Stop), ctx)
+}
+
+// Suspend mocks base method.
+func (m *MockContainerInterface) Suspend(ctx context.Context) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", ctx)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockContainerInterfaceMockRecorder) Suspend(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockContainerInterface)(nil).Suspend), ctx)
+}
+
+// TermProxy mocks base method.
+func (m *MockContainerInterface) TermProxy(ctx context.Context) (*proxmox.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TermProxy", ctx)
+	ret0, _ := ret[0].(*proxmox.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TermProxy indicates an expected call of TermProxy.
+func (mr *MockContainerInterfaceMockRecorder) TermProxy(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TermProxy", reflect.TypeOf((*MockContainerInterface)(nil).TermProxy), ctx)
+}
+
+// TermWebSocket mocks base method.
+func (m *MockContainerInterface) TermWebSocket(term *proxmox.Term) (chan []byte, chan []byte, chan error, func() error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TermWebSocket", term)
+	ret0, _ := ret[0].(chan []byte)
+	ret1, _ := ret[1].(chan []byte)
+	ret2, _ := ret[2].(chan error)
+	ret3, _ := ret[3].(func() error)
+	ret4, _ := ret[4].(error)
+	return ret0, ret1, ret2, ret3, ret4
+}
+
+// TermWebSocket indicates an expected call of TermWebSocket.
+func (mr *MockContainerInterfaceMockRecorder) TermWebSocket(term any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TermWebSocket", reflect.TypeOf((*MockContainerInterface)(nil).TermWebSocket), term)
+}
+
+// MockVirtualMachineInterface is a mock of VirtualMachineInterface interface.
+type MockVirtualMachineInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockVirtualMachineInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockVirtualMachineInterfaceMockRecorder is the mock recorder for MockVirtualMachineInterface.
+type MockVirtualMachineInterfaceMockRecorder struct {
+	mock *MockVirtualMachineInterface
+}
+
+// NewMockVirtualMachineInterface creates a new mock instance.
+func NewMockVirtualMachineInterface(ctrl *gomock.Controller) *MockVirtualMachineInterface {
+	mock := &MockVirtualMachineInterface{ctrl: ctrl}
+	mock.recorder = &MockVirtualMachineInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVirtualMachineInterface) EXPECT() *MockVirtualMachineInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddTag mocks base method.
+func (m *MockVirtualMachineInterface) AddTag(ctx context.Context, value string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTag", ctx, value)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTag indicates an expected call of AddTag.
+func (mr *MockVirtualMachineInterfaceMockRecorder) AddTag(ctx, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockVirtualMachineInterface)(nil).AddTag), ctx, value)
+}
+
+// AgentExec mocks base method.
+func (m *MockVirtualMachineInterface) AgentExec(ctx context.Context, command []string, inputData string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AgentExec", ctx, command, inputData)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AgentExec indicates an expected call of AgentExec.
+func (mr *MockVirtualMachineInterfaceMockRecorder) AgentExec(ctx, command, inputData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AgentExec", reflect.TypeOf((*MockVirtualMachineInterface)(nil).AgentExec), ctx, command, inputData)
+}
+
+// AgentGetNetworkIFaces mocks base method.
+func (m *MockVirtualMachineInterface) AgentGetNetworkIFaces(ctx context.Context) ([]*proxmox.AgentNetworkIface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AgentGetNetworkIFaces", ctx)
+	ret0, _ := ret[0].([]*proxmox.AgentNetworkIface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AgentGetNetworkIFaces indicates an expected call of AgentGetNetworkIFaces.
+func (mr *MockVirtualMachineInterfaceMockRecorder) AgentGetNetworkIFaces(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AgentGetNetworkIFaces", reflect.TypeOf((*MockVirtualMachineInterface)(nil).AgentGetNetworkIFaces), ctx)
+}
+
+// Clone mocks base method.
+func (m *MockVirtualMachineInterface) Clone(ctx context.Context, options *proxmox.VirtualMachineCloneOptions) (int, *proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone", ctx, options)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*proxmox.Task)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Clone(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Clone), ctx, options)
+}
+
+// Config mocks base method.
+func (m *MockVirtualMachineInterface) Config(ctx context.Context, options ...proxmox.VirtualMachineOption) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Config", varargs...)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Config indicates an expected call of Config.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Config(ctx any, options ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Config", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Config), varargs...)
+}
+
+// CurrentConfig mocks base method.
+func (m *MockVirtualMachineInterface) CurrentConfig() *proxmox.VirtualMachineConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentConfig")
+	ret0, _ := ret[0].(*proxmox.VirtualMachineConfig)
+	return ret0
+}
+
+// CurrentConfig indicates an expected call of CurrentConfig.
+func (mr *MockVirtualMachineInterfaceMockRecorder) CurrentConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentConfig", reflect.TypeOf((*MockVirtualMachineInterface)(nil).CurrentConfig))
+}
+
+// Delete mocks base method.
+func (m *MockVirtualMachineInterface) Delete(ctx context.Context, options *proxmox.VirtualMachineDeleteOptions) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, options)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Delete(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Delete), ctx, options)
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockVirtualMachineInterface) DeleteSnapshot(ctx context.Context, name string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", ctx, name)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockVirtualMachineInterfaceMockRecorder) DeleteSnapshot(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockVirtualMachineInterface)(nil).DeleteSnapshot), ctx, name)
+}
+
+// Details mocks base method.
+func (m *MockVirtualMachineInterface) Details() interfaces.VirtualMachineDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Details")
+	ret0, _ := ret[0].(interfaces.VirtualMachineDetails)
+	return ret0
+}
+
+// Details indicates an expected call of Details.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Details() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Details", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Details))
+}
+
+// Migrate mocks base method.
+func (m *MockVirtualMachineInterface) Migrate(ctx context.Context, options *proxmox.VirtualMachineMigrateOptions) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Migrate", ctx, options)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Migrate indicates an expected call of Migrate.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Migrate(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Migrate), ctx, options)
+}
+
+// MigratePreconditions mocks base method.
+func (m *MockVirtualMachineInterface) MigratePreconditions(ctx context.Context, target string) (*proxmox.VirtualMachineMigratePreconditions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigratePreconditions", ctx, target)
+	ret0, _ := ret[0].(*proxmox.VirtualMachineMigratePreconditions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigratePreconditions indicates an expected call of MigratePreconditions.
+func (mr *MockVirtualMachineInterfaceMockRecorder) MigratePreconditions(ctx, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigratePreconditions", reflect.TypeOf((*MockVirtualMachineInterface)(nil).MigratePreconditions), ctx, target)
+}
+
+// NewSnapshot mocks base method.
+func (m *MockVirtualMachineInterface) NewSnapshot(ctx context.Context, name string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSnapshot", ctx, name)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewSnapshot indicates an expected call of NewSnapshot.
+func (mr *MockVirtualMachineInterfaceMockRecorder) NewSnapshot(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSnapshot", reflect.TypeOf((*MockVirtualMachineInterface)(nil).NewSnapshot), ctx, name)
+}
+
+// Pause mocks base method.
+func (m *MockVirtualMachineInterface) Pause(ctx context.Context) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", ctx)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Pause(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Pause), ctx)
+}
+
+// RRDData mocks base method.
+func (m *MockVirtualMachineInterface) RRDData(ctx context.Context, timeframe proxmox.Timeframe, cf ...proxmox.ConsolidationFunction) ([]*proxmox.RRDData, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, timeframe}
+	for _, a := range cf {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RRDData", varargs...)
+	ret0, _ := ret[0].([]*proxmox.RRDData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RRDData indicates an expected call of RRDData.
+func (mr *MockVirtualMachineInterfaceMockRecorder) RRDData(ctx, timeframe any, cf ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, timeframe}, cf...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RRDData", reflect.TypeOf((*MockVirtualMachineInterface)(nil).RRDData), varargs...)
+}
+
+// Reboot mocks base method.
+func (m *MockVirtualMachineInterface) Reboot(ctx context.Context) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reboot", ctx)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reboot indicates an expected call of Reboot.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Reboot(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reboot", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Reboot), ctx)
+}
+
+// RemoveTag mocks base method.
+func (m *MockVirtualMachineInterface) RemoveTag(ctx context.Context, value string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTag", ctx, value)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTag indicates an expected call of RemoveTag.
+func (mr *MockVirtualMachineInterfaceMockRecorder) RemoveTag(ctx, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTag", reflect.TypeOf((*MockVirtualMachineInterface)(nil).RemoveTag), ctx, value)
+}
+
+// ResizeDisk mocks base method.
+func (m *MockVirtualMachineInterface) ResizeDisk(ctx context.Context, disk, size string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeDisk", ctx, disk, size)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeDisk indicates an expected call of ResizeDisk.
+func (mr *MockVirtualMachineInterfaceMockRecorder) ResizeDisk(ctx, disk, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeDisk", reflect.TypeOf((*MockVirtualMachineInterface)(nil).ResizeDisk), ctx, disk, size)
+}
+
+// Resume mocks base method.
+func (m *MockVirtualMachineInterface) Resume(ctx context.Context) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", ctx)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Resume(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Resume), ctx)
+}
+
+// RollbackSnapshot mocks base method.
+func (m *MockVirtualMachineInterface) RollbackSnapshot(ctx context.Context, name string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackSnapshot", ctx, name)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackSnapshot indicates an expected call of RollbackSnapshot.
+func (mr *MockVirtualMachineInterfaceMockRecorder) RollbackSnapshot(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSnapshot", reflect.TypeOf((*MockVirtualMachineInterface)(nil).RollbackSnapshot), ctx, name)
+}
+
+// Shutdown mocks base method.
+func (m *MockVirtualMachineInterface) Shutdown(ctx context.Context) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Shutdown", ctx)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Shutdown indicates an expected call of Shutdown.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Shutdown(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Shutdown), ctx)
+}
+
+// Snapshots mocks base method.
+func (m *MockVirtualMachineInterface) Snapshots(ctx context.Context) ([]*proxmox.VirtualMachineSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshots", ctx)
+	ret0, _ := ret[0].([]*proxmox.VirtualMachineSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshots indicates an expected call of Snapshots.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Snapshots(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshots", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Snapshots), ctx)
+}
+
+// SpiceProxy mocks base method.
+func (m *MockVirtualMachineInterface) SpiceProxy(ctx context.Context) (*proxmox.SpiceProxy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpiceProxy", ctx)
+	ret0, _ := ret[0].(*proxmox.SpiceProxy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpiceProxy indicates an expected call of SpiceProxy.
+func (mr *MockVirtualMachineInterfaceMockRecorder) SpiceProxy(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpiceProxy", reflect.TypeOf((*MockVirtualMachineInterface)(nil).SpiceProxy), ctx)
+}
+
+// Start mocks base method.
+func (m *MockVirtualMachineInterface) Start(ctx context.Context) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Start(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Start), ctx)
+}
+
+// Stop mocks base method.
+func (m *MockVirtualMachineInterface) Stop(ctx context.Context) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Stop(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Stop), ctx)
+}
+
+// TermProxy mocks base method.
+func (m *MockVirtualMachineInterface) TermProxy(ctx context.Context) (*proxmox.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TermProxy", ctx)
+	ret0, _ := ret[0].(*proxmox.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TermProxy indicates an expected call of TermProxy.
+func (mr *MockVirtualMachineInterfaceMockRecorder) TermProxy(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TermProxy", reflect.TypeOf((*MockVirtualMachineInterface)(nil).TermProxy), ctx)
+}
+
+// TermWebSocket mocks base method.
+func (m *MockVirtualMachineInterface) TermWebSocket(term *proxmox.Term) (chan []byte, chan []byte, chan error, func() error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TermWebSocket", term)
+	ret0, _ := ret[0].(chan []byte)
+	ret1, _ := ret[1].(chan []byte)
+	ret2, _ := ret[2].(chan error)
+	ret3, _ := ret[3].(func() error)
+	ret4, _ := ret[4].(error)
+	return ret0, ret1, ret2, ret3, ret4
+}
+
+// TermWebSocket indicates an expected call of TermWebSocket.
+func (mr *MockVirtualMachineInterfaceMockRecorder) TermWebSocket(term any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TermWebSocket", reflect.TypeOf((*MockVirtualMachineInterface)(nil).TermWebSocket), term)
 }
 
-// WaitForAgent mocks base method.
-func (m *MockVirtualMachineInterface) WaitForAgent(ctx context.Context, seconds int) error {
+// VNCProxy mocks base method.
+func (m *MockVirtualMachineInterface) VNCProxy(ctx context.Context, config *proxmox.VNCConfig) (*proxmox.VNC, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VNCProxy", ctx, config)
+	ret0, _ := ret[0].(*proxmox.VNC)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VNCProxy indicates an expected call of VNCProxy.
+func (mr *MockVirtualMachineInterfaceMockRecorder) VNCProxy(ctx, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VNCProxy", reflect.TypeOf((*MockVirtualMachineInterface)(nil).VNCProxy), ctx, config)
+}
+
+// VNCWebSocket mocks base method.
+func (m *MockVirtualMachineInterface) VNCWebSocket(vnc *proxmox.VNC) (chan []byte, chan []byte, chan error, func() error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VNCWebSocket", vnc)
+	ret0, _ := ret[0].(chan []byte)
+	ret1, _ := ret[1].(chan []byte)
+	ret2, _ := ret[2].(chan error)
+	ret3, _ := ret[3].(func() error)
+	ret4, _ := ret[4].(error)
+	return ret0, ret1, ret2, ret3, ret4
+}
+
+// VNCWebSocket indicates an expected call of VNCWebSocket.
+func (mr *MockVirtualMachineInterfaceMockRecorder) VNCWebSocket(vnc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VNCWebSocket", reflect.TypeOf((*MockVirtualMachineInterface)(nil).VNCWebSocket), vnc)
+}
+
+// WaitForAgent mocks base method.
+func (m *MockVirtualMachineInterface) WaitForAgent(ctx context.Context, seconds int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForAgent", ctx, seconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForAgent indicates an expected call of WaitForAgent.
+func (mr *MockVirtualMachineInterfaceMockRecorder) WaitForAgent(ctx, seconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForAgent", reflect.TypeOf((*MockVirtualMachineInterface)(nil).WaitForAgent), ctx, seconds)
+}
+
+// WaitForAgentExecExit mocks base method.
+func (m *MockVirtualMachineInterface) WaitForAgentExecExit(ctx context.Context, pid, seconds int) (*proxmox.AgentExecStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForAgentExecExit", ctx, pid, seconds)
+	ret0, _ := ret[0].(*proxmox.AgentExecStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForAgentExecExit indicates an expected call of WaitForAgentExecExit.
+func (mr *MockVirtualMachineInterfaceMockRecorder) WaitForAgentExecExit(ctx, pid, seconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForAgentExecExit", reflect.TypeOf((*MockVirtualMachineInterface)(nil).WaitForAgentExecExit), ctx, pid, seconds)
+}
+
+// MockClusterInterface is a mock of ClusterInterface interface.
+type MockClusterInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockClusterInterfaceMockRecorder is the mock recorder for MockClusterInterface.
+type MockClusterInterfaceMockRecorder struct {
+	mock *MockClusterInterface
+}
+
+// NewMockClusterInterface creates a new mock instance.
+func NewMockClusterInterface(ctrl *gomock.Controller) *MockClusterInterface {
+	mock := &MockClusterInterface{ctrl: ctrl}
+	mock.recorder = &MockClusterInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterInterface) EXPECT() *MockClusterInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ACMEAccount mocks base method.
+func (m *MockClusterInterface) ACMEAccount(ctx context.Context, name string) (*proxmox.ACMEAccount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WaitForAgent", ctx, seconds)
+	ret := m.ctrl.Call(m, "ACMEAccount", ctx, name)
+	ret0, _ := ret[0].(*proxmox.ACMEAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ACMEAccount indicates an expected call of ACMEAccount.
+func (mr *MockClusterInterfaceMockRecorder) ACMEAccount(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ACMEAccount", reflect.TypeOf((*MockClusterInterface)(nil).ACMEAccount), ctx, name)
+}
+
+// ACMEAccounts mocks base method.
+func (m *MockClusterInterface) ACMEAccounts(ctx context.Context) ([]*proxmox.ACMEAccountIndex, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ACMEAccounts", ctx)
+	ret0, _ := ret[0].([]*proxmox.ACMEAccountIndex)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ACMEAccounts indicates an expected call of ACMEAccounts.
+func (mr *MockClusterInterfaceMockRecorder) ACMEAccounts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ACMEAccounts", reflect.TypeOf((*MockClusterInterface)(nil).ACMEAccounts), ctx)
+}
+
+// ACMETermsOfService mocks base method.
+func (m *MockClusterInterface) ACMETermsOfService(ctx context.Context, directory string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ACMETermsOfService", ctx, directory)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ACMETermsOfService indicates an expected call of ACMETermsOfService.
+func (mr *MockClusterInterfaceMockRecorder) ACMETermsOfService(ctx, directory any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ACMETermsOfService", reflect.TypeOf((*MockClusterInterface)(nil).ACMETermsOfService), ctx, directory)
+}
+
+// DeleteACMEAccount mocks base method.
+func (m *MockClusterInterface) DeleteACMEAccount(ctx context.Context, name string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteACMEAccount", ctx, name)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteACMEAccount indicates an expected call of DeleteACMEAccount.
+func (mr *MockClusterInterfaceMockRecorder) DeleteACMEAccount(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteACMEAccount", reflect.TypeOf((*MockClusterInterface)(nil).DeleteACMEAccount), ctx, name)
+}
+
+// DeleteHAGroup mocks base method.
+func (m *MockClusterInterface) DeleteHAGroup(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHAGroup", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHAGroup indicates an expected call of DeleteHAGroup.
+func (mr *MockClusterInterfaceMockRecorder) DeleteHAGroup(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHAGroup", reflect.TypeOf((*MockClusterInterface)(nil).DeleteHAGroup), ctx, name)
+}
+
+// DeleteHAResource mocks base method.
+func (m *MockClusterInterface) DeleteHAResource(ctx context.Context, sid string, purge bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHAResource", ctx, sid, purge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHAResource indicates an expected call of DeleteHAResource.
+func (mr *MockClusterInterfaceMockRecorder) DeleteHAResource(ctx, sid, purge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHAResource", reflect.TypeOf((*MockClusterInterface)(nil).DeleteHAResource), ctx, sid, purge)
+}
+
+// DeleteHARule mocks base method.
+func (m *MockClusterInterface) DeleteHARule(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHARule", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHARule indicates an expected call of DeleteHARule.
+func (mr *MockClusterInterfaceMockRecorder) DeleteHARule(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHARule", reflect.TypeOf((*MockClusterInterface)(nil).DeleteHARule), ctx, name)
+}
+
+// HAGroups mocks base method.
+func (m *MockClusterInterface) HAGroups(ctx context.Context) ([]*proxmox.HAGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HAGroups", ctx)
+	ret0, _ := ret[0].([]*proxmox.HAGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HAGroups indicates an expected call of HAGroups.
+func (mr *MockClusterInterfaceMockRecorder) HAGroups(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HAGroups", reflect.TypeOf((*MockClusterInterface)(nil).HAGroups), ctx)
+}
+
+// HAResources mocks base method.
+func (m *MockClusterInterface) HAResources(ctx context.Context, resourceType string) ([]*proxmox.HAResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HAResources", ctx, resourceType)
+	ret0, _ := ret[0].([]*proxmox.HAResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HAResources indicates an expected call of HAResources.
+func (mr *MockClusterInterfaceMockRecorder) HAResources(ctx, resourceType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HAResources", reflect.TypeOf((*MockClusterInterface)(nil).HAResources), ctx, resourceType)
+}
+
+// HARules mocks base method.
+func (m *MockClusterInterface) HARules(ctx context.Context) ([]*proxmox.HARule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HARules", ctx)
+	ret0, _ := ret[0].([]*proxmox.HARule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HARules indicates an expected call of HARules.
+func (mr *MockClusterInterfaceMockRecorder) HARules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HARules", reflect.TypeOf((*MockClusterInterface)(nil).HARules), ctx)
+}
+
+// HAStatus mocks base method.
+func (m *MockClusterInterface) HAStatus(ctx context.Context) ([]*proxmox.HAStatusEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HAStatus", ctx)
+	ret0, _ := ret[0].([]*proxmox.HAStatusEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HAStatus indicates an expected call of HAStatus.
+func (mr *MockClusterInterfaceMockRecorder) HAStatus(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HAStatus", reflect.TypeOf((*MockClusterInterface)(nil).HAStatus), ctx)
+}
+
+// MigrateHAResource mocks base method.
+func (m *MockClusterInterface) MigrateHAResource(ctx context.Context, sid, node string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateHAResource", ctx, sid, node)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateHAResource indicates an expected call of MigrateHAResource.
+func (mr *MockClusterInterfaceMockRecorder) MigrateHAResource(ctx, sid, node any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateHAResource", reflect.TypeOf((*MockClusterInterface)(nil).MigrateHAResource), ctx, sid, node)
+}
+
+// NewACMEAccount mocks base method.
+func (m *MockClusterInterface) NewACMEAccount(ctx context.Context, options *proxmox.ACMEAccountOptions) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewACMEAccount", ctx, options)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewACMEAccount indicates an expected call of NewACMEAccount.
+func (mr *MockClusterInterfaceMockRecorder) NewACMEAccount(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewACMEAccount", reflect.TypeOf((*MockClusterInterface)(nil).NewACMEAccount), ctx, options)
+}
+
+// NewHAGroup mocks base method.
+func (m *MockClusterInterface) NewHAGroup(ctx context.Context, options *proxmox.HAGroupCreateOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewHAGroup", ctx, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewHAGroup indicates an expected call of NewHAGroup.
+func (mr *MockClusterInterfaceMockRecorder) NewHAGroup(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewHAGroup", reflect.TypeOf((*MockClusterInterface)(nil).NewHAGroup), ctx, options)
+}
+
+// NewHAResource mocks base method.
+func (m *MockClusterInterface) NewHAResource(ctx context.Context, options *proxmox.HAResourceCreateOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewHAResource", ctx, options)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// WaitForAgent indicates an expected call of WaitForAgent.
-func (mr *MockVirtualMachineInterfaceMockRecorder) WaitForAgent(ctx, seconds any) *gomock.Call {
+// NewHAResource indicates an expected call of NewHAResource.
+func (mr *MockClusterInterfaceMockRecorder) NewHAResource(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewHAResource", reflect.TypeOf((*MockClusterInterface)(nil).NewHAResource), ctx, options)
+}
+
+// NewHARule mocks base method.
+func (m *MockClusterInterface) NewHARule(ctx context.Context, options *proxmox.HARuleCreateOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewHARule", ctx, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewHARule indicates an expected call of NewHARule.
+func (mr *MockClusterInterfaceMockRecorder) NewHARule(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewHARule", reflect.TypeOf((*MockClusterInterface)(nil).NewHARule), ctx, options)
+}
+
+// NextID mocks base method.
+func (m *MockClusterInterface) NextID(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextID", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextID indicates an expected call of NextID.
+func (mr *MockClusterInterfaceMockRecorder) NextID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextID", reflect.TypeOf((*MockClusterInterface)(nil).NextID), ctx)
+}
+
+// PCIMappings mocks base method.
+func (m *MockClusterInterface) PCIMappings(ctx context.Context, checkNode string) (proxmox.ClusterPCIMappings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PCIMappings", ctx, checkNode)
+	ret0, _ := ret[0].(proxmox.ClusterPCIMappings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PCIMappings indicates an expected call of PCIMappings.
+func (mr *MockClusterInterfaceMockRecorder) PCIMappings(ctx, checkNode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PCIMappings", reflect.TypeOf((*MockClusterInterface)(nil).PCIMappings), ctx, checkNode)
+}
+
+// Quorate mocks base method.
+func (m *MockClusterInterface) Quorate(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quorate", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quorate indicates an expected call of Quorate.
+func (mr *MockClusterInterfaceMockRecorder) Quorate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quorate", reflect.TypeOf((*MockClusterInterface)(nil).Quorate), ctx)
+}
+
+// RelocateHAResource mocks base method.
+func (m *MockClusterInterface) RelocateHAResource(ctx context.Context, sid, node string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelocateHAResource", ctx, sid, node)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RelocateHAResource indicates an expected call of RelocateHAResource.
+func (mr *MockClusterInterfaceMockRecorder) RelocateHAResource(ctx, sid, node any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelocateHAResource", reflect.TypeOf((*MockClusterInterface)(nil).RelocateHAResource), ctx, sid, node)
+}
+
+// Resources mocks base method.
+func (m *MockClusterInterface) Resources(ctx context.Context, filters ...string) (proxmox.ClusterResources, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Resources", varargs...)
+	ret0, _ := ret[0].(proxmox.ClusterResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources.
+func (mr *MockClusterInterfaceMockRecorder) Resources(ctx any, filters ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockClusterInterface)(nil).Resources), varargs...)
+}
+
+// SDNApply mocks base method.
+func (m *MockClusterInterface) SDNApply(ctx context.Context) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SDNApply", ctx)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SDNApply indicates an expected call of SDNApply.
+func (mr *MockClusterInterfaceMockRecorder) SDNApply(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SDNApply", reflect.TypeOf((*MockClusterInterface)(nil).SDNApply), ctx)
+}
+
+// SDNControllers mocks base method.
+func (m *MockClusterInterface) SDNControllers(ctx context.Context) ([]*proxmox.SDNController, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SDNControllers", ctx)
+	ret0, _ := ret[0].([]*proxmox.SDNController)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SDNControllers indicates an expected call of SDNControllers.
+func (mr *MockClusterInterfaceMockRecorder) SDNControllers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SDNControllers", reflect.TypeOf((*MockClusterInterface)(nil).SDNControllers), ctx)
+}
+
+// SDNDryRun mocks base method.
+func (m *MockClusterInterface) SDNDryRun(ctx context.Context, node string) (*proxmox.SDNDryRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SDNDryRun", ctx, node)
+	ret0, _ := ret[0].(*proxmox.SDNDryRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SDNDryRun indicates an expected call of SDNDryRun.
+func (mr *MockClusterInterfaceMockRecorder) SDNDryRun(ctx, node any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SDNDryRun", reflect.TypeOf((*MockClusterInterface)(nil).SDNDryRun), ctx, node)
+}
+
+// SDNIPAMStatus mocks base method.
+func (m *MockClusterInterface) SDNIPAMStatus(ctx context.Context, ipam string) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SDNIPAMStatus", ctx, ipam)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SDNIPAMStatus indicates an expected call of SDNIPAMStatus.
+func (mr *MockClusterInterfaceMockRecorder) SDNIPAMStatus(ctx, ipam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SDNIPAMStatus", reflect.TypeOf((*MockClusterInterface)(nil).SDNIPAMStatus), ctx, ipam)
+}
+
+// SDNIPAMs mocks base method.
+func (m *MockClusterInterface) SDNIPAMs(ctx context.Context) ([]*proxmox.SDNIPAM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SDNIPAMs", ctx)
+	ret0, _ := ret[0].([]*proxmox.SDNIPAM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SDNIPAMs indicates an expected call of SDNIPAMs.
+func (mr *MockClusterInterfaceMockRecorder) SDNIPAMs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SDNIPAMs", reflect.TypeOf((*MockClusterInterface)(nil).SDNIPAMs), ctx)
+}
+
+// SDNPending mocks base method.
+func (m *MockClusterInterface) SDNPending(ctx context.Context, path string) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SDNPending", ctx, path)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SDNPending indicates an expected call of SDNPending.
+func (mr *MockClusterInterfaceMockRecorder) SDNPending(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SDNPending", reflect.TypeOf((*MockClusterInterface)(nil).SDNPending), ctx, path)
+}
+
+// SDNSubnets mocks base method.
+func (m *MockClusterInterface) SDNSubnets(ctx context.Context, vnet string) ([]*proxmox.VNetSubnet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SDNSubnets", ctx, vnet)
+	ret0, _ := ret[0].([]*proxmox.VNetSubnet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SDNSubnets indicates an expected call of SDNSubnets.
+func (mr *MockClusterInterfaceMockRecorder) SDNSubnets(ctx, vnet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SDNSubnets", reflect.TypeOf((*MockClusterInterface)(nil).SDNSubnets), ctx, vnet)
+}
+
+// SDNVNets mocks base method.
+func (m *MockClusterInterface) SDNVNets(ctx context.Context) ([]*proxmox.VNet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SDNVNets", ctx)
+	ret0, _ := ret[0].([]*proxmox.VNet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SDNVNets indicates an expected call of SDNVNets.
+func (mr *MockClusterInterfaceMockRecorder) SDNVNets(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForAgent", reflect.TypeOf((*MockVirtualMachineInterface)(nil).WaitForAgent), ctx, seconds)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SDNVNets", reflect.TypeOf((*MockClusterInterface)(nil).SDNVNets), ctx)
 }
 
-// WaitForAgentExecExit mocks base method.
-func (m *MockVirtualMachineInterface) WaitForAgentExecExit(ctx context.Context, pid, seconds int) (*proxmox.AgentExecStatus, error) {
+// SDNZones mocks base method.
+func (m *MockClusterInterface) SDNZones(ctx context.Context) ([]*proxmox.SDNZone, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WaitForAgentExecExit", ctx, pid, seconds)
-	ret0, _ := ret[0].(*proxmox.AgentExecStatus)
+	ret := m.ctrl.Call(m, "SDNZones", ctx)
+	ret0, _ := ret[0].([]*proxmox.SDNZone)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// WaitForAgentExecExit indicates an expected call of WaitForAgentExecExit.
-func (mr *MockVirtualMachineInterfaceMockRecorder) WaitForAgentExecExit(ctx, pid, seconds any) *gomock.Call {
+// SDNZones indicates an expected call of SDNZones.
+func (mr *MockClusterInterfaceMockRecorder) SDNZones(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForAgentExecExit", reflect.TypeOf((*MockVirtualMachineInterface)(nil).WaitForAgentExecExit), ctx, pid, seconds)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SDNZones", reflect.TypeOf((*MockClusterInterface)(nil).SDNZones), ctx)
 }
 
-// MockClusterInterface is a mock of ClusterInterface interface.
-type MockClusterInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockClusterInterfaceMockRecorder
-	isgomock struct{}
+// USBMappings mocks base method.
+func (m *MockClusterInterface) USBMappings(ctx context.Context, checkNode string) (proxmox.ClusterUSBMappings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "USBMappings", ctx, checkNode)
+	ret0, _ := ret[0].(proxmox.ClusterUSBMappings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockClusterInterfaceMockRecorder is the mock recorder for MockClusterInterface.
-type MockClusterInterfaceMockRecorder struct {
-	mock *MockClusterInterface
+// USBMappings indicates an expected call of USBMappings.
+func (mr *MockClusterInterfaceMockRecorder) USBMappings(ctx, checkNode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "USBMappings", reflect.TypeOf((*MockClusterInterface)(nil).USBMappings), ctx, checkNode)
 }
 
-// NewMockClusterInterface creates a new mock instance.
-func NewMockClusterInterface(ctrl *gomock.Controller) *MockClusterInterface {
-	mock := &MockClusterInterface{ctrl: ctrl}
-	mock.recorder = &MockClusterInterfaceMockRecorder{mock}
-	return mock
+// UpdateHAGroup mocks base method.
+func (m *MockClusterInterface) UpdateHAGroup(ctx context.Context, name string, options *proxmox.HAGroupUpdateOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHAGroup", ctx, name, options)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockClusterInterface) EXPECT() *MockClusterInterfaceMockRecorder {
-	return m.recorder
+// UpdateHAGroup indicates an expected call of UpdateHAGroup.
+func (mr *MockClusterInterfaceMockRecorder) UpdateHAGroup(ctx, name, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHAGroup", reflect.TypeOf((*MockClusterInterface)(nil).UpdateHAGroup), ctx, name, options)
 }
 
-// NextID mocks base method.
-func (m *MockClusterInterface) NextID(ctx context.Context) (int, error) {
+// UpdateHAResource mocks base method.
+func (m *MockClusterInterface) UpdateHAResource(ctx context.Context, sid string, options *proxmox.HAResourceUpdateOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NextID", ctx)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateHAResource", ctx, sid, options)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// NextID indicates an expected call of NextID.
-func (mr *MockClusterInterfaceMockRecorder) NextID(ctx any) *gomock.Call {
+// UpdateHAResource indicates an expected call of UpdateHAResource.
+func (mr *MockClusterInterfaceMockRecorder) UpdateHAResource(ctx, sid, options any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextID", reflect.TypeOf((*MockClusterInterface)(nil).NextID), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHAResource", reflect.TypeOf((*MockClusterInterface)(nil).UpdateHAResource), ctx, sid, options)
 }
 
-// Resources mocks base method.
-func (m *MockClusterInterface) Resources(ctx context.Context, filters ...string) (proxmox.ClusterResources, error) {
+// UpdateHARule mocks base method.
+func (m *MockClusterInterface) UpdateHARule(ctx context.Context, name string, options *proxmox.HARuleUpdateOption) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range filters {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Resources", varargs...)
-	ret0, _ := ret[0].(proxmox.ClusterResources)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateHARule", ctx, name, options)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Resources indicates an expected call of Resources.
-func (mr *MockClusterInterfaceMockRecorder) Resources(ctx any, filters ...any) *gomock.Call {
+// UpdateHARule indicates an expected call of UpdateHARule.
+func (mr *MockClusterInterfaceMockRecorder) UpdateHARule(ctx, name, options any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, filters...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockClusterInterface)(nil).Resources), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHARule", reflect.TypeOf((*MockClusterInterface)(nil).UpdateHARule), ctx, name, options)
 }
 
 // MockStorageInterface is a mock of StorageInterface interface.
@@ -1237,6 +2792,36 @@ func (m *MockStorageInterface) EXPECT() *MockStorageInterfaceMockRecorder {
 	return m.recorder
 }
 
+// CopyContent mocks base method.
+func (m *MockStorageInterface) CopyContent(ctx context.Context, sourceVolume, targetVolume, targetNode string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyContent", ctx, sourceVolume, targetVolume, targetNode)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyContent indicates an expected call of CopyContent.
+func (mr *MockStorageInterfaceMockRecorder) CopyContent(ctx, sourceVolume, targetVolume, targetNode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyContent", reflect.TypeOf((*MockStorageInterface)(nil).CopyContent), ctx, sourceVolume, targetVolume, targetNode)
+}
+
+// DeleteContent mocks base method.
+func (m *MockStorageInterface) DeleteContent(ctx context.Context, volume string) (*proxmox.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContent", ctx, volume)
+	ret0, _ := ret[0].(*proxmox.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteContent indicates an expected call of DeleteContent.
+func (mr *MockStorageInterfaceMockRecorder) DeleteContent(ctx, volume any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContent", reflect.TypeOf((*MockStorageInterface)(nil).DeleteContent), ctx, volume)
+}
+
 // GetContent mocks base method.
 func (m *MockStorageInterface) GetContent(ctx context.Context) ([]*proxmox.StorageContent, error) {
 	m.ctrl.T.Helper()
@@ -1251,3 +2836,314 @@ func (mr *MockStorageInterfaceMockRecorder) GetContent(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContent", reflect.TypeOf((*MockStorageInterface)(nil).GetContent), ctx)
 }
+
+// MockFirewallInterface is a mock of FirewallInterface interface.
+type MockFirewallInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockFirewallInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockFirewallInterfaceMockRecorder is the mock recorder for MockFirewallInterface.
+type MockFirewallInterfaceMockRecorder struct {
+	mock *MockFirewallInterface
+}
+
+// NewMockFirewallInterface creates a new mock instance.
+func NewMockFirewallInterface(ctrl *gomock.Controller) *MockFirewallInterface {
+	mock := &MockFirewallInterface{ctrl: ctrl}
+	mock.recorder = &MockFirewallInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFirewallInterface) EXPECT() *MockFirewallInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddIPSetEntry mocks base method.
+func (m *MockFirewallInterface) AddIPSetEntry(ctx context.Context, name string, entry *proxmox.FirewallIPSetEntryCreationOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddIPSetEntry", ctx, name, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddIPSetEntry indicates an expected call of AddIPSetEntry.
+func (mr *MockFirewallInterfaceMockRecorder) AddIPSetEntry(ctx, name, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIPSetEntry", reflect.TypeOf((*MockFirewallInterface)(nil).AddIPSetEntry), ctx, name, entry)
+}
+
+// Aliases mocks base method.
+func (m *MockFirewallInterface) Aliases(ctx context.Context) ([]*proxmox.FirewallAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aliases", ctx)
+	ret0, _ := ret[0].([]*proxmox.FirewallAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aliases indicates an expected call of Aliases.
+func (mr *MockFirewallInterfaceMockRecorder) Aliases(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aliases", reflect.TypeOf((*MockFirewallInterface)(nil).Aliases), ctx)
+}
+
+// DeleteAlias mocks base method.
+func (m *MockFirewallInterface) DeleteAlias(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlias", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlias indicates an expected call of DeleteAlias.
+func (mr *MockFirewallInterfaceMockRecorder) DeleteAlias(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlias", reflect.TypeOf((*MockFirewallInterface)(nil).DeleteAlias), ctx, name)
+}
+
+// DeleteIPSet mocks base method.
+func (m *MockFirewallInterface) DeleteIPSet(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIPSet", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIPSet indicates an expected call of DeleteIPSet.
+func (mr *MockFirewallInterfaceMockRecorder) DeleteIPSet(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIPSet", reflect.TypeOf((*MockFirewallInterface)(nil).DeleteIPSet), ctx, name)
+}
+
+// DeleteIPSetEntry mocks base method.
+func (m *MockFirewallInterface) DeleteIPSetEntry(ctx context.Context, name, cidr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIPSetEntry", ctx, name, cidr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIPSetEntry indicates an expected call of DeleteIPSetEntry.
+func (mr *MockFirewallInterfaceMockRecorder) DeleteIPSetEntry(ctx, name, cidr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIPSetEntry", reflect.TypeOf((*MockFirewallInterface)(nil).DeleteIPSetEntry), ctx, name, cidr)
+}
+
+// DeleteRule mocks base method.
+func (m *MockFirewallInterface) DeleteRule(ctx context.Context, pos int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", ctx, pos)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockFirewallInterfaceMockRecorder) DeleteRule(ctx, pos any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockFirewallInterface)(nil).DeleteRule), ctx, pos)
+}
+
+// DeleteSecurityGroup mocks base method.
+func (m *MockFirewallInterface) DeleteSecurityGroup(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecurityGroup", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecurityGroup indicates an expected call of DeleteSecurityGroup.
+func (mr *MockFirewallInterfaceMockRecorder) DeleteSecurityGroup(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockFirewallInterface)(nil).DeleteSecurityGroup), ctx, name)
+}
+
+// IPSetEntries mocks base method.
+func (m *MockFirewallInterface) IPSetEntries(ctx context.Context, name string) ([]*proxmox.FirewallIPSetEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IPSetEntries", ctx, name)
+	ret0, _ := ret[0].([]*proxmox.FirewallIPSetEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IPSetEntries indicates an expected call of IPSetEntries.
+func (mr *MockFirewallInterfaceMockRecorder) IPSetEntries(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IPSetEntries", reflect.TypeOf((*MockFirewallInterface)(nil).IPSetEntries), ctx, name)
+}
+
+// IPSets mocks base method.
+func (m *MockFirewallInterface) IPSets(ctx context.Context) ([]*proxmox.FirewallIPSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IPSets", ctx)
+	ret0, _ := ret[0].([]*proxmox.FirewallIPSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IPSets indicates an expected call of IPSets.
+func (mr *MockFirewallInterfaceMockRecorder) IPSets(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IPSets", reflect.TypeOf((*MockFirewallInterface)(nil).IPSets), ctx)
+}
+
+// Log mocks base method.
+func (m *MockFirewallInterface) Log(ctx context.Context, options *proxmox.NodeFirewallLogOptions) ([]*proxmox.LogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Log", ctx, options)
+	ret0, _ := ret[0].([]*proxmox.LogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockFirewallInterfaceMockRecorder) Log(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockFirewallInterface)(nil).Log), ctx, options)
+}
+
+// NewAlias mocks base method.
+func (m *MockFirewallInterface) NewAlias(ctx context.Context, alias *proxmox.FirewallAliasCreateOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAlias", ctx, alias)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewAlias indicates an expected call of NewAlias.
+func (mr *MockFirewallInterfaceMockRecorder) NewAlias(ctx, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAlias", reflect.TypeOf((*MockFirewallInterface)(nil).NewAlias), ctx, alias)
+}
+
+// NewIPSet mocks base method.
+func (m *MockFirewallInterface) NewIPSet(ctx context.Context, name, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewIPSet", ctx, name, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewIPSet indicates an expected call of NewIPSet.
+func (mr *MockFirewallInterfaceMockRecorder) NewIPSet(ctx, name, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewIPSet", reflect.TypeOf((*MockFirewallInterface)(nil).NewIPSet), ctx, name, comment)
+}
+
+// NewRule mocks base method.
+func (m *MockFirewallInterface) NewRule(ctx context.Context, params map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRule", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewRule indicates an expected call of NewRule.
+func (mr *MockFirewallInterfaceMockRecorder) NewRule(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRule", reflect.TypeOf((*MockFirewallInterface)(nil).NewRule), ctx, params)
+}
+
+// NewSecurityGroup mocks base method.
+func (m *MockFirewallInterface) NewSecurityGroup(ctx context.Context, name, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSecurityGroup", ctx, name, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewSecurityGroup indicates an expected call of NewSecurityGroup.
+func (mr *MockFirewallInterfaceMockRecorder) NewSecurityGroup(ctx, name, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSecurityGroup", reflect.TypeOf((*MockFirewallInterface)(nil).NewSecurityGroup), ctx, name, comment)
+}
+
+// Options mocks base method.
+func (m *MockFirewallInterface) Options(ctx context.Context) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Options", ctx)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Options indicates an expected call of Options.
+func (mr *MockFirewallInterfaceMockRecorder) Options(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Options", reflect.TypeOf((*MockFirewallInterface)(nil).Options), ctx)
+}
+
+// Rules mocks base method.
+func (m *MockFirewallInterface) Rules(ctx context.Context) ([]*proxmox.FirewallRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rules", ctx)
+	ret0, _ := ret[0].([]*proxmox.FirewallRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rules indicates an expected call of Rules.
+func (mr *MockFirewallInterfaceMockRecorder) Rules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rules", reflect.TypeOf((*MockFirewallInterface)(nil).Rules), ctx)
+}
+
+// SecurityGroups mocks base method.
+func (m *MockFirewallInterface) SecurityGroups(ctx context.Context) ([]*proxmox.FirewallSecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecurityGroups", ctx)
+	ret0, _ := ret[0].([]*proxmox.FirewallSecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecurityGroups indicates an expected call of SecurityGroups.
+func (mr *MockFirewallInterfaceMockRecorder) SecurityGroups(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroups", reflect.TypeOf((*MockFirewallInterface)(nil).SecurityGroups), ctx)
+}
+
+// UpdateAlias mocks base method.
+func (m *MockFirewallInterface) UpdateAlias(ctx context.Context, name, cidr, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlias", ctx, name, cidr, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlias indicates an expected call of UpdateAlias.
+func (mr *MockFirewallInterfaceMockRecorder) UpdateAlias(ctx, name, cidr, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlias", reflect.TypeOf((*MockFirewallInterface)(nil).UpdateAlias), ctx, name, cidr, comment)
+}
+
+// UpdateOptions mocks base method.
+func (m *MockFirewallInterface) UpdateOptions(ctx context.Context, params map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOptions", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOptions indicates an expected call of UpdateOptions.
+func (mr *MockFirewallInterfaceMockRecorder) UpdateOptions(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOptions", reflect.TypeOf((*MockFirewallInterface)(nil).UpdateOptions), ctx, params)
+}
+
+// UpdateRule mocks base method.
+func (m *MockFirewallInterface) UpdateRule(ctx context.Context, pos int, params map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRule", ctx, pos, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRule indicates an expected call of UpdateRule.
+func (mr *MockFirewallInterfaceMockRecorder) UpdateRule(ctx, pos, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRule", reflect.TypeOf((*MockFirewallInterface)(nil).UpdateRule), ctx, pos, params)
+}